@@ -0,0 +1,136 @@
+package mockapi
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/linode/linodego"
+)
+
+const eventsCollection = "account/events"
+
+// job is an asynchronous operation that completes at a later time.
+type job struct {
+	at    time.Time
+	event Object
+	apply func()
+}
+
+// entityRef returns the representation of an entity
+// as it appears in an event.
+func entityRef(id any, entityType linodego.EntityType, label, url string) Object {
+	return Object{
+		"id":    id,
+		"type":  entityType,
+		"label": label,
+		"url":   url,
+	}
+}
+
+// startEvent records a new event in the started state and schedules
+// it to finish after the configured event delay. The given apply
+// function is run when the event finishes successfully.
+func (s *Server) startEvent(
+	action linodego.EventAction,
+	entity, secondaryEntity Object,
+	apply func(),
+) Object {
+	event := Object{
+		"id":               s.allocateID(),
+		"action":           action,
+		"status":           linodego.EventStarted,
+		"percent_complete": 0,
+		"created":          timestamp(),
+		"entity":           entity,
+		"secondary_entity": secondaryEntity,
+		"username":         "mockapi",
+		"read":             false,
+		"seen":             false,
+		"rate":             nil,
+		"time_remaining":   nil,
+	}
+
+	s.collection(eventsCollection).put(event)
+	s.schedule(event, apply)
+
+	return event
+}
+
+// schedule runs the given function (and finishes the given event,
+// if defined) after the configured event delay.
+func (s *Server) schedule(event Object, apply func()) {
+	s.jobs = append(s.jobs, &job{
+		at:    time.Now().Add(s.eventDelay),
+		event: event,
+		apply: apply,
+	})
+}
+
+// advance completes all jobs that are due.
+func (s *Server) advance() {
+	now := time.Now()
+
+	// Jobs may schedule further jobs when applied
+	jobs := s.jobs
+	s.jobs = nil
+
+	remaining := make([]*job, 0, len(jobs))
+
+	for _, j := range jobs {
+		if j.at.After(now) {
+			remaining = append(remaining, j)
+			continue
+		}
+
+		if j.event != nil {
+			action := linodego.EventAction(fmt.Sprint(j.event["action"]))
+			if s.failEvents[action] > 0 {
+				s.failEvents[action]--
+				j.event["status"] = linodego.EventFailed
+				continue
+			}
+
+			j.event["status"] = linodego.EventFinished
+			j.event["percent_complete"] = 100
+		}
+
+		if j.apply != nil {
+			j.apply()
+		}
+	}
+
+	s.jobs = append(remaining, s.jobs...)
+}
+
+func (s *Server) registerEventRoutes() {
+	s.route("GET account/events", func(r *http.Request) (any, error) {
+		return listResponse(r, s.collection(eventsCollection).list())
+	})
+
+	s.route("GET account/events/{eventID}", func(r *http.Request) (any, error) {
+		return s.getObject(r, eventsCollection, "eventID")
+	})
+
+	s.route("POST account/events/{eventID}/seen", func(r *http.Request) (any, error) {
+		event, err := s.getObject(r, eventsCollection, "eventID")
+		if err != nil {
+			return nil, err
+		}
+
+		event["seen"] = true
+
+		return nil, nil
+	})
+
+	s.route("POST account/events/{eventID}/read", func(r *http.Request) (any, error) {
+		event, err := s.getObject(r, eventsCollection, "eventID")
+		if err != nil {
+			return nil, err
+		}
+
+		event["read"] = true
+
+		return nil, nil
+	})
+}
@@ -0,0 +1,195 @@
+package mockapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// filter is a parsed X-Filter header.
+type filter map[string]any
+
+func parseFilter(header string) (filter, error) {
+	result := make(filter)
+
+	if header == "" {
+		return result, nil
+	}
+
+	if err := json.Unmarshal([]byte(header), &result); err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+
+	return result, nil
+}
+
+// matches returns whether the given normalized object matches the filter.
+func (f filter) matches(obj Object) bool {
+	for key, value := range f {
+		switch key {
+		case "+order_by", "+order":
+			continue
+		case "+and":
+			for _, child := range asObjectSlice(value) {
+				if !filter(child).matches(obj) {
+					return false
+				}
+			}
+		case "+or":
+			children := asObjectSlice(value)
+			if len(children) == 0 {
+				continue
+			}
+
+			matched := false
+			for _, child := range children {
+				if filter(child).matches(obj) {
+					matched = true
+					break
+				}
+			}
+
+			if !matched {
+				return false
+			}
+		default:
+			if !matchField(lookup(obj, key), value) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// sort orders the given normalized objects according to
+// the filter's +order_by and +order keys.
+func (f filter) sort(objects []Object) {
+	orderBy := asString(f["+order_by"])
+	if orderBy == "" {
+		return
+	}
+
+	descending := asString(f["+order"]) == "desc"
+
+	sort.SliceStable(objects, func(i, j int) bool {
+		a, b := lookup(objects[i], orderBy), lookup(objects[j], orderBy)
+
+		cmp := compare(a, b)
+		if cmp == 0 {
+			cmp = compare(objects[i]["id"], objects[j]["id"])
+		}
+
+		if descending {
+			return cmp > 0
+		}
+
+		return cmp < 0
+	})
+}
+
+// lookup resolves a dot-separated key (e.g. entity.id) in the given object.
+func lookup(obj Object, key string) any {
+	var current any = map[string]any(obj)
+
+	for _, segment := range strings.Split(key, ".") {
+		m, ok := current.(map[string]any)
+		if !ok {
+			return nil
+		}
+
+		current = m[segment]
+	}
+
+	return current
+}
+
+func matchField(fieldValue, filterValue any) bool {
+	op, operand := "+eq", filterValue
+
+	if m, ok := filterValue.(map[string]any); ok && len(m) == 1 {
+		for k, v := range m {
+			op, operand = k, v
+		}
+	}
+
+	// List fields (e.g. tags) match if any element matches
+	if list, ok := fieldValue.([]any); ok {
+		for _, item := range list {
+			if matchOperator(op, item, operand) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return matchOperator(op, fieldValue, operand)
+}
+
+func matchOperator(op string, fieldValue, operand any) bool {
+	switch op {
+	case "+eq":
+		return compare(fieldValue, operand) == 0
+	case "+neq":
+		return compare(fieldValue, operand) != 0
+	case "+gt":
+		return compare(fieldValue, operand) > 0
+	case "+gte":
+		return compare(fieldValue, operand) >= 0
+	case "+lt":
+		return compare(fieldValue, operand) < 0
+	case "+lte":
+		return compare(fieldValue, operand) <= 0
+	case "+contains":
+		return strings.Contains(
+			strings.ToLower(fmt.Sprint(fieldValue)),
+			strings.ToLower(fmt.Sprint(operand)),
+		)
+	}
+
+	return false
+}
+
+// compare compares two normalized JSON values, returning
+// a negative number, zero or a positive number.
+func compare(a, b any) int {
+	if af, ok := a.(float64); ok {
+		var bf float64
+
+		switch b := b.(type) {
+		case float64:
+			bf = b
+		case string:
+			if _, err := fmt.Sscan(b, &bf); err != nil {
+				return strings.Compare(fmt.Sprint(a), b)
+			}
+		default:
+			return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+		}
+
+		switch {
+		case af < bf:
+			return -1
+		case af > bf:
+			return 1
+		}
+
+		return 0
+	}
+
+	if a == nil || b == nil {
+		if a == b {
+			return 0
+		}
+
+		if a == nil {
+			return -1
+		}
+
+		return 1
+	}
+
+	return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+}
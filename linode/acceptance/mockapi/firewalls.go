@@ -0,0 +1,312 @@
+package mockapi
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/linode/linodego"
+)
+
+const firewallsCollection = "networking/firewalls"
+
+func firewallDevicesCollection(firewallID int) string {
+	return fmt.Sprintf("networking/firewalls/%d/devices", firewallID)
+}
+
+func firewallEntity(firewall Object) Object {
+	id := asInt(firewall["id"])
+
+	return entityRef(
+		id, linodego.EntityFirewall, asString(firewall["label"]),
+		fmt.Sprintf("/v4/networking/firewalls/%d", id),
+	)
+}
+
+// firewallRules returns the rule set described by the given options.
+func firewallRules(body Object) Object {
+	rules := Object{
+		"inbound":         []any{},
+		"inbound_policy":  "ACCEPT",
+		"outbound":        []any{},
+		"outbound_policy": "ACCEPT",
+	}
+
+	merge(rules, body, "inbound_policy", "outbound_policy")
+
+	for _, direction := range []string{"inbound", "outbound"} {
+		if v, ok := body[direction]; ok && v != nil {
+			rules[direction] = asObjectSlice(v)
+		}
+	}
+
+	return rules
+}
+
+// addFirewallDevice assigns the entity with the given ID and type to a firewall.
+func (s *Server) addFirewallDevice(firewall Object, entityID int, entityType string) (Object, error) {
+	var entity Object
+
+	switch linodego.FirewallDeviceType(entityType) {
+	case linodego.FirewallDeviceLinode:
+		instance, ok := s.collection(instancesCollection).get(entityID)
+		if !ok {
+			return nil, errBadRequest("id", "Linode not found")
+		}
+
+		entity = linodeEntity(instance)
+	case linodego.FirewallDeviceNodeBalancer:
+		nodeBalancer, ok := s.collection(nodeBalancersCollection).get(entityID)
+		if !ok {
+			return nil, errBadRequest("id", "NodeBalancer not found")
+		}
+
+		entity = nodeBalancerEntity(nodeBalancer)
+	default:
+		return nil, errBadRequest("type", "Must be one of linode, nodebalancer")
+	}
+
+	devices := s.collection(firewallDevicesCollection(asInt(firewall["id"])))
+
+	for _, device := range devices.list() {
+		existing := asObject(device["entity"])
+		if asInt(existing["id"]) == entityID && asString(existing["type"]) == entityType {
+			return nil, errBadRequest("id", "Device is already assigned to this firewall")
+		}
+	}
+
+	device := Object{
+		"id":      s.allocateID(),
+		"entity":  entity,
+		"created": timestamp(),
+		"updated": timestamp(),
+	}
+
+	devices.put(device)
+
+	return device, nil
+}
+
+// entityFirewalls returns all firewalls the given entity is assigned to.
+func (s *Server) entityFirewalls(entityID int, entityType linodego.FirewallDeviceType) []Object {
+	result := make([]Object, 0)
+
+	for _, firewall := range s.collection(firewallsCollection).list() {
+		for _, device := range s.collection(firewallDevicesCollection(asInt(firewall["id"]))).list() {
+			entity := asObject(device["entity"])
+			if asInt(entity["id"]) == entityID && asString(entity["type"]) == string(entityType) {
+				result = append(result, firewall)
+				break
+			}
+		}
+	}
+
+	return result
+}
+
+// removeEntityFirewallDevices unassigns the given entity from all firewalls.
+func (s *Server) removeEntityFirewallDevices(entityID int, entityType linodego.FirewallDeviceType) {
+	for _, firewall := range s.collection(firewallsCollection).list() {
+		devices := s.collection(firewallDevicesCollection(asInt(firewall["id"])))
+
+		for _, device := range devices.list() {
+			entity := asObject(device["entity"])
+			if asInt(entity["id"]) == entityID && asString(entity["type"]) == string(entityType) {
+				devices.delete(asInt(device["id"]))
+			}
+		}
+	}
+}
+
+func (s *Server) registerFirewallRoutes() {
+	firewalls := s.collection(firewallsCollection)
+
+	s.route("GET networking/firewalls", func(r *http.Request) (any, error) {
+		return listResponse(r, firewalls.list())
+	})
+
+	s.route("POST networking/firewalls", func(r *http.Request) (any, error) {
+		body, err := decodeBody(r)
+		if err != nil {
+			return nil, err
+		}
+
+		if asString(body["label"]) == "" {
+			return nil, errBadRequest("label", "Label is required")
+		}
+
+		firewall := Object{
+			"id":      s.allocateID(),
+			"label":   asString(body["label"]),
+			"status":  linodego.FirewallEnabled,
+			"tags":    asStringSlice(body["tags"]),
+			"rules":   firewallRules(asObject(body["rules"])),
+			"created": timestamp(),
+			"updated": timestamp(),
+		}
+
+		devices := asObject(body["devices"])
+
+		for _, linodeID := range asObjectIDs(devices["linodes"]) {
+			if _, err := s.addFirewallDevice(firewall, linodeID, string(linodego.FirewallDeviceLinode)); err != nil {
+				return nil, err
+			}
+		}
+
+		for _, nodeBalancerID := range asObjectIDs(devices["nodebalancers"]) {
+			if _, err := s.addFirewallDevice(
+				firewall, nodeBalancerID, string(linodego.FirewallDeviceNodeBalancer),
+			); err != nil {
+				return nil, err
+			}
+		}
+
+		firewalls.put(firewall)
+
+		s.startEvent(linodego.ActionFirewallCreate, firewallEntity(firewall), nil, nil)
+
+		return firewall, nil
+	})
+
+	s.route("GET networking/firewalls/{firewallID}", func(r *http.Request) (any, error) {
+		return s.getObject(r, firewallsCollection, "firewallID")
+	})
+
+	s.route("PUT networking/firewalls/{firewallID}", func(r *http.Request) (any, error) {
+		firewall, err := s.getObject(r, firewallsCollection, "firewallID")
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := decodeBody(r)
+		if err != nil {
+			return nil, err
+		}
+
+		merge(firewall, body, "label", "tags", "status")
+		firewall["updated"] = timestamp()
+
+		return firewall, nil
+	})
+
+	s.route("DELETE networking/firewalls/{firewallID}", func(r *http.Request) (any, error) {
+		firewall, err := s.getObject(r, firewallsCollection, "firewallID")
+		if err != nil {
+			return nil, err
+		}
+
+		firewalls.delete(asInt(firewall["id"]))
+		delete(s.collections, firewallDevicesCollection(asInt(firewall["id"])))
+
+		s.startEvent(linodego.ActionFirewallDelete, firewallEntity(firewall), nil, nil)
+
+		return nil, nil
+	})
+
+	s.route("GET networking/firewalls/{firewallID}/rules", func(r *http.Request) (any, error) {
+		firewall, err := s.getObject(r, firewallsCollection, "firewallID")
+		if err != nil {
+			return nil, err
+		}
+
+		return asObject(firewall["rules"]), nil
+	})
+
+	s.route("PUT networking/firewalls/{firewallID}/rules", func(r *http.Request) (any, error) {
+		firewall, err := s.getObject(r, firewallsCollection, "firewallID")
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := decodeBody(r)
+		if err != nil {
+			return nil, err
+		}
+
+		firewall["rules"] = firewallRules(body)
+		firewall["updated"] = timestamp()
+
+		return asObject(firewall["rules"]), nil
+	})
+
+	s.route("GET networking/firewalls/{firewallID}/devices", func(r *http.Request) (any, error) {
+		firewall, err := s.getObject(r, firewallsCollection, "firewallID")
+		if err != nil {
+			return nil, err
+		}
+
+		return listResponse(r, s.collection(firewallDevicesCollection(asInt(firewall["id"]))).list())
+	})
+
+	s.route("POST networking/firewalls/{firewallID}/devices", func(r *http.Request) (any, error) {
+		firewall, err := s.getObject(r, firewallsCollection, "firewallID")
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := decodeBody(r)
+		if err != nil {
+			return nil, err
+		}
+
+		device, err := s.addFirewallDevice(firewall, asInt(body["id"]), asString(body["type"]))
+		if err != nil {
+			return nil, err
+		}
+
+		s.startEvent(linodego.ActionFirewallDeviceAdd, firewallEntity(firewall), asObject(device["entity"]), nil)
+
+		return device, nil
+	})
+
+	s.route("GET networking/firewalls/{firewallID}/devices/{deviceID}", func(r *http.Request) (any, error) {
+		firewall, err := s.getObject(r, firewallsCollection, "firewallID")
+		if err != nil {
+			return nil, err
+		}
+
+		return s.getObject(r, firewallDevicesCollection(asInt(firewall["id"])), "deviceID")
+	})
+
+	s.route("DELETE networking/firewalls/{firewallID}/devices/{deviceID}", func(r *http.Request) (any, error) {
+		firewall, err := s.getObject(r, firewallsCollection, "firewallID")
+		if err != nil {
+			return nil, err
+		}
+
+		device, err := s.getObject(r, firewallDevicesCollection(asInt(firewall["id"])), "deviceID")
+		if err != nil {
+			return nil, err
+		}
+
+		s.collection(firewallDevicesCollection(asInt(firewall["id"]))).delete(asInt(device["id"]))
+
+		s.startEvent(linodego.ActionFirewallDeviceRemove, firewallEntity(firewall), asObject(device["entity"]), nil)
+
+		return nil, nil
+	})
+
+	s.route("GET linode/instances/{linodeID}/firewalls", func(r *http.Request) (any, error) {
+		instance, err := s.getObject(r, instancesCollection, "linodeID")
+		if err != nil {
+			return nil, err
+		}
+
+		return listResponse(r, s.entityFirewalls(asInt(instance["id"]), linodego.FirewallDeviceLinode))
+	})
+}
+
+// asObjectIDs returns the given list of IDs as integers.
+func asObjectIDs(v any) []int {
+	result := make([]int, 0)
+
+	switch v := v.(type) {
+	case []int:
+		result = append(result, v...)
+	case []any:
+		for _, item := range v {
+			result = append(result, asInt(item))
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,976 @@
+package mockapi
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/linode/linodego"
+)
+
+const (
+	instancesCollection = "linode/instances"
+
+	defaultSwapSize = 512
+)
+
+func disksCollection(linodeID int) string {
+	return fmt.Sprintf("linode/instances/%d/disks", linodeID)
+}
+
+func configsCollection(linodeID int) string {
+	return fmt.Sprintf("linode/instances/%d/configs", linodeID)
+}
+
+func ipsCollection(linodeID int) string {
+	return fmt.Sprintf("linode/instances/%d/ips", linodeID)
+}
+
+func linodeEntity(instance Object) Object {
+	id := asInt(instance["id"])
+
+	return entityRef(
+		id, linodego.EntityLinode, asString(instance["label"]),
+		fmt.Sprintf("/v4/linode/instances/%d", id),
+	)
+}
+
+func diskEntity(linodeID int, disk Object) Object {
+	id := asInt(disk["id"])
+
+	return entityRef(
+		id, linodego.EntityDisk, asString(disk["label"]),
+		fmt.Sprintf("/v4/linode/instances/%d/disks/%d", linodeID, id),
+	)
+}
+
+// createInstance creates a new instance with the given options,
+// returning the new instance and its creation event.
+func (s *Server) createInstance(body Object) (Object, error) {
+	linodeType, ok := s.getStaticObject(typesCollection, asString(body["type"]))
+	if !ok {
+		return nil, errBadRequest("type", "A valid plan type by that ID was not found")
+	}
+
+	region := asString(body["region"])
+	if _, ok := s.getStaticObject(regionsCollection, region); !ok {
+		return nil, errBadRequest("region", "region is not valid")
+	}
+
+	image := asString(body["image"])
+	if image != "" {
+		if _, ok := s.getStaticObject(imagesCollection, image); !ok {
+			return nil, errBadRequest("image", "image is not valid")
+		}
+
+		if asString(body["root_pass"]) == "" {
+			return nil, errBadRequest("root_pass", "root_pass is required when specifying an image")
+		}
+	}
+
+	id := s.allocateID()
+
+	label := asString(body["label"])
+	if label == "" {
+		label = fmt.Sprintf("linode%d", id)
+	}
+
+	tags := asStringSlice(body["tags"])
+
+	instance := Object{
+		"id":     id,
+		"label":  label,
+		"region": region,
+		"type":   linodeType["id"],
+		"image":  nil,
+		"group":  asString(body["group"]),
+		"status": linodego.InstanceProvisioning,
+		"ipv4":   []string{},
+		"ipv6":   fmt.Sprintf("2001:db8::%x/128", id),
+		"specs": Object{
+			"disk":     linodeType["disk"],
+			"memory":   linodeType["memory"],
+			"vcpus":    linodeType["vcpus"],
+			"transfer": linodeType["transfer"],
+			"gpus":     linodeType["gpus"],
+		},
+		"alerts": Object{
+			"cpu":            90 * asInt(linodeType["vcpus"]),
+			"io":             10000,
+			"network_in":     10,
+			"network_out":    10,
+			"transfer_quota": 80,
+		},
+		"backups": Object{
+			"enabled":   asBool(body["backups_enabled"], false),
+			"available": false,
+			"schedule": Object{
+				"day":    "Scheduling",
+				"window": "Scheduling",
+			},
+			"last_successful": nil,
+		},
+		"hypervisor":       "kvm",
+		"host_uuid":        fmt.Sprintf("mockapi-host-%d", id),
+		"watchdog_enabled": true,
+		"has_user_data":    asObject(body["metadata"]) != nil,
+		"tags":             tags,
+		"created":          timestamp(),
+		"updated":          timestamp(),
+		"disk_encryption":  linodego.InstanceDiskEncryptionDisabled,
+		"placement_group":  nil,
+		"lke_cluster_id":   nil,
+	}
+
+	if image != "" {
+		instance["image"] = image
+	}
+
+	if asString(body["disk_encryption"]) == string(linodego.InstanceDiskEncryptionEnabled) {
+		instance["disk_encryption"] = linodego.InstanceDiskEncryptionEnabled
+	}
+
+	s.collection(instancesCollection).put(instance)
+
+	s.addInstanceIP(instance, true)
+	if asBool(body["private_ip"], false) {
+		s.addInstanceIP(instance, false)
+	}
+
+	if image != "" {
+		swapSize := defaultSwapSize
+		if v, ok := body["swap_size"]; ok {
+			swapSize = asInt(v)
+		}
+
+		rootDisk := s.newDisk(id, Object{
+			"label":      fmt.Sprintf("%s Disk", strings.TrimPrefix(image, "linode/")),
+			"size":       asInt(linodeType["disk"]) - swapSize,
+			"filesystem": linodego.FilesystemExt4,
+		}, linodego.DiskReady)
+
+		devices := Object{"sda": Object{"disk_id": rootDisk["id"], "volume_id": nil}}
+
+		if swapSize > 0 {
+			swapDisk := s.newDisk(id, Object{
+				"label":      fmt.Sprintf("%d MB Swap Image", swapSize),
+				"size":       swapSize,
+				"filesystem": linodego.FilesystemSwap,
+			}, linodego.DiskReady)
+
+			devices["sdb"] = Object{"disk_id": swapDisk["id"], "volume_id": nil}
+		}
+
+		if _, err := s.newConfig(id, Object{
+			"label":      fmt.Sprintf("My %s Disk Profile", strings.TrimPrefix(image, "linode/")),
+			"devices":    devices,
+			"interfaces": body["interfaces"],
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	booted := asBool(body["booted"], image != "")
+
+	s.startEvent(linodego.ActionLinodeCreate, linodeEntity(instance), nil, func() {
+		instance["status"] = linodego.InstanceOffline
+		if booted {
+			instance["status"] = linodego.InstanceRunning
+		}
+	})
+
+	return instance, nil
+}
+
+// deleteInstance deletes the given instance and all of its sub-resources.
+func (s *Server) deleteInstance(instance Object) {
+	linodeID := asInt(instance["id"])
+
+	s.collection(instancesCollection).delete(linodeID)
+
+	for _, name := range []string{disksCollection(linodeID), configsCollection(linodeID), ipsCollection(linodeID)} {
+		delete(s.collections, name)
+	}
+
+	s.detachInstanceVolumes(linodeID)
+	s.removeEntityFirewallDevices(linodeID, linodego.FirewallDeviceLinode)
+
+	s.startEvent(linodego.ActionLinodeDelete, linodeEntity(instance), nil, nil)
+}
+
+// addInstanceIP allocates a new IPv4 address for the given instance.
+func (s *Server) addInstanceIP(instance Object, public bool) Object {
+	linodeID := asInt(instance["id"])
+	ipID := s.allocateID()
+
+	ip := Object{
+		"_key":        ipID,
+		"address":     fmt.Sprintf("192.0.2.%d", ipID%254+1),
+		"gateway":     "192.0.2.1",
+		"subnet_mask": "255.255.255.0",
+		"prefix":      24,
+		"type":        linodego.IPTypeIPv4,
+		"public":      true,
+		"rdns":        fmt.Sprintf("192-0-2-%d.ip.linodeusercontent.com", ipID%254+1),
+		"linode_id":   linodeID,
+		"region":      instance["region"],
+		"vpc_nat_1_1": nil,
+	}
+
+	if !public {
+		ip["address"] = fmt.Sprintf("192.168.128.%d", ipID%254+1)
+		ip["gateway"] = nil
+		ip["subnet_mask"] = "255.255.128.0"
+		ip["prefix"] = 17
+		ip["public"] = false
+		ip["rdns"] = nil
+	}
+
+	s.collection(ipsCollection(linodeID)).put(ip)
+
+	instance["ipv4"] = append(asStringSlice(instance["ipv4"]), asString(ip["address"]))
+
+	return ip
+}
+
+// instanceIPs returns the networking information for the given instance.
+func (s *Server) instanceIPs(instance Object) Object {
+	linodeID := asInt(instance["id"])
+
+	public := make([]Object, 0)
+	private := make([]Object, 0)
+
+	for _, ip := range s.collection(ipsCollection(linodeID)).list() {
+		if asBool(ip["public"], false) {
+			public = append(public, normalize(ip))
+		} else {
+			private = append(private, normalize(ip))
+		}
+	}
+
+	return Object{
+		"ipv4": Object{
+			"public":   public,
+			"private":  private,
+			"shared":   []any{},
+			"reserved": []any{},
+			"vpc":      []any{},
+		},
+		"ipv6": Object{
+			"link_local": Object{
+				"address":     fmt.Sprintf("fe80::%x", linodeID),
+				"gateway":     "fe80::1",
+				"subnet_mask": "ffff:ffff:ffff:ffff::",
+				"prefix":      64,
+				"type":        linodego.IPTypeIPv6,
+				"public":      false,
+				"linode_id":   linodeID,
+				"region":      instance["region"],
+			},
+			"slaac": Object{
+				"address":     strings.TrimSuffix(asString(instance["ipv6"]), "/128"),
+				"gateway":     "fe80::1",
+				"subnet_mask": "ffff:ffff:ffff:ffff::",
+				"prefix":      128,
+				"type":        linodego.IPTypeIPv6,
+				"public":      true,
+				"linode_id":   linodeID,
+				"region":      instance["region"],
+			},
+			"global": []any{},
+		},
+	}
+}
+
+// newDisk creates a disk on the given instance in the given status.
+func (s *Server) newDisk(linodeID int, body Object, status linodego.DiskStatus) Object {
+	filesystem := asString(body["filesystem"])
+	if filesystem == "" {
+		filesystem = string(linodego.FilesystemExt4)
+	}
+
+	disk := Object{
+		"id":              s.allocateID(),
+		"label":           asString(body["label"]),
+		"status":          status,
+		"size":            asInt(body["size"]),
+		"filesystem":      filesystem,
+		"created":         timestamp(),
+		"updated":         timestamp(),
+		"disk_encryption": linodego.InstanceDiskEncryptionDisabled,
+	}
+
+	s.collection(disksCollection(linodeID)).put(disk)
+
+	return disk
+}
+
+// usedDiskSpace returns the total size of all disks on the given instance,
+// excluding the disk with the given ID.
+func (s *Server) usedDiskSpace(linodeID, excludeDiskID int) int {
+	total := 0
+
+	for _, disk := range s.collection(disksCollection(linodeID)).list() {
+		if asInt(disk["id"]) != excludeDiskID {
+			total += asInt(disk["size"])
+		}
+	}
+
+	return total
+}
+
+// newConfig creates a config profile on the given instance.
+func (s *Server) newConfig(linodeID int, body Object) (Object, error) {
+	config := Object{
+		"id":           s.allocateID(),
+		"label":        asString(body["label"]),
+		"comments":     "",
+		"devices":      Object{},
+		"memory_limit": 0,
+		"kernel":       "linode/grub2",
+		"init_rd":      nil,
+		"root_device":  "/dev/sda",
+		"run_level":    "default",
+		"virt_mode":    "paravirt",
+		"helpers": Object{
+			"updatedb_disabled":  true,
+			"distro":             true,
+			"modules_dep":        true,
+			"network":            true,
+			"devtmpfs_automount": true,
+		},
+		"interfaces": []Object{},
+		"created":    timestamp(),
+		"updated":    timestamp(),
+	}
+
+	if err := s.updateConfig(config, body); err != nil {
+		return nil, err
+	}
+
+	s.collection(configsCollection(linodeID)).put(config)
+
+	return config, nil
+}
+
+// updateConfig applies the given options to a config profile.
+func (s *Server) updateConfig(config, body Object) error {
+	merge(
+		config, body,
+		"label", "comments", "devices", "memory_limit", "kernel",
+		"init_rd", "root_device", "run_level", "virt_mode",
+	)
+
+	if helpers := asObject(body["helpers"]); helpers != nil {
+		merge(
+			asObject(config["helpers"]), helpers,
+			"updatedb_disabled", "distro", "modules_dep", "network", "devtmpfs_automount",
+		)
+	}
+
+	if _, ok := body["interfaces"]; ok && body["interfaces"] != nil {
+		interfaces, err := s.newInterfaces(asObjectSlice(body["interfaces"]))
+		if err != nil {
+			return err
+		}
+
+		config["interfaces"] = interfaces
+	}
+
+	config["updated"] = timestamp()
+
+	return nil
+}
+
+// newInterfaces builds the interfaces of a config profile from the given options.
+func (s *Server) newInterfaces(options []Object) ([]Object, error) {
+	result := make([]Object, len(options))
+	primarySet := false
+
+	for i, opts := range options {
+		purpose := asString(opts["purpose"])
+
+		iface := Object{
+			"id":           s.allocateID(),
+			"purpose":      purpose,
+			"label":        "",
+			"ipam_address": "",
+			"primary":      asBool(opts["primary"], false),
+			"active":       false,
+			"vpc_id":       nil,
+			"subnet_id":    nil,
+			"ipv4":         nil,
+			"ip_ranges":    []string{},
+		}
+
+		if asBool(opts["primary"], false) {
+			if primarySet {
+				return nil, errBadRequest(fmt.Sprintf("interfaces[%d].primary", i), "Only one primary interface is allowed")
+			}
+
+			primarySet = true
+		}
+
+		switch linodego.ConfigInterfacePurpose(purpose) {
+		case linodego.InterfacePurposePublic:
+		case linodego.InterfacePurposeVLAN:
+			iface["label"] = asString(opts["label"])
+			iface["ipam_address"] = asString(opts["ipam_address"])
+		case linodego.InterfacePurposeVPC:
+			subnetID := asInt(opts["subnet_id"])
+
+			subnet, vpcID, ok := s.findSubnet(subnetID)
+			if !ok {
+				return nil, errBadRequest(fmt.Sprintf("interfaces[%d].subnet_id", i), "Subnet not found")
+			}
+
+			ipv4 := asObject(opts["ipv4"])
+			vpcAddress := asString(ipv4["vpc"])
+
+			if vpcAddress == "" {
+				vpcAddress = s.allocateSubnetAddress(subnet)
+			}
+
+			iface["vpc_id"] = vpcID
+			iface["subnet_id"] = subnetID
+			iface["ipv4"] = Object{
+				"vpc":     vpcAddress,
+				"nat_1_1": asString(ipv4["nat_1_1"]),
+			}
+			iface["ip_ranges"] = asStringSlice(opts["ip_ranges"])
+		default:
+			return nil, errBadRequest(fmt.Sprintf("interfaces[%d].purpose", i), "Must be one of public, vlan, vpc")
+		}
+
+		result[i] = iface
+	}
+
+	return result, nil
+}
+
+// setInstanceStatus transitions the given instance to the given
+// transitional status, then to the final status once the event finishes.
+func (s *Server) setInstanceStatus(
+	instance Object,
+	action linodego.EventAction,
+	transitional, final linodego.InstanceStatus,
+	secondaryEntity Object,
+	apply func(),
+) Object {
+	instance["status"] = transitional
+
+	return s.startEvent(action, linodeEntity(instance), secondaryEntity, func() {
+		instance["status"] = final
+
+		if apply != nil {
+			apply()
+		}
+	})
+}
+
+// bootConfigEntity returns the event entity of the config to boot the
+// given instance into, or nil if the instance has no configs.
+func (s *Server) bootConfigEntity(linodeID, configID int) (Object, error) {
+	configs := s.collection(configsCollection(linodeID))
+
+	var config Object
+
+	if configID != 0 {
+		var ok bool
+		if config, ok = configs.get(configID); !ok {
+			return nil, errBadRequest("config_id", "Config not found")
+		}
+	} else if all := configs.list(); len(all) > 0 {
+		config = all[0]
+	}
+
+	if config == nil {
+		return nil, nil
+	}
+
+	return entityRef(
+		config["id"], "linode_config", asString(config["label"]),
+		fmt.Sprintf("/v4/linode/instances/%d/configs/%d", linodeID, asInt(config["id"])),
+	), nil
+}
+
+func (s *Server) registerLinodeRoutes() {
+	instances := s.collection(instancesCollection)
+
+	s.route("GET linode/instances", func(r *http.Request) (any, error) {
+		return listResponse(r, instances.list())
+	})
+
+	s.route("POST linode/instances", func(r *http.Request) (any, error) {
+		body, err := decodeBody(r)
+		if err != nil {
+			return nil, err
+		}
+
+		return s.createInstance(body)
+	})
+
+	s.route("GET linode/instances/{linodeID}", func(r *http.Request) (any, error) {
+		return s.getObject(r, instancesCollection, "linodeID")
+	})
+
+	s.route("PUT linode/instances/{linodeID}", func(r *http.Request) (any, error) {
+		instance, err := s.getObject(r, instancesCollection, "linodeID")
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := decodeBody(r)
+		if err != nil {
+			return nil, err
+		}
+
+		merge(instance, body, "label", "group", "tags", "watchdog_enabled")
+
+		if alerts := asObject(body["alerts"]); alerts != nil {
+			merge(
+				asObject(instance["alerts"]), alerts,
+				"cpu", "io", "network_in", "network_out", "transfer_quota",
+			)
+		}
+
+		if backups := asObject(body["backups"]); backups != nil {
+			if schedule := asObject(backups["schedule"]); schedule != nil {
+				merge(asObject(asObject(instance["backups"])["schedule"]), schedule, "day", "window")
+			}
+		}
+
+		instance["updated"] = timestamp()
+
+		return instance, nil
+	})
+
+	s.route("DELETE linode/instances/{linodeID}", func(r *http.Request) (any, error) {
+		instance, err := s.getObject(r, instancesCollection, "linodeID")
+		if err != nil {
+			return nil, err
+		}
+
+		s.deleteInstance(instance)
+
+		return nil, nil
+	})
+
+	s.route("POST linode/instances/{linodeID}/boot", func(r *http.Request) (any, error) {
+		instance, err := s.getObject(r, instancesCollection, "linodeID")
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := decodeBody(r)
+		if err != nil {
+			return nil, err
+		}
+
+		config, err := s.bootConfigEntity(asInt(instance["id"]), asInt(body["config_id"]))
+		if err != nil {
+			return nil, err
+		}
+
+		s.setInstanceStatus(
+			instance, linodego.ActionLinodeBoot,
+			linodego.InstanceBooting, linodego.InstanceRunning, config, nil,
+		)
+
+		return nil, nil
+	})
+
+	s.route("POST linode/instances/{linodeID}/reboot", func(r *http.Request) (any, error) {
+		instance, err := s.getObject(r, instancesCollection, "linodeID")
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := decodeBody(r)
+		if err != nil {
+			return nil, err
+		}
+
+		config, err := s.bootConfigEntity(asInt(instance["id"]), asInt(body["config_id"]))
+		if err != nil {
+			return nil, err
+		}
+
+		s.setInstanceStatus(
+			instance, linodego.ActionLinodeReboot,
+			linodego.InstanceRebooting, linodego.InstanceRunning, config, nil,
+		)
+
+		return nil, nil
+	})
+
+	s.route("POST linode/instances/{linodeID}/shutdown", func(r *http.Request) (any, error) {
+		instance, err := s.getObject(r, instancesCollection, "linodeID")
+		if err != nil {
+			return nil, err
+		}
+
+		s.setInstanceStatus(
+			instance, linodego.ActionLinodeShutdown,
+			linodego.InstanceShuttingDown, linodego.InstanceOffline, nil, nil,
+		)
+
+		return nil, nil
+	})
+
+	s.route("POST linode/instances/{linodeID}/resize", func(r *http.Request) (any, error) {
+		instance, err := s.getObject(r, instancesCollection, "linodeID")
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := decodeBody(r)
+		if err != nil {
+			return nil, err
+		}
+
+		linodeType, ok := s.getStaticObject(typesCollection, asString(body["type"]))
+		if !ok {
+			return nil, errBadRequest("type", "A valid plan type by that ID was not found")
+		}
+
+		linodeID := asInt(instance["id"])
+
+		if asInt(linodeType["disk"]) < s.usedDiskSpace(linodeID, 0) &&
+			!asBool(body["allow_auto_disk_resize"], true) {
+			return nil, errBadRequest("type", "Linode has allocated more disk than the new service plan allows")
+		}
+
+		s.setInstanceStatus(
+			instance, linodego.ActionLinodeResize,
+			linodego.InstanceResizing, linodego.InstanceOffline, nil,
+			func() {
+				instance["type"] = linodeType["id"]
+				instance["specs"] = Object{
+					"disk":     linodeType["disk"],
+					"memory":   linodeType["memory"],
+					"vcpus":    linodeType["vcpus"],
+					"transfer": linodeType["transfer"],
+					"gpus":     linodeType["gpus"],
+				}
+			},
+		)
+
+		return nil, nil
+	})
+
+	s.route("POST linode/instances/{linodeID}/migrate", func(r *http.Request) (any, error) {
+		instance, err := s.getObject(r, instancesCollection, "linodeID")
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := decodeBody(r)
+		if err != nil {
+			return nil, err
+		}
+
+		region := asString(body["region"])
+		if region != "" {
+			if _, ok := s.getStaticObject(regionsCollection, region); !ok {
+				return nil, errBadRequest("region", "region is not valid")
+			}
+		}
+
+		previous := instance["status"]
+
+		s.setInstanceStatus(
+			instance, linodego.ActionLinodeMigrateDatacenter,
+			linodego.InstanceMigrating, linodego.InstanceStatus(asString(previous)), nil,
+			func() {
+				if region != "" {
+					instance["region"] = region
+				}
+			},
+		)
+
+		return nil, nil
+	})
+
+	s.route("POST linode/instances/{linodeID}/backups/enable", func(r *http.Request) (any, error) {
+		instance, err := s.getObject(r, instancesCollection, "linodeID")
+		if err != nil {
+			return nil, err
+		}
+
+		asObject(instance["backups"])["enabled"] = true
+
+		return nil, nil
+	})
+
+	s.route("POST linode/instances/{linodeID}/backups/cancel", func(r *http.Request) (any, error) {
+		instance, err := s.getObject(r, instancesCollection, "linodeID")
+		if err != nil {
+			return nil, err
+		}
+
+		asObject(instance["backups"])["enabled"] = false
+
+		return nil, nil
+	})
+
+	s.route("GET linode/instances/{linodeID}/ips", func(r *http.Request) (any, error) {
+		instance, err := s.getObject(r, instancesCollection, "linodeID")
+		if err != nil {
+			return nil, err
+		}
+
+		return s.instanceIPs(instance), nil
+	})
+
+	s.route("POST linode/instances/{linodeID}/ips", func(r *http.Request) (any, error) {
+		instance, err := s.getObject(r, instancesCollection, "linodeID")
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := decodeBody(r)
+		if err != nil {
+			return nil, err
+		}
+
+		if asString(body["type"]) != string(linodego.IPTypeIPv4) {
+			return nil, errBadRequest("type", "Only ipv4 addresses may be allocated")
+		}
+
+		return s.addInstanceIP(instance, asBool(body["public"], false)), nil
+	})
+
+	s.route("POST networking/ips/share", func(r *http.Request) (any, error) {
+		body, err := decodeBody(r)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := instances.get(asInt(body["linode_id"])); !ok {
+			return nil, errBadRequest("linode_id", "Linode not found")
+		}
+
+		return nil, nil
+	})
+
+	s.registerConfigRoutes()
+	s.registerDiskRoutes()
+}
+
+func (s *Server) registerConfigRoutes() {
+	s.route("GET linode/instances/{linodeID}/configs", func(r *http.Request) (any, error) {
+		instance, err := s.getObject(r, instancesCollection, "linodeID")
+		if err != nil {
+			return nil, err
+		}
+
+		return listResponse(r, s.collection(configsCollection(asInt(instance["id"]))).list())
+	})
+
+	s.route("POST linode/instances/{linodeID}/configs", func(r *http.Request) (any, error) {
+		instance, err := s.getObject(r, instancesCollection, "linodeID")
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := decodeBody(r)
+		if err != nil {
+			return nil, err
+		}
+
+		if asString(body["label"]) == "" {
+			return nil, errBadRequest("label", "Label is required")
+		}
+
+		return s.newConfig(asInt(instance["id"]), body)
+	})
+
+	s.route("GET linode/instances/{linodeID}/configs/{configID}", func(r *http.Request) (any, error) {
+		instance, err := s.getObject(r, instancesCollection, "linodeID")
+		if err != nil {
+			return nil, err
+		}
+
+		return s.getObject(r, configsCollection(asInt(instance["id"])), "configID")
+	})
+
+	s.route("PUT linode/instances/{linodeID}/configs/{configID}", func(r *http.Request) (any, error) {
+		instance, err := s.getObject(r, instancesCollection, "linodeID")
+		if err != nil {
+			return nil, err
+		}
+
+		config, err := s.getObject(r, configsCollection(asInt(instance["id"])), "configID")
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := decodeBody(r)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.updateConfig(config, body); err != nil {
+			return nil, err
+		}
+
+		return config, nil
+	})
+
+	s.route("DELETE linode/instances/{linodeID}/configs/{configID}", func(r *http.Request) (any, error) {
+		instance, err := s.getObject(r, instancesCollection, "linodeID")
+		if err != nil {
+			return nil, err
+		}
+
+		configs := s.collection(configsCollection(asInt(instance["id"])))
+
+		config, err := s.getObject(r, configsCollection(asInt(instance["id"])), "configID")
+		if err != nil {
+			return nil, err
+		}
+
+		configs.delete(asInt(config["id"]))
+
+		return nil, nil
+	})
+}
+
+func (s *Server) registerDiskRoutes() {
+	s.route("GET linode/instances/{linodeID}/disks", func(r *http.Request) (any, error) {
+		instance, err := s.getObject(r, instancesCollection, "linodeID")
+		if err != nil {
+			return nil, err
+		}
+
+		return listResponse(r, s.collection(disksCollection(asInt(instance["id"]))).list())
+	})
+
+	s.route("POST linode/instances/{linodeID}/disks", func(r *http.Request) (any, error) {
+		instance, err := s.getObject(r, instancesCollection, "linodeID")
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := decodeBody(r)
+		if err != nil {
+			return nil, err
+		}
+
+		linodeID := asInt(instance["id"])
+		size := asInt(body["size"])
+
+		if size < 1 {
+			return nil, errBadRequest("size", "Size must be a positive integer")
+		}
+
+		if s.usedDiskSpace(linodeID, 0)+size > asInt(asObject(instance["specs"])["disk"]) {
+			return nil, errBadRequest("size", "Insufficient space available on this Linode")
+		}
+
+		if asString(body["image"]) != "" && asString(body["root_pass"]) == "" {
+			return nil, errBadRequest("root_pass", "root_pass is required when specifying an image")
+		}
+
+		disk := s.newDisk(linodeID, body, linodego.DiskNotReady)
+
+		s.startEvent(linodego.ActionDiskCreate, linodeEntity(instance), diskEntity(linodeID, disk), func() {
+			disk["status"] = linodego.DiskReady
+		})
+
+		return disk, nil
+	})
+
+	s.route("GET linode/instances/{linodeID}/disks/{diskID}", func(r *http.Request) (any, error) {
+		instance, err := s.getObject(r, instancesCollection, "linodeID")
+		if err != nil {
+			return nil, err
+		}
+
+		return s.getObject(r, disksCollection(asInt(instance["id"])), "diskID")
+	})
+
+	s.route("PUT linode/instances/{linodeID}/disks/{diskID}", func(r *http.Request) (any, error) {
+		instance, err := s.getObject(r, instancesCollection, "linodeID")
+		if err != nil {
+			return nil, err
+		}
+
+		disk, err := s.getObject(r, disksCollection(asInt(instance["id"])), "diskID")
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := decodeBody(r)
+		if err != nil {
+			return nil, err
+		}
+
+		merge(disk, body, "label")
+		disk["updated"] = timestamp()
+
+		return disk, nil
+	})
+
+	s.route("DELETE linode/instances/{linodeID}/disks/{diskID}", func(r *http.Request) (any, error) {
+		instance, err := s.getObject(r, instancesCollection, "linodeID")
+		if err != nil {
+			return nil, err
+		}
+
+		linodeID := asInt(instance["id"])
+
+		disk, err := s.getObject(r, disksCollection(linodeID), "diskID")
+		if err != nil {
+			return nil, err
+		}
+
+		disk["status"] = linodego.DiskDeleting
+
+		s.startEvent(linodego.ActionDiskDelete, linodeEntity(instance), diskEntity(linodeID, disk), func() {
+			s.collection(disksCollection(linodeID)).delete(asInt(disk["id"]))
+		})
+
+		return nil, nil
+	})
+
+	s.route("POST linode/instances/{linodeID}/disks/{diskID}/resize", func(r *http.Request) (any, error) {
+		instance, err := s.getObject(r, instancesCollection, "linodeID")
+		if err != nil {
+			return nil, err
+		}
+
+		linodeID := asInt(instance["id"])
+
+		disk, err := s.getObject(r, disksCollection(linodeID), "diskID")
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := decodeBody(r)
+		if err != nil {
+			return nil, err
+		}
+
+		size := asInt(body["size"])
+
+		if s.usedDiskSpace(linodeID, asInt(disk["id"]))+size > asInt(asObject(instance["specs"])["disk"]) {
+			return nil, errBadRequest("size", "Insufficient space available on this Linode")
+		}
+
+		if instance["status"] != linodego.InstanceOffline {
+			return nil, errBadRequest("", "Linode must be offline to resize a disk")
+		}
+
+		disk["status"] = linodego.DiskNotReady
+
+		s.startEvent(linodego.ActionDiskResize, linodeEntity(instance), diskEntity(linodeID, disk), func() {
+			disk["size"] = size
+			disk["status"] = linodego.DiskReady
+			disk["updated"] = timestamp()
+		})
+
+		return nil, nil
+	})
+}
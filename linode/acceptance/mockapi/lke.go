@@ -0,0 +1,634 @@
+package mockapi
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+
+	"github.com/linode/linodego"
+)
+
+const (
+	lkeClustersCollection = "lke/clusters"
+
+	lkeEntityType linodego.EntityType = "lkecluster"
+
+	lkeNodeImage       = "linode/debian12"
+	lkeNodeLabelFormat = "lke%d-%d-%x"
+)
+
+func lkePoolsCollection(clusterID int) string {
+	return fmt.Sprintf("lke/clusters/%d/pools", clusterID)
+}
+
+func lkeClusterEntity(cluster Object) Object {
+	id := asInt(cluster["id"])
+
+	return entityRef(
+		id, lkeEntityType, asString(cluster["label"]),
+		fmt.Sprintf("/v4/lke/clusters/%d", id),
+	)
+}
+
+// kubeconfig returns the base64-encoded kubeconfig of the given cluster.
+func kubeconfig(cluster Object) string {
+	id := asInt(cluster["id"])
+
+	config := fmt.Sprintf(`apiVersion: v1
+kind: Config
+preferences: {}
+clusters:
+- cluster:
+    certificate-authority-data: %s
+    server: https://%d.mockapi.lke.linode.com:443
+  name: lke%d
+users:
+- name: lke%d-admin
+  user:
+    token: %s
+contexts:
+- context:
+    cluster: lke%d
+    namespace: default
+    user: lke%d-admin
+  name: lke%d-ctx
+current-context: lke%d-ctx
+`,
+		base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("mockapi-ca-%d", id))),
+		id, id, id, cluster["_kubeconfig_token"], id, id, id, id,
+	)
+
+	return base64.StdEncoding.EncodeToString([]byte(config))
+}
+
+// newLKENodePool creates a node pool on the given cluster.
+func (s *Server) newLKENodePool(cluster, body Object) (Object, error) {
+	linodeType, ok := s.getStaticObject(typesCollection, asString(body["type"]))
+	if !ok {
+		return nil, errBadRequest("type", "A valid plan type by that ID was not found")
+	}
+
+	count := asInt(body["count"])
+
+	autoscaler := Object{
+		"enabled": false,
+		"min":     count,
+		"max":     count,
+	}
+
+	if v := asObject(body["autoscaler"]); v != nil {
+		merge(autoscaler, v, "enabled", "min", "max")
+	}
+
+	if count < 1 && !asBool(autoscaler["enabled"], false) {
+		return nil, errBadRequest("count", "Must be at least 1")
+	}
+
+	labels := asObject(body["labels"])
+	if labels == nil {
+		labels = Object{}
+	}
+
+	taints := asObjectSlice(body["taints"])
+
+	disks := asObjectSlice(body["disks"])
+
+	pool := Object{
+		"id":              s.allocateID(),
+		"count":           count,
+		"type":            linodeType["id"],
+		"disks":           disks,
+		"nodes":           []Object{},
+		"tags":            asStringSlice(body["tags"]),
+		"labels":          labels,
+		"taints":          taints,
+		"autoscaler":      autoscaler,
+		"disk_encryption": linodego.InstanceDiskEncryptionDisabled,
+	}
+
+	s.collection(lkePoolsCollection(asInt(cluster["id"]))).put(pool)
+
+	if err := s.scaleLKENodePool(cluster, pool, count); err != nil {
+		return nil, err
+	}
+
+	return pool, nil
+}
+
+// newLKENode provisions a new node (and its backing instance) in the given pool.
+// The node becomes ready once the instance has been created.
+func (s *Server) newLKENode(cluster, pool Object) (Object, error) {
+	clusterID, poolID := asInt(cluster["id"]), asInt(pool["id"])
+	suffix := s.allocateID()
+
+	instance, err := s.createInstance(Object{
+		"label":     fmt.Sprintf(lkeNodeLabelFormat, clusterID, poolID, suffix),
+		"region":    cluster["region"],
+		"type":      pool["type"],
+		"image":     lkeNodeImage,
+		"root_pass": "mockapi",
+		"tags":      pool["tags"],
+		"booted":    true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	instance["lke_cluster_id"] = clusterID
+
+	node := Object{
+		"id":          fmt.Sprintf("%d-%x", poolID, suffix),
+		"instance_id": instance["id"],
+		"status":      linodego.LKELinodeNotReady,
+	}
+
+	s.schedule(nil, func() {
+		node["status"] = linodego.LKELinodeReady
+	})
+
+	return node, nil
+}
+
+// scaleLKENodePool adds or removes nodes until the given pool has the given count.
+func (s *Server) scaleLKENodePool(cluster, pool Object, count int) error {
+	nodes := asObjectSlice(pool["nodes"])
+
+	for len(nodes) < count {
+		node, err := s.newLKENode(cluster, pool)
+		if err != nil {
+			return err
+		}
+
+		nodes = append(nodes, node)
+	}
+
+	for len(nodes) > count {
+		s.deleteLKENode(nodes[len(nodes)-1])
+		nodes = nodes[:len(nodes)-1]
+	}
+
+	pool["nodes"] = nodes
+	pool["count"] = count
+
+	return nil
+}
+
+// deleteLKENode deletes the instance backing the given node.
+func (s *Server) deleteLKENode(node Object) {
+	if instance, ok := s.collection(instancesCollection).get(asInt(node["instance_id"])); ok {
+		s.deleteInstance(instance)
+	}
+}
+
+// recycleLKENodes replaces the given nodes of a pool with new nodes.
+func (s *Server) recycleLKENodes(cluster, pool Object, recycle func(node Object) bool) error {
+	nodes := asObjectSlice(pool["nodes"])
+
+	for i, node := range nodes {
+		if !recycle(node) {
+			continue
+		}
+
+		s.deleteLKENode(node)
+
+		replacement, err := s.newLKENode(cluster, pool)
+		if err != nil {
+			return err
+		}
+
+		nodes[i] = replacement
+	}
+
+	pool["nodes"] = nodes
+
+	return nil
+}
+
+// findLKENode returns the pool and node with the given node ID on a cluster.
+func (s *Server) findLKENode(clusterID int, nodeID string) (Object, Object, bool) {
+	for _, pool := range s.collection(lkePoolsCollection(clusterID)).list() {
+		for _, node := range asObjectSlice(pool["nodes"]) {
+			if asString(node["id"]) == nodeID {
+				return pool, node, true
+			}
+		}
+	}
+
+	return nil, nil, false
+}
+
+// updateControlPlaneACL applies the given ACL options to a cluster.
+func updateControlPlaneACL(cluster, options Object) {
+	acl := asObject(cluster["_acl"])
+
+	merge(acl, options, "enabled")
+
+	if addresses := asObject(options["addresses"]); addresses != nil {
+		merge(asObject(acl["addresses"]), addresses, "ipv4", "ipv6")
+	}
+}
+
+func (s *Server) registerLKERoutes() {
+	clusters := s.collection(lkeClustersCollection)
+
+	getPool := func(r *http.Request) (Object, Object, error) {
+		cluster, err := s.getObject(r, lkeClustersCollection, "clusterID")
+		if err != nil {
+			return nil, nil, err
+		}
+
+		pool, err := s.getObject(r, lkePoolsCollection(asInt(cluster["id"])), "poolID")
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return cluster, pool, nil
+	}
+
+	s.route("GET lke/clusters", func(r *http.Request) (any, error) {
+		return listResponse(r, clusters.list())
+	})
+
+	s.route("POST lke/clusters", func(r *http.Request) (any, error) {
+		body, err := decodeBody(r)
+		if err != nil {
+			return nil, err
+		}
+
+		region := asString(body["region"])
+		if _, ok := s.getStaticObject(regionsCollection, region); !ok {
+			return nil, errBadRequest("region", "region is not valid")
+		}
+
+		if _, ok := s.getStaticObject(lkeVersionsCollection, asString(body["k8s_version"])); !ok {
+			return nil, errBadRequest("k8s_version", "k8s_version is not valid")
+		}
+
+		pools := asObjectSlice(body["node_pools"])
+		if len(pools) < 1 {
+			return nil, errBadRequest("node_pools", "At least one node pool is required")
+		}
+
+		id := s.allocateID()
+
+		controlPlane := asObject(body["control_plane"])
+
+		cluster := Object{
+			"id":          id,
+			"label":       asString(body["label"]),
+			"region":      region,
+			"status":      linodego.LKEClusterReady,
+			"k8s_version": asString(body["k8s_version"]),
+			"tags":        asStringSlice(body["tags"]),
+			"control_plane": Object{
+				"high_availability": asBool(controlPlane["high_availability"], false),
+			},
+			"created": timestamp(),
+			"updated": timestamp(),
+
+			"_acl": Object{
+				"enabled": false,
+				"addresses": Object{
+					"ipv4": []string{},
+					"ipv6": []string{},
+				},
+			},
+			"_kubeconfig_token": fmt.Sprintf("mockapi-kubeconfig-%d", s.allocateID()),
+			"_service_token":    fmt.Sprintf("mockapi-service-%d", s.allocateID()),
+		}
+
+		if acl := asObject(controlPlane["acl"]); acl != nil {
+			updateControlPlaneACL(cluster, acl)
+		}
+
+		clusters.put(cluster)
+
+		for _, pool := range pools {
+			if _, err := s.newLKENodePool(cluster, pool); err != nil {
+				return nil, err
+			}
+		}
+
+		return cluster, nil
+	})
+
+	s.route("GET lke/clusters/{clusterID}", func(r *http.Request) (any, error) {
+		return s.getObject(r, lkeClustersCollection, "clusterID")
+	})
+
+	s.route("PUT lke/clusters/{clusterID}", func(r *http.Request) (any, error) {
+		cluster, err := s.getObject(r, lkeClustersCollection, "clusterID")
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := decodeBody(r)
+		if err != nil {
+			return nil, err
+		}
+
+		if version, ok := body["k8s_version"]; ok {
+			if _, ok := s.getStaticObject(lkeVersionsCollection, asString(version)); !ok {
+				return nil, errBadRequest("k8s_version", "k8s_version is not valid")
+			}
+		}
+
+		merge(cluster, body, "label", "tags", "k8s_version")
+
+		if controlPlane := asObject(body["control_plane"]); controlPlane != nil {
+			merge(asObject(cluster["control_plane"]), controlPlane, "high_availability")
+
+			if acl := asObject(controlPlane["acl"]); acl != nil {
+				updateControlPlaneACL(cluster, acl)
+			}
+		}
+
+		cluster["updated"] = timestamp()
+
+		return cluster, nil
+	})
+
+	s.route("DELETE lke/clusters/{clusterID}", func(r *http.Request) (any, error) {
+		cluster, err := s.getObject(r, lkeClustersCollection, "clusterID")
+		if err != nil {
+			return nil, err
+		}
+
+		clusterID := asInt(cluster["id"])
+
+		for _, pool := range s.collection(lkePoolsCollection(clusterID)).list() {
+			for _, node := range asObjectSlice(pool["nodes"]) {
+				s.deleteLKENode(node)
+			}
+		}
+
+		clusters.delete(clusterID)
+		delete(s.collections, lkePoolsCollection(clusterID))
+
+		return nil, nil
+	})
+
+	s.route("GET lke/clusters/{clusterID}/api-endpoints", func(r *http.Request) (any, error) {
+		cluster, err := s.getObject(r, lkeClustersCollection, "clusterID")
+		if err != nil {
+			return nil, err
+		}
+
+		id := asInt(cluster["id"])
+
+		return listResponse(r, []Object{
+			{"endpoint": fmt.Sprintf("https://%d.mockapi.lke.linode.com:443", id)},
+			{"endpoint": fmt.Sprintf("https://%d.mockapi.lke.linode.com:6443", id)},
+		})
+	})
+
+	s.route("GET lke/clusters/{clusterID}/dashboard", func(r *http.Request) (any, error) {
+		cluster, err := s.getObject(r, lkeClustersCollection, "clusterID")
+		if err != nil {
+			return nil, err
+		}
+
+		return Object{
+			"url": fmt.Sprintf("https://%d.dashboard.mockapi.lke.linode.com", asInt(cluster["id"])),
+		}, nil
+	})
+
+	s.route("GET lke/clusters/{clusterID}/kubeconfig", func(r *http.Request) (any, error) {
+		cluster, err := s.getObject(r, lkeClustersCollection, "clusterID")
+		if err != nil {
+			return nil, err
+		}
+
+		return Object{"kubeconfig": kubeconfig(cluster)}, nil
+	})
+
+	s.route("DELETE lke/clusters/{clusterID}/kubeconfig", func(r *http.Request) (any, error) {
+		cluster, err := s.getObject(r, lkeClustersCollection, "clusterID")
+		if err != nil {
+			return nil, err
+		}
+
+		cluster["_kubeconfig_token"] = fmt.Sprintf("mockapi-kubeconfig-%d", s.allocateID())
+
+		return nil, nil
+	})
+
+	s.route("DELETE lke/clusters/{clusterID}/servicetoken", func(r *http.Request) (any, error) {
+		cluster, err := s.getObject(r, lkeClustersCollection, "clusterID")
+		if err != nil {
+			return nil, err
+		}
+
+		cluster["_service_token"] = fmt.Sprintf("mockapi-service-%d", s.allocateID())
+
+		return nil, nil
+	})
+
+	s.route("POST lke/clusters/{clusterID}/regenerate", func(r *http.Request) (any, error) {
+		cluster, err := s.getObject(r, lkeClustersCollection, "clusterID")
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := decodeBody(r)
+		if err != nil {
+			return nil, err
+		}
+
+		if asBool(body["kubeconfig"], false) {
+			cluster["_kubeconfig_token"] = fmt.Sprintf("mockapi-kubeconfig-%d", s.allocateID())
+		}
+
+		if asBool(body["servicetoken"], false) {
+			cluster["_service_token"] = fmt.Sprintf("mockapi-service-%d", s.allocateID())
+		}
+
+		return cluster, nil
+	})
+
+	s.route("POST lke/clusters/{clusterID}/recycle", func(r *http.Request) (any, error) {
+		cluster, err := s.getObject(r, lkeClustersCollection, "clusterID")
+		if err != nil {
+			return nil, err
+		}
+
+		for _, pool := range s.collection(lkePoolsCollection(asInt(cluster["id"]))).list() {
+			if err := s.recycleLKENodes(cluster, pool, func(Object) bool { return true }); err != nil {
+				return nil, err
+			}
+		}
+
+		return nil, nil
+	})
+
+	s.route("GET lke/clusters/{clusterID}/control_plane_acl", func(r *http.Request) (any, error) {
+		cluster, err := s.getObject(r, lkeClustersCollection, "clusterID")
+		if err != nil {
+			return nil, err
+		}
+
+		return Object{"acl": cluster["_acl"]}, nil
+	})
+
+	s.route("PUT lke/clusters/{clusterID}/control_plane_acl", func(r *http.Request) (any, error) {
+		cluster, err := s.getObject(r, lkeClustersCollection, "clusterID")
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := decodeBody(r)
+		if err != nil {
+			return nil, err
+		}
+
+		updateControlPlaneACL(cluster, asObject(body["acl"]))
+
+		s.startEvent(linodego.ActionLKEControlPlaneACLUpdate, lkeClusterEntity(cluster), nil, nil)
+
+		return Object{"acl": cluster["_acl"]}, nil
+	})
+
+	s.route("DELETE lke/clusters/{clusterID}/control_plane_acl", func(r *http.Request) (any, error) {
+		cluster, err := s.getObject(r, lkeClustersCollection, "clusterID")
+		if err != nil {
+			return nil, err
+		}
+
+		cluster["_acl"] = Object{
+			"enabled": false,
+			"addresses": Object{
+				"ipv4": []string{},
+				"ipv6": []string{},
+			},
+		}
+
+		s.startEvent(linodego.ActionLKEControlPlaneACLDelete, lkeClusterEntity(cluster), nil, nil)
+
+		return nil, nil
+	})
+
+	s.route("GET lke/clusters/{clusterID}/pools", func(r *http.Request) (any, error) {
+		cluster, err := s.getObject(r, lkeClustersCollection, "clusterID")
+		if err != nil {
+			return nil, err
+		}
+
+		return listResponse(r, s.collection(lkePoolsCollection(asInt(cluster["id"]))).list())
+	})
+
+	s.route("POST lke/clusters/{clusterID}/pools", func(r *http.Request) (any, error) {
+		cluster, err := s.getObject(r, lkeClustersCollection, "clusterID")
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := decodeBody(r)
+		if err != nil {
+			return nil, err
+		}
+
+		return s.newLKENodePool(cluster, body)
+	})
+
+	s.route("GET lke/clusters/{clusterID}/pools/{poolID}", func(r *http.Request) (any, error) {
+		_, pool, err := getPool(r)
+		return pool, err
+	})
+
+	s.route("PUT lke/clusters/{clusterID}/pools/{poolID}", func(r *http.Request) (any, error) {
+		cluster, pool, err := getPool(r)
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := decodeBody(r)
+		if err != nil {
+			return nil, err
+		}
+
+		merge(pool, body, "tags", "labels", "taints")
+
+		if autoscaler := asObject(body["autoscaler"]); autoscaler != nil {
+			merge(asObject(pool["autoscaler"]), autoscaler, "enabled", "min", "max")
+		}
+
+		if count, ok := body["count"]; ok && asInt(count) != asInt(pool["count"]) {
+			if err := s.scaleLKENodePool(cluster, pool, asInt(count)); err != nil {
+				return nil, err
+			}
+		}
+
+		return pool, nil
+	})
+
+	s.route("DELETE lke/clusters/{clusterID}/pools/{poolID}", func(r *http.Request) (any, error) {
+		cluster, pool, err := getPool(r)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, node := range asObjectSlice(pool["nodes"]) {
+			s.deleteLKENode(node)
+		}
+
+		s.collection(lkePoolsCollection(asInt(cluster["id"]))).delete(asInt(pool["id"]))
+
+		return nil, nil
+	})
+
+	s.route("POST lke/clusters/{clusterID}/pools/{poolID}/recycle", func(r *http.Request) (any, error) {
+		cluster, pool, err := getPool(r)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, s.recycleLKENodes(cluster, pool, func(Object) bool { return true })
+	})
+
+	s.route("GET lke/clusters/{clusterID}/nodes/{nodeID}", func(r *http.Request) (any, error) {
+		cluster, err := s.getObject(r, lkeClustersCollection, "clusterID")
+		if err != nil {
+			return nil, err
+		}
+
+		_, node, ok := s.findLKENode(asInt(cluster["id"]), r.PathValue("nodeID"))
+		if !ok {
+			return nil, errNotFound()
+		}
+
+		return node, nil
+	})
+
+	s.route("DELETE lke/clusters/{clusterID}/nodes/{nodeID}", func(r *http.Request) (any, error) {
+		cluster, err := s.getObject(r, lkeClustersCollection, "clusterID")
+		if err != nil {
+			return nil, err
+		}
+
+		pool, node, ok := s.findLKENode(asInt(cluster["id"]), r.PathValue("nodeID"))
+		if !ok {
+			return nil, errNotFound()
+		}
+
+		// Deleting a node causes it to be replaced to maintain the pool count
+		return nil, s.recycleLKENodes(cluster, pool, func(n Object) bool {
+			return n["id"] == node["id"]
+		})
+	})
+
+	s.route("POST lke/clusters/{clusterID}/nodes/{nodeID}/recycle", func(r *http.Request) (any, error) {
+		cluster, err := s.getObject(r, lkeClustersCollection, "clusterID")
+		if err != nil {
+			return nil, err
+		}
+
+		pool, node, ok := s.findLKENode(asInt(cluster["id"]), r.PathValue("nodeID"))
+		if !ok {
+			return nil, errNotFound()
+		}
+
+		return nil, s.recycleLKENodes(cluster, pool, func(n Object) bool {
+			return n["id"] == node["id"]
+		})
+	})
+}
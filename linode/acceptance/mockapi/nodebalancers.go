@@ -0,0 +1,446 @@
+package mockapi
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/linode/linodego"
+)
+
+const nodeBalancersCollection = "nodebalancers"
+
+func nodeBalancerConfigsCollection(nodeBalancerID int) string {
+	return fmt.Sprintf("nodebalancers/%d/configs", nodeBalancerID)
+}
+
+func nodeBalancerNodesCollection(nodeBalancerID, configID int) string {
+	return fmt.Sprintf("nodebalancers/%d/configs/%d/nodes", nodeBalancerID, configID)
+}
+
+func nodeBalancerEntity(nodeBalancer Object) Object {
+	id := asInt(nodeBalancer["id"])
+
+	return entityRef(
+		id, linodego.EntityNodebalancer, asString(nodeBalancer["label"]),
+		fmt.Sprintf("/v4/nodebalancers/%d", id),
+	)
+}
+
+// newNodeBalancerConfig creates a config on the given NodeBalancer.
+func (s *Server) newNodeBalancerConfig(nodeBalancerID int, body Object) (Object, error) {
+	config := Object{
+		"id":              s.allocateID(),
+		"port":            80,
+		"protocol":        linodego.ProtocolHTTP,
+		"proxy_protocol":  linodego.ProxyProtocolNone,
+		"algorithm":       linodego.AlgorithmRoundRobin,
+		"stickiness":      linodego.StickinessNone,
+		"check":           linodego.CheckNone,
+		"check_interval":  0,
+		"check_attempts":  0,
+		"check_path":      "",
+		"check_body":      "",
+		"check_passive":   true,
+		"check_timeout":   0,
+		"cipher_suite":    linodego.CipherRecommended,
+		"nodebalancer_id": nodeBalancerID,
+		"ssl_commonname":  "",
+		"ssl_fingerprint": "",
+		"ssl_cert":        nil,
+		"ssl_key":         nil,
+		"nodes_status": Object{
+			"up":   0,
+			"down": 0,
+		},
+	}
+
+	if err := s.updateNodeBalancerConfig(config, body); err != nil {
+		return nil, err
+	}
+
+	s.collection(nodeBalancerConfigsCollection(nodeBalancerID)).put(config)
+
+	if nodes, ok := body["nodes"]; ok {
+		if err := s.replaceNodeBalancerNodes(config, asObjectSlice(nodes)); err != nil {
+			return nil, err
+		}
+	}
+
+	return config, nil
+}
+
+// updateNodeBalancerConfig applies the given options to a NodeBalancer config.
+func (s *Server) updateNodeBalancerConfig(config, body Object) error {
+	port := asInt(config["port"])
+	if v, ok := body["port"]; ok {
+		port = asInt(v)
+	}
+
+	if port < 1 || port > 65535 {
+		return errBadRequest("port", "Must be between 1 and 65535")
+	}
+
+	merge(
+		config, body,
+		"port", "protocol", "proxy_protocol", "algorithm", "stickiness",
+		"check", "check_interval", "check_attempts", "check_path", "check_body",
+		"check_passive", "check_timeout", "cipher_suite", "ssl_cert", "ssl_key",
+	)
+
+	if asString(config["ssl_cert"]) != "" {
+		config["ssl_commonname"] = "mockapi.example.com"
+		config["ssl_fingerprint"] = "00:00:00:00"
+		config["ssl_cert"] = "<REDACTED>"
+		config["ssl_key"] = "<REDACTED>"
+	}
+
+	return nil
+}
+
+// newNodeBalancerNode creates a backend node on the given NodeBalancer config.
+func (s *Server) newNodeBalancerNode(config, body Object) (Object, error) {
+	if asString(body["address"]) == "" {
+		return nil, errBadRequest("address", "Address is required")
+	}
+
+	if asString(body["label"]) == "" {
+		return nil, errBadRequest("label", "Label is required")
+	}
+
+	node := Object{
+		"id":              s.allocateID(),
+		"address":         asString(body["address"]),
+		"label":           asString(body["label"]),
+		"status":          "Unknown",
+		"weight":          100,
+		"mode":            linodego.ModeAccept,
+		"config_id":       config["id"],
+		"nodebalancer_id": config["nodebalancer_id"],
+	}
+
+	merge(node, body, "weight", "mode")
+
+	s.collection(nodeBalancerNodesCollection(asInt(config["nodebalancer_id"]), asInt(config["id"]))).put(node)
+
+	return node, nil
+}
+
+// replaceNodeBalancerNodes replaces all nodes of a config
+// with the given nodes, preserving nodes with matching IDs.
+func (s *Server) replaceNodeBalancerNodes(config Object, nodes []Object) error {
+	collectionName := nodeBalancerNodesCollection(asInt(config["nodebalancer_id"]), asInt(config["id"]))
+	existing := s.collection(collectionName)
+
+	keep := make(map[int]bool)
+
+	for _, body := range nodes {
+		if node, ok := existing.get(asInt(body["id"])); ok {
+			merge(node, body, "address", "label", "weight", "mode")
+			keep[asInt(node["id"])] = true
+
+			continue
+		}
+
+		node, err := s.newNodeBalancerNode(config, body)
+		if err != nil {
+			return err
+		}
+
+		keep[asInt(node["id"])] = true
+	}
+
+	for _, node := range existing.list() {
+		if !keep[asInt(node["id"])] {
+			existing.delete(asInt(node["id"]))
+		}
+	}
+
+	return nil
+}
+
+func (s *Server) registerNodeBalancerRoutes() {
+	nodeBalancers := s.collection(nodeBalancersCollection)
+
+	s.route("GET nodebalancers", func(r *http.Request) (any, error) {
+		return listResponse(r, nodeBalancers.list())
+	})
+
+	s.route("POST nodebalancers", func(r *http.Request) (any, error) {
+		body, err := decodeBody(r)
+		if err != nil {
+			return nil, err
+		}
+
+		region := asString(body["region"])
+		if _, ok := s.getStaticObject(regionsCollection, region); !ok {
+			return nil, errBadRequest("region", "region is not valid")
+		}
+
+		id := s.allocateID()
+
+		label := asString(body["label"])
+		if label == "" {
+			label = fmt.Sprintf("balancer%d", id)
+		}
+
+		nodeBalancer := Object{
+			"id":                   id,
+			"label":                label,
+			"region":               region,
+			"hostname":             fmt.Sprintf("nb-%d.mockapi.nodebalancer.linode.com", id),
+			"ipv4":                 fmt.Sprintf("198.51.100.%d", id%254+1),
+			"ipv6":                 fmt.Sprintf("2001:db8:1::%x", id),
+			"client_conn_throttle": asInt(body["client_conn_throttle"]),
+			"transfer": Object{
+				"total": nil,
+				"out":   nil,
+				"in":    nil,
+			},
+			"tags":    asStringSlice(body["tags"]),
+			"created": timestamp(),
+			"updated": timestamp(),
+		}
+
+		nodeBalancers.put(nodeBalancer)
+
+		for _, config := range asObjectSlice(body["configs"]) {
+			if _, err := s.newNodeBalancerConfig(id, config); err != nil {
+				return nil, err
+			}
+		}
+
+		if firewallID := asInt(body["firewall_id"]); firewallID != 0 {
+			firewall, ok := s.collection(firewallsCollection).get(firewallID)
+			if !ok {
+				return nil, errBadRequest("firewall_id", "Firewall not found")
+			}
+
+			if _, err := s.addFirewallDevice(firewall, id, string(linodego.FirewallDeviceNodeBalancer)); err != nil {
+				return nil, err
+			}
+		}
+
+		s.startEvent(linodego.ActionNodebalancerCreate, nodeBalancerEntity(nodeBalancer), nil, nil)
+
+		return nodeBalancer, nil
+	})
+
+	s.route("GET nodebalancers/{nodeBalancerID}", func(r *http.Request) (any, error) {
+		return s.getObject(r, nodeBalancersCollection, "nodeBalancerID")
+	})
+
+	s.route("PUT nodebalancers/{nodeBalancerID}", func(r *http.Request) (any, error) {
+		nodeBalancer, err := s.getObject(r, nodeBalancersCollection, "nodeBalancerID")
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := decodeBody(r)
+		if err != nil {
+			return nil, err
+		}
+
+		merge(nodeBalancer, body, "label", "client_conn_throttle", "tags")
+		nodeBalancer["updated"] = timestamp()
+
+		return nodeBalancer, nil
+	})
+
+	s.route("DELETE nodebalancers/{nodeBalancerID}", func(r *http.Request) (any, error) {
+		nodeBalancer, err := s.getObject(r, nodeBalancersCollection, "nodeBalancerID")
+		if err != nil {
+			return nil, err
+		}
+
+		id := asInt(nodeBalancer["id"])
+
+		nodeBalancers.delete(id)
+		s.removeEntityFirewallDevices(id, linodego.FirewallDeviceNodeBalancer)
+
+		s.startEvent(linodego.ActionNodebalancerDelete, nodeBalancerEntity(nodeBalancer), nil, nil)
+
+		return nil, nil
+	})
+
+	s.route("GET nodebalancers/{nodeBalancerID}/firewalls", func(r *http.Request) (any, error) {
+		nodeBalancer, err := s.getObject(r, nodeBalancersCollection, "nodeBalancerID")
+		if err != nil {
+			return nil, err
+		}
+
+		return listResponse(r, s.entityFirewalls(asInt(nodeBalancer["id"]), linodego.FirewallDeviceNodeBalancer))
+	})
+
+	s.registerNodeBalancerConfigRoutes()
+}
+
+func (s *Server) registerNodeBalancerConfigRoutes() {
+	getConfig := func(r *http.Request) (Object, error) {
+		nodeBalancer, err := s.getObject(r, nodeBalancersCollection, "nodeBalancerID")
+		if err != nil {
+			return nil, err
+		}
+
+		return s.getObject(r, nodeBalancerConfigsCollection(asInt(nodeBalancer["id"])), "configID")
+	}
+
+	s.route("GET nodebalancers/{nodeBalancerID}/configs", func(r *http.Request) (any, error) {
+		nodeBalancer, err := s.getObject(r, nodeBalancersCollection, "nodeBalancerID")
+		if err != nil {
+			return nil, err
+		}
+
+		return listResponse(r, s.collection(nodeBalancerConfigsCollection(asInt(nodeBalancer["id"]))).list())
+	})
+
+	s.route("POST nodebalancers/{nodeBalancerID}/configs", func(r *http.Request) (any, error) {
+		nodeBalancer, err := s.getObject(r, nodeBalancersCollection, "nodeBalancerID")
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := decodeBody(r)
+		if err != nil {
+			return nil, err
+		}
+
+		return s.newNodeBalancerConfig(asInt(nodeBalancer["id"]), body)
+	})
+
+	s.route("GET nodebalancers/{nodeBalancerID}/configs/{configID}", func(r *http.Request) (any, error) {
+		return getConfig(r)
+	})
+
+	s.route("PUT nodebalancers/{nodeBalancerID}/configs/{configID}", func(r *http.Request) (any, error) {
+		config, err := getConfig(r)
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := decodeBody(r)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.updateNodeBalancerConfig(config, body); err != nil {
+			return nil, err
+		}
+
+		return config, nil
+	})
+
+	s.route("POST nodebalancers/{nodeBalancerID}/configs/{configID}/rebuild", func(r *http.Request) (any, error) {
+		config, err := getConfig(r)
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := decodeBody(r)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.updateNodeBalancerConfig(config, body); err != nil {
+			return nil, err
+		}
+
+		if err := s.replaceNodeBalancerNodes(config, asObjectSlice(body["nodes"])); err != nil {
+			return nil, err
+		}
+
+		return config, nil
+	})
+
+	s.route("DELETE nodebalancers/{nodeBalancerID}/configs/{configID}", func(r *http.Request) (any, error) {
+		config, err := getConfig(r)
+		if err != nil {
+			return nil, err
+		}
+
+		nodeBalancerID := asInt(config["nodebalancer_id"])
+
+		s.collection(nodeBalancerConfigsCollection(nodeBalancerID)).delete(asInt(config["id"]))
+		delete(s.collections, nodeBalancerNodesCollection(nodeBalancerID, asInt(config["id"])))
+
+		return nil, nil
+	})
+
+	s.route("GET nodebalancers/{nodeBalancerID}/configs/{configID}/nodes", func(r *http.Request) (any, error) {
+		config, err := getConfig(r)
+		if err != nil {
+			return nil, err
+		}
+
+		return listResponse(r, s.collection(
+			nodeBalancerNodesCollection(asInt(config["nodebalancer_id"]), asInt(config["id"])),
+		).list())
+	})
+
+	s.route("POST nodebalancers/{nodeBalancerID}/configs/{configID}/nodes", func(r *http.Request) (any, error) {
+		config, err := getConfig(r)
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := decodeBody(r)
+		if err != nil {
+			return nil, err
+		}
+
+		return s.newNodeBalancerNode(config, body)
+	})
+
+	s.route("GET nodebalancers/{nodeBalancerID}/configs/{configID}/nodes/{nodeID}", func(r *http.Request) (any, error) {
+		config, err := getConfig(r)
+		if err != nil {
+			return nil, err
+		}
+
+		return s.getObject(
+			r, nodeBalancerNodesCollection(asInt(config["nodebalancer_id"]), asInt(config["id"])), "nodeID",
+		)
+	})
+
+	s.route("PUT nodebalancers/{nodeBalancerID}/configs/{configID}/nodes/{nodeID}", func(r *http.Request) (any, error) {
+		config, err := getConfig(r)
+		if err != nil {
+			return nil, err
+		}
+
+		node, err := s.getObject(
+			r, nodeBalancerNodesCollection(asInt(config["nodebalancer_id"]), asInt(config["id"])), "nodeID",
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := decodeBody(r)
+		if err != nil {
+			return nil, err
+		}
+
+		merge(node, body, "address", "label", "weight", "mode")
+
+		return node, nil
+	})
+
+	s.route("DELETE nodebalancers/{nodeBalancerID}/configs/{configID}/nodes/{nodeID}", func(r *http.Request) (any, error) {
+		config, err := getConfig(r)
+		if err != nil {
+			return nil, err
+		}
+
+		nodes := s.collection(nodeBalancerNodesCollection(asInt(config["nodebalancer_id"]), asInt(config["id"])))
+
+		node, err := s.getObject(
+			r, nodeBalancerNodesCollection(asInt(config["nodebalancer_id"]), asInt(config["id"])), "nodeID",
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		nodes.delete(asInt(node["id"]))
+
+		return nil, nil
+	})
+}
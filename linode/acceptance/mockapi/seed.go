@@ -0,0 +1,154 @@
+package mockapi
+
+import (
+	"net/http"
+	"strings"
+)
+
+const (
+	typesCollection       = "linode/types"
+	regionsCollection     = "regions"
+	imagesCollection      = "images"
+	lkeVersionsCollection = "lke/versions"
+)
+
+// seedTypes are the Linode types known to the Server.
+var seedTypes = []struct {
+	id, label, class               string
+	disk, memory, vcpus, transfer  int
+	hourly, monthly, backupMonthly float64
+}{
+	{"g6-nanode-1", "Nanode 1GB", "nanode", 25600, 1024, 1, 1000, 0.0075, 5, 2},
+	{"g6-standard-1", "Linode 2GB", "standard", 51200, 2048, 1, 2000, 0.018, 12, 2.5},
+	{"g6-standard-2", "Linode 4GB", "standard", 81920, 4096, 2, 4000, 0.036, 24, 5},
+	{"g6-standard-4", "Linode 8GB", "standard", 163840, 8192, 4, 5000, 0.072, 48, 10},
+	{"g6-dedicated-2", "Dedicated 4GB", "dedicated", 81920, 4096, 2, 4000, 0.054, 36, 5},
+}
+
+// seedRegions are the regions known to the Server.
+var seedRegions = []struct {
+	id, label, country string
+}{
+	{"us-east", "Newark, NJ", "us"},
+	{"us-ord", "Chicago, IL", "us"},
+	{"eu-west", "London, UK", "gb"},
+}
+
+// seedImages are the public images known to the Server.
+var seedImages = []string{
+	"linode/alpine3.19",
+	"linode/alpine3.20",
+	"linode/debian12",
+	"linode/ubuntu24.04",
+}
+
+// seedLKEVersions are the LKE versions known to the Server.
+var seedLKEVersions = []string{"1.29", "1.30", "1.31"}
+
+// seed populates the Server with static reference data.
+func (s *Server) seed() {
+	for i, t := range seedTypes {
+		s.collection(typesCollection).put(Object{
+			"id":          t.id,
+			"label":       t.label,
+			"class":       t.class,
+			"disk":        t.disk,
+			"memory":      t.memory,
+			"vcpus":       t.vcpus,
+			"gpus":        0,
+			"transfer":    t.transfer,
+			"network_out": 1000 * t.vcpus,
+			"price": Object{
+				"hourly":  t.hourly,
+				"monthly": t.monthly,
+			},
+			"region_prices": []any{},
+			"addons": Object{
+				"backups": Object{
+					"price": Object{
+						"hourly":  t.backupMonthly / 730,
+						"monthly": t.backupMonthly,
+					},
+					"region_prices": []any{},
+				},
+			},
+			"successor": nil,
+			"_key":      i,
+		})
+	}
+
+	for i, r := range seedRegions {
+		s.collection(regionsCollection).put(Object{
+			"id":      r.id,
+			"label":   r.label,
+			"country": r.country,
+			"status":  "ok",
+			"capabilities": []string{
+				"Linodes", "NodeBalancers", "Block Storage", "Object Storage",
+				"Kubernetes", "Cloud Firewall", "Vlans", "VPCs", "Metadata",
+				"Placement Group", "Disk Encryption", "LA Disk Encryption",
+			},
+			"resolvers": Object{
+				"ipv4": "192.0.2.53",
+				"ipv6": "2001:db8::53",
+			},
+			"site_type": "core",
+			"_key":      i,
+		})
+	}
+
+	for i, id := range seedImages {
+		s.collection(imagesCollection).put(Object{
+			"id":           id,
+			"label":        strings.TrimPrefix(id, "linode/"),
+			"description":  "",
+			"type":         "manual",
+			"is_public":    true,
+			"deprecated":   false,
+			"vendor":       "Linode",
+			"size":         1500,
+			"status":       "available",
+			"created":      timestamp(),
+			"created_by":   "linode",
+			"capabilities": []string{"cloud-init"},
+			"_key":         i,
+		})
+	}
+
+	for i, v := range seedLKEVersions {
+		s.collection(lkeVersionsCollection).put(Object{
+			"id":   v,
+			"_key": i,
+		})
+	}
+}
+
+// getStaticObject returns the seeded object with the given string ID.
+func (s *Server) getStaticObject(collectionName, id string) (Object, bool) {
+	for _, obj := range s.collection(collectionName).list() {
+		if obj["id"] == id {
+			return obj, true
+		}
+	}
+
+	return nil, false
+}
+
+func (s *Server) registerStaticRoutes() {
+	for _, c := range []string{typesCollection, regionsCollection, imagesCollection, lkeVersionsCollection} {
+		collectionName := c
+
+		s.route("GET "+collectionName, func(r *http.Request) (any, error) {
+			return listResponse(r, s.collection(collectionName).list())
+		})
+
+		s.route("GET "+collectionName+"/{id...}", func(r *http.Request) (any, error) {
+			obj, ok := s.getStaticObject(collectionName, r.PathValue("id"))
+			if !ok {
+				return nil, errNotFound()
+			}
+
+			return obj, nil
+		})
+	}
+}
@@ -0,0 +1,338 @@
+// Package mockapi implements an in-process fake of the Linode API.
+//
+// The Server simulates the v4 and v4beta endpoints used by this provider
+// with in-memory state, asynchronous event progression and injectable errors,
+// allowing provider behavior to be tested without a Linode account.
+// The provider can be pointed at the Server using its `url` setting.
+package mockapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/linode/linodego"
+)
+
+const (
+	// Token is the access token expected by the Server.
+	Token = "mockapi-token"
+
+	// APIVersion is the API version used by clients returned from Server.Client.
+	APIVersion = "v4"
+
+	defaultPageSize = 100
+	maxPageSize     = 500
+)
+
+// InjectedError describes a synthetic API error returned
+// for requests matching the given method and path pattern.
+type InjectedError struct {
+	// Method is the HTTP method to match. An empty value matches any method.
+	Method string
+
+	// Path is matched against the path of the request URL,
+	// including the API version prefix (e.g. /v4/linode/instances/123).
+	Path *regexp.Regexp
+
+	// Status is the HTTP status code to respond with.
+	Status int
+
+	// Reason is the error reason included in the response body.
+	Reason string
+
+	// Times is the number of requests this error should be returned for.
+	// Values less than 1 will cause the error to be returned indefinitely.
+	Times int
+}
+
+// Request is a record of a request handled by the Server.
+type Request struct {
+	Method string
+	Path   string
+	Filter string
+}
+
+// Server is an in-process fake Linode API server.
+type Server struct {
+	httpServer *httptest.Server
+	mux        *http.ServeMux
+
+	mu          sync.Mutex
+	nextID      int
+	collections map[string]*collection
+	jobs        []*job
+	failEvents  map[linodego.EventAction]int
+	injected    []*InjectedError
+	requests    []Request
+	eventDelay  time.Duration
+}
+
+// NewServer creates and starts a new fake Linode API server.
+// The caller is expected to call Close when finished.
+func NewServer() *Server {
+	s := &Server{
+		mux:         http.NewServeMux(),
+		nextID:      1000,
+		collections: make(map[string]*collection),
+		failEvents:  make(map[linodego.EventAction]int),
+	}
+
+	s.seed()
+
+	s.registerStaticRoutes()
+	s.registerEventRoutes()
+	s.registerLinodeRoutes()
+	s.registerVolumeRoutes()
+	s.registerFirewallRoutes()
+	s.registerNodeBalancerRoutes()
+	s.registerLKERoutes()
+	s.registerVPCRoutes()
+
+	// Unknown endpoints respond the same way the API does
+	s.mux.HandleFunc("/", func(w http.ResponseWriter, _ *http.Request) {
+		writeError(w, errNotFound())
+	})
+
+	s.httpServer = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+
+	return s
+}
+
+// Close shuts down the underlying HTTP server.
+func (s *Server) Close() {
+	s.httpServer.Close()
+}
+
+// URL returns the base URL of the Server, suitable for
+// use in the provider's `url` setting.
+func (s *Server) URL() string {
+	return s.httpServer.URL
+}
+
+// Client returns a linodego client configured to use the Server.
+func (s *Server) Client() *linodego.Client {
+	client := linodego.NewClient(s.httpServer.Client())
+
+	client.SetBaseURL(s.URL())
+	client.SetAPIVersion(APIVersion)
+	client.SetToken(Token)
+	client.SetPollDelay(50 * time.Millisecond)
+	client.SetRetryWaitTime(10 * time.Millisecond)
+	client.SetRetryMaxWaitTime(50 * time.Millisecond)
+
+	return &client
+}
+
+// ProviderConfig returns a provider block configuring
+// the Linode provider to use the Server.
+func (s *Server) ProviderConfig() string {
+	return fmt.Sprintf(`
+provider "linode" {
+  url                    = "%s"
+  api_version            = "%s"
+  token                  = "%s"
+  event_poll_ms          = 50
+  lke_event_poll_ms      = 50
+  lke_node_ready_poll_ms = 50
+  min_retry_delay_ms     = 10
+  max_retry_delay_ms     = 50
+}
+`, s.URL(), APIVersion, Token) // lintignore:AT004
+}
+
+// SetEventDelay sets the amount of time it takes for
+// asynchronous operations (and their events) to finish.
+// Defaults to zero, meaning operations finish on the next request.
+func (s *Server) SetEventDelay(delay time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.eventDelay = delay
+}
+
+// FailNextEvent causes the next event with the given
+// action to end in the failed state.
+func (s *Server) FailNextEvent(action linodego.EventAction) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.failEvents[action]++
+}
+
+// InjectError registers an error to be returned for matching requests.
+func (s *Server) InjectError(e InjectedError) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.injected = append(s.injected, &e)
+}
+
+// Requests returns all requests handled by the Server.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := make([]Request, len(s.requests))
+	copy(result, s.requests)
+
+	return result
+}
+
+// RequestCount returns the number of handled requests
+// matching the given method and path pattern.
+func (s *Server) RequestCount(method string, path *regexp.Regexp) int {
+	count := 0
+
+	for _, r := range s.Requests() {
+		if (method == "" || r.Method == method) && path.MatchString(r.Path) {
+			count++
+		}
+	}
+
+	return count
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{
+		Method: r.Method,
+		Path:   r.URL.Path,
+		Filter: r.Header.Get("X-Filter"),
+	})
+	injected := s.matchInjectedError(r)
+	s.mu.Unlock()
+
+	if injected != nil {
+		writeError(w, &apiError{status: injected.Status, reason: injected.Reason})
+		return
+	}
+
+	if r.Header.Get("Authorization") != "Bearer "+Token {
+		writeError(w, &apiError{status: http.StatusUnauthorized, reason: "Invalid Token"})
+		return
+	}
+
+	s.mux.ServeHTTP(w, r)
+}
+
+func (s *Server) matchInjectedError(r *http.Request) *InjectedError {
+	for i, e := range s.injected {
+		if e.Method != "" && e.Method != r.Method {
+			continue
+		}
+
+		if e.Path != nil && !e.Path.MatchString(r.URL.Path) {
+			continue
+		}
+
+		if e.Times > 0 {
+			e.Times--
+			if e.Times == 0 {
+				s.injected = append(s.injected[:i], s.injected[i+1:]...)
+			}
+		}
+
+		return e
+	}
+
+	return nil
+}
+
+// handlerFunc handles a single API request, returning
+// either the response body or an error.
+type handlerFunc func(r *http.Request) (any, error)
+
+// route registers a handler for the given pattern under every API version.
+// All handlers run while holding the Server lock.
+func (s *Server) route(pattern string, h handlerFunc) {
+	method, path, ok := strings.Cut(pattern, " ")
+	if !ok {
+		panic(fmt.Sprintf("invalid mockapi route %q", pattern))
+	}
+
+	s.mux.HandleFunc(fmt.Sprintf("%s /{version}/%s", method, path), func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		s.advance()
+
+		result, err := h(r)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+
+		if obj, ok := result.(Object); ok {
+			result = normalize(obj)
+		}
+
+		writeJSON(w, http.StatusOK, result)
+	})
+}
+
+type apiError struct {
+	status int
+	field  string
+	reason string
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("[%d] %s", e.status, e.reason)
+}
+
+func errNotFound() error {
+	return &apiError{status: http.StatusNotFound, reason: "Not found"}
+}
+
+func errBadRequest(field, reason string) error {
+	return &apiError{status: http.StatusBadRequest, field: field, reason: reason}
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	apiErr, ok := err.(*apiError)
+	if !ok {
+		apiErr = &apiError{status: http.StatusInternalServerError, reason: err.Error()}
+	}
+
+	body := map[string]any{"reason": apiErr.reason}
+	if apiErr.field != "" {
+		body["field"] = apiErr.field
+	}
+
+	writeJSON(w, apiErr.status, map[string]any{
+		"errors": []any{body},
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	if body == nil {
+		body = map[string]any{}
+	}
+
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func decodeBody(r *http.Request) (Object, error) {
+	result := make(Object)
+
+	if r.Body == nil {
+		return result, nil
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&result); err != nil && !errors.Is(err, io.EOF) {
+		return nil, errBadRequest("", fmt.Sprintf("invalid request body: %s", err))
+	}
+
+	return result, nil
+}
@@ -0,0 +1,255 @@
+//go:build unit
+
+package mockapi_test
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance/mockapi"
+)
+
+func TestServer_instanceLifecycle(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	client := server.Client()
+	ctx := context.Background()
+
+	p, err := client.NewEventPollerWithoutEntity(linodego.EntityLinode, linodego.ActionLinodeCreate)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	instance, err := client.CreateInstance(ctx, linodego.InstanceCreateOptions{
+		Region:   "us-east",
+		Type:     "g6-nanode-1",
+		Label:    "mockapi-test",
+		Image:    "linode/debian12",
+		RootPass: "sup3rs3cr3t!",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if instance.Status != linodego.InstanceProvisioning {
+		t.Fatalf("expected status %s, got %s", linodego.InstanceProvisioning, instance.Status)
+	}
+
+	p.EntityID = instance.ID
+
+	if _, err := p.WaitForFinished(ctx, 10); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.WaitForInstanceStatus(ctx, instance.ID, linodego.InstanceRunning, 10); err != nil {
+		t.Fatal(err)
+	}
+
+	disks, err := client.ListInstanceDisks(ctx, instance.ID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(disks) != 2 {
+		t.Fatalf("expected 2 disks, got %d", len(disks))
+	}
+
+	if disks[0].Size+disks[1].Size != instance.Specs.Disk {
+		t.Fatalf("expected disks to fill %d MB, got %d", instance.Specs.Disk, disks[0].Size+disks[1].Size)
+	}
+
+	configs, err := client.ListInstanceConfigs(ctx, instance.ID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(configs) != 1 || configs[0].Devices.SDA.DiskID != disks[0].ID {
+		t.Fatalf("expected a single config booting from disk %d, got %v", disks[0].ID, configs)
+	}
+
+	if err := client.DeleteInstance(ctx, instance.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.GetInstance(ctx, instance.ID); !linodego.IsNotFound(err) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
+
+func TestServer_failEvent(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	client := server.Client()
+	ctx := context.Background()
+
+	instance, err := client.CreateInstance(ctx, linodego.InstanceCreateOptions{
+		Region: "us-east",
+		Type:   "g6-nanode-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server.FailNextEvent(linodego.ActionDiskCreate)
+
+	p, err := client.NewEventPoller(ctx, instance.ID, linodego.EntityLinode, linodego.ActionDiskCreate)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.CreateInstanceDisk(ctx, instance.ID, linodego.InstanceDiskCreateOptions{
+		Label: "data",
+		Size:  1024,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := p.WaitForFinished(ctx, 10); err == nil {
+		t.Fatal("expected event to fail")
+	}
+}
+
+func TestServer_injectError(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	client := server.Client()
+	ctx := context.Background()
+
+	server.InjectError(mockapi.InjectedError{
+		Method: http.MethodGet,
+		Path:   regexp.MustCompile(`/linode/types/g6-nanode-1$`),
+		Status: http.StatusBadRequest,
+		Reason: "injected",
+		Times:  1,
+	})
+
+	_, err := client.GetType(ctx, "g6-nanode-1")
+	if lerr, ok := err.(*linodego.Error); !ok || lerr.Code != http.StatusBadRequest {
+		t.Fatalf("expected injected error, got %v", err)
+	}
+
+	if _, err := client.GetType(ctx, "g6-nanode-1"); err != nil {
+		t.Fatalf("expected injected error to be exhausted, got %v", err)
+	}
+
+	if count := server.RequestCount(http.MethodGet, regexp.MustCompile(`/linode/types/`)); count != 2 {
+		t.Fatalf("expected 2 requests, got %d", count)
+	}
+}
+
+func TestServer_filterAndPagination(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	client := server.Client()
+	ctx := context.Background()
+
+	for i := 0; i < 30; i++ {
+		tags := []string{"even"}
+		if i%2 == 1 {
+			tags = []string{"odd"}
+		}
+
+		if _, err := client.CreateVolume(ctx, linodego.VolumeCreateOptions{
+			Region: "us-east",
+			Size:   20,
+			Tags:   tags,
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	volumes, err := client.ListVolumes(ctx, linodego.NewListOptions(0, `{"tags": "odd"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(volumes) != 15 {
+		t.Fatalf("expected 15 volumes, got %d", len(volumes))
+	}
+
+	page := linodego.NewListOptions(2, "")
+	page.PageSize = 25
+
+	volumes, err = client.ListVolumes(ctx, page)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(volumes) != 5 || page.Pages != 2 || page.Results != 30 {
+		t.Fatalf("unexpected page: %d volumes, %d pages, %d results", len(volumes), page.Pages, page.Results)
+	}
+}
+
+func TestServer_lkeNodePool(t *testing.T) {
+	server := mockapi.NewServer()
+	defer server.Close()
+
+	client := server.Client()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	cluster, err := client.CreateLKECluster(ctx, linodego.LKEClusterCreateOptions{
+		Label:      "mockapi-test",
+		Region:     "us-east",
+		K8sVersion: "1.31",
+		NodePools: []linodego.LKENodePoolCreateOptions{
+			{Type: "g6-standard-1", Count: 3},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pools, err := client.ListLKENodePools(ctx, cluster.ID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(pools) != 1 || len(pools[0].Linodes) != 3 {
+		t.Fatalf("expected a single pool with 3 nodes, got %v", pools)
+	}
+
+	for {
+		pool, err := client.GetLKENodePool(ctx, cluster.ID, pools[0].ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ready := true
+		for _, node := range pool.Linodes {
+			ready = ready && node.Status == linodego.LKELinodeReady
+		}
+
+		if ready {
+			break
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	instance, err := client.GetInstance(ctx, pools[0].Linodes[0].InstanceID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if instance.LKEClusterID != cluster.ID {
+		t.Fatalf("expected instance to belong to cluster %d, got %d", cluster.ID, instance.LKEClusterID)
+	}
+
+	kubeconfig, err := client.GetLKEClusterKubeconfig(ctx, cluster.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if kubeconfig.KubeConfig == "" {
+		t.Fatal("expected kubeconfig to be populated")
+	}
+}
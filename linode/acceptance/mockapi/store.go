@@ -0,0 +1,261 @@
+package mockapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const timeLayout = "2006-01-02T15:04:05"
+
+// Object is a JSON object as it is returned by the Linode API.
+type Object map[string]any
+
+// collection is an in-memory store of API objects indexed by ID.
+type collection struct {
+	objects map[int]Object
+}
+
+// collection returns the collection with the given name,
+// creating it if it does not yet exist.
+func (s *Server) collection(name string) *collection {
+	c, ok := s.collections[name]
+	if !ok {
+		c = &collection{objects: make(map[int]Object)}
+		s.collections[name] = c
+	}
+
+	return c
+}
+
+// allocateID returns a new ID that is unique across all collections.
+func (s *Server) allocateID() int {
+	s.nextID++
+	return s.nextID
+}
+
+func (c *collection) get(id int) (Object, bool) {
+	obj, ok := c.objects[id]
+	return obj, ok
+}
+
+// put stores the given object under its ID. Objects with non-numeric
+// IDs (e.g. types and regions) are stored under their "_key" value.
+func (c *collection) put(obj Object) {
+	if key, ok := obj["_key"]; ok {
+		c.objects[asInt(key)] = obj
+		return
+	}
+
+	c.objects[asInt(obj["id"])] = obj
+}
+
+func (c *collection) delete(id int) {
+	delete(c.objects, id)
+}
+
+// list returns all objects in the collection ordered by ID.
+func (c *collection) list() []Object {
+	ids := make([]int, 0, len(c.objects))
+	for id := range c.objects {
+		ids = append(ids, id)
+	}
+
+	sort.Ints(ids)
+
+	result := make([]Object, len(ids))
+	for i, id := range ids {
+		result[i] = c.objects[id]
+	}
+
+	return result
+}
+
+// getObject returns the object with the ID in the given path
+// value from the given collection.
+func (s *Server) getObject(r *http.Request, collectionName, pathValue string) (Object, error) {
+	id, err := strconv.Atoi(r.PathValue(pathValue))
+	if err != nil {
+		return nil, errNotFound()
+	}
+
+	obj, ok := s.collection(collectionName).get(id)
+	if !ok {
+		return nil, errNotFound()
+	}
+
+	return obj, nil
+}
+
+// listResponse applies the request's filter and pagination to
+// the given objects and returns a paginated response body.
+func listResponse(r *http.Request, objects []Object) (any, error) {
+	filter, err := parseFilter(r.Header.Get("X-Filter"))
+	if err != nil {
+		return nil, errBadRequest("X-Filter", err.Error())
+	}
+
+	filtered := make([]Object, 0, len(objects))
+	for _, obj := range objects {
+		normalized := normalize(obj)
+		if filter.matches(normalized) {
+			filtered = append(filtered, normalized)
+		}
+	}
+
+	filter.sort(filtered)
+
+	page, pageSize := 1, defaultPageSize
+
+	if v := r.URL.Query().Get("page"); v != "" {
+		if page, err = strconv.Atoi(v); err != nil || page < 1 {
+			return nil, errBadRequest("page", "Must be a positive integer")
+		}
+	}
+
+	if v := r.URL.Query().Get("page_size"); v != "" {
+		if pageSize, err = strconv.Atoi(v); err != nil || pageSize < 25 || pageSize > maxPageSize {
+			return nil, errBadRequest("page_size", fmt.Sprintf("Must be between 25 and %d", maxPageSize))
+		}
+	}
+
+	pages := (len(filtered) + pageSize - 1) / pageSize
+	if pages < 1 {
+		pages = 1
+	}
+
+	start := min((page-1)*pageSize, len(filtered))
+	end := min(start+pageSize, len(filtered))
+
+	return map[string]any{
+		"data":    filtered[start:end],
+		"page":    page,
+		"pages":   pages,
+		"results": len(filtered),
+	}, nil
+}
+
+// normalize returns a deep copy of the given object with all
+// values converted to their generic JSON representations.
+// Top-level keys prefixed with an underscore are internal
+// to the Server and are omitted.
+func normalize(obj Object) Object {
+	public := make(Object, len(obj))
+	for k, v := range obj {
+		if !strings.HasPrefix(k, "_") {
+			public[k] = v
+		}
+	}
+
+	raw, err := json.Marshal(public)
+	if err != nil {
+		panic(fmt.Sprintf("failed to marshal mockapi object: %s", err))
+	}
+
+	var result Object
+	if err := json.Unmarshal(raw, &result); err != nil {
+		panic(fmt.Sprintf("failed to unmarshal mockapi object: %s", err))
+	}
+
+	return result
+}
+
+// merge copies the given keys from the source object to
+// the target object if they are defined.
+func merge(target, source Object, keys ...string) {
+	for _, key := range keys {
+		if v, ok := source[key]; ok {
+			target[key] = v
+		}
+	}
+}
+
+func asInt(v any) int {
+	switch v := v.(type) {
+	case int:
+		return v
+	case float64:
+		return int(v)
+	case json.Number:
+		i, _ := v.Int64()
+		return int(i)
+	case string:
+		i, _ := strconv.Atoi(v)
+		return i
+	}
+
+	return 0
+}
+
+func asString(v any) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+
+	// Handle named string types (e.g. linodego.InstanceStatus)
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.String {
+		return rv.String()
+	}
+
+	return ""
+}
+
+func asBool(v any, defaultValue bool) bool {
+	if b, ok := v.(bool); ok {
+		return b
+	}
+
+	return defaultValue
+}
+
+func asStringSlice(v any) []string {
+	result := make([]string, 0)
+
+	switch v := v.(type) {
+	case []string:
+		result = append(result, v...)
+	case []any:
+		for _, item := range v {
+			result = append(result, asString(item))
+		}
+	}
+
+	return result
+}
+
+func asObjectSlice(v any) []Object {
+	result := make([]Object, 0)
+
+	switch v := v.(type) {
+	case []Object:
+		result = append(result, v...)
+	case []any:
+		for _, item := range v {
+			if obj := asObject(item); obj != nil {
+				result = append(result, obj)
+			}
+		}
+	}
+
+	return result
+}
+
+func asObject(v any) Object {
+	switch v := v.(type) {
+	case Object:
+		return v
+	case map[string]any:
+		return v
+	}
+
+	return nil
+}
+
+func timestamp() string {
+	return time.Now().UTC().Format(timeLayout)
+}
@@ -0,0 +1,251 @@
+package mockapi
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/linode/linodego"
+)
+
+const (
+	volumesCollection = "volumes"
+
+	minVolumeSize = 10
+)
+
+func volumeEntity(volume Object) Object {
+	id := asInt(volume["id"])
+
+	return entityRef(
+		id, linodego.EntityVolume, asString(volume["label"]),
+		fmt.Sprintf("/v4/volumes/%d", id),
+	)
+}
+
+// newVolume creates a volume with the given options.
+func (s *Server) newVolume(body Object) (Object, error) {
+	region := asString(body["region"])
+	linodeID := asInt(body["linode_id"])
+
+	var instance Object
+
+	if linodeID != 0 {
+		var ok bool
+		if instance, ok = s.collection(instancesCollection).get(linodeID); !ok {
+			return nil, errBadRequest("linode_id", "Linode not found")
+		}
+
+		region = asString(instance["region"])
+	}
+
+	if _, ok := s.getStaticObject(regionsCollection, region); !ok {
+		return nil, errBadRequest("region", "region is not valid")
+	}
+
+	size := asInt(body["size"])
+	if size == 0 {
+		size = 20
+	}
+
+	if size < minVolumeSize {
+		return nil, errBadRequest("size", fmt.Sprintf("Must be at least %d", minVolumeSize))
+	}
+
+	id := s.allocateID()
+
+	label := asString(body["label"])
+	if label == "" {
+		label = fmt.Sprintf("volume%d", id)
+	}
+
+	volume := Object{
+		"id":              id,
+		"label":           label,
+		"status":          linodego.VolumeCreating,
+		"region":          region,
+		"size":            size,
+		"linode_id":       nil,
+		"linode_label":    nil,
+		"filesystem_path": fmt.Sprintf("/dev/disk/by-id/scsi-0Linode_Volume_%s", label),
+		"tags":            asStringSlice(body["tags"]),
+		"hardware_type":   "nvme",
+		"encryption":      "disabled",
+		"created":         timestamp(),
+		"updated":         timestamp(),
+	}
+
+	if asString(body["encryption"]) == "enabled" {
+		volume["encryption"] = "enabled"
+	}
+
+	s.collection(volumesCollection).put(volume)
+
+	s.startEvent(linodego.ActionVolumeCreate, volumeEntity(volume), nil, func() {
+		volume["status"] = linodego.VolumeActive
+
+		if instance != nil {
+			volume["linode_id"] = linodeID
+			volume["linode_label"] = instance["label"]
+		}
+	})
+
+	return volume, nil
+}
+
+// detachInstanceVolumes detaches all volumes from the given instance.
+func (s *Server) detachInstanceVolumes(linodeID int) {
+	for _, volume := range s.collection(volumesCollection).list() {
+		if volume["linode_id"] != nil && asInt(volume["linode_id"]) == linodeID {
+			volume["linode_id"] = nil
+			volume["linode_label"] = nil
+		}
+	}
+}
+
+func (s *Server) registerVolumeRoutes() {
+	volumes := s.collection(volumesCollection)
+
+	s.route("GET volumes", func(r *http.Request) (any, error) {
+		return listResponse(r, volumes.list())
+	})
+
+	s.route("POST volumes", func(r *http.Request) (any, error) {
+		body, err := decodeBody(r)
+		if err != nil {
+			return nil, err
+		}
+
+		return s.newVolume(body)
+	})
+
+	s.route("GET volumes/{volumeID}", func(r *http.Request) (any, error) {
+		return s.getObject(r, volumesCollection, "volumeID")
+	})
+
+	s.route("PUT volumes/{volumeID}", func(r *http.Request) (any, error) {
+		volume, err := s.getObject(r, volumesCollection, "volumeID")
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := decodeBody(r)
+		if err != nil {
+			return nil, err
+		}
+
+		merge(volume, body, "label", "tags")
+		volume["updated"] = timestamp()
+
+		return volume, nil
+	})
+
+	s.route("DELETE volumes/{volumeID}", func(r *http.Request) (any, error) {
+		volume, err := s.getObject(r, volumesCollection, "volumeID")
+		if err != nil {
+			return nil, err
+		}
+
+		if volume["linode_id"] != nil {
+			return nil, errBadRequest("", "Volume must be detached before it can be deleted")
+		}
+
+		volumes.delete(asInt(volume["id"]))
+
+		s.startEvent(linodego.ActionVolumeDelete, volumeEntity(volume), nil, nil)
+
+		return nil, nil
+	})
+
+	s.route("POST volumes/{volumeID}/attach", func(r *http.Request) (any, error) {
+		volume, err := s.getObject(r, volumesCollection, "volumeID")
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := decodeBody(r)
+		if err != nil {
+			return nil, err
+		}
+
+		linodeID := asInt(body["linode_id"])
+
+		instance, ok := s.collection(instancesCollection).get(linodeID)
+		if !ok {
+			return nil, errBadRequest("linode_id", "Linode not found")
+		}
+
+		if instance["region"] != volume["region"] {
+			return nil, errBadRequest("linode_id", "Volume and Linode must be in the same region")
+		}
+
+		if volume["linode_id"] != nil {
+			return nil, errBadRequest("linode_id", "Volume is already attached")
+		}
+
+		s.startEvent(linodego.ActionVolumeAttach, volumeEntity(volume), linodeEntity(instance), func() {
+			volume["linode_id"] = linodeID
+			volume["linode_label"] = instance["label"]
+		})
+
+		return volume, nil
+	})
+
+	s.route("POST volumes/{volumeID}/detach", func(r *http.Request) (any, error) {
+		volume, err := s.getObject(r, volumesCollection, "volumeID")
+		if err != nil {
+			return nil, err
+		}
+
+		s.startEvent(linodego.ActionVolumeDetach, volumeEntity(volume), nil, func() {
+			volume["linode_id"] = nil
+			volume["linode_label"] = nil
+		})
+
+		return nil, nil
+	})
+
+	s.route("POST volumes/{volumeID}/resize", func(r *http.Request) (any, error) {
+		volume, err := s.getObject(r, volumesCollection, "volumeID")
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := decodeBody(r)
+		if err != nil {
+			return nil, err
+		}
+
+		size := asInt(body["size"])
+		if size < asInt(volume["size"]) {
+			return nil, errBadRequest("size", "Volumes can only be resized up")
+		}
+
+		volume["status"] = linodego.VolumeResizing
+
+		s.startEvent(linodego.ActionVolumeResize, volumeEntity(volume), nil, func() {
+			volume["size"] = size
+			volume["status"] = linodego.VolumeActive
+		})
+
+		return nil, nil
+	})
+
+	s.route("POST volumes/{volumeID}/clone", func(r *http.Request) (any, error) {
+		source, err := s.getObject(r, volumesCollection, "volumeID")
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := decodeBody(r)
+		if err != nil {
+			return nil, err
+		}
+
+		return s.newVolume(Object{
+			"label":  body["label"],
+			"region": source["region"],
+			"size":   source["size"],
+			"tags":   source["tags"],
+		})
+	})
+}
@@ -0,0 +1,313 @@
+package mockapi
+
+import (
+	"fmt"
+	"net/http"
+	"net/netip"
+)
+
+const vpcsCollection = "vpcs"
+
+func vpcSubnetsCollection(vpcID int) string {
+	return fmt.Sprintf("vpcs/%d/subnets", vpcID)
+}
+
+// findSubnet returns the subnet with the given ID and the ID of its VPC.
+func (s *Server) findSubnet(subnetID int) (Object, int, bool) {
+	for _, vpc := range s.collection(vpcsCollection).list() {
+		vpcID := asInt(vpc["id"])
+
+		if subnet, ok := s.collection(vpcSubnetsCollection(vpcID)).get(subnetID); ok {
+			return subnet, vpcID, true
+		}
+	}
+
+	return nil, 0, false
+}
+
+// allocateSubnetAddress returns the next unused address in the given subnet.
+func (s *Server) allocateSubnetAddress(subnet Object) string {
+	prefix, err := netip.ParsePrefix(asString(subnet["ipv4"]))
+	if err != nil {
+		return ""
+	}
+
+	next := asInt(subnet["_next_address"])
+	if next < 2 {
+		// The first address of a subnet is reserved for the gateway
+		next = 2
+	}
+
+	subnet["_next_address"] = next + 1
+
+	addr := prefix.Masked().Addr()
+	for i := 0; i < next; i++ {
+		addr = addr.Next()
+	}
+
+	return addr.String()
+}
+
+// subnetLinodes returns the instances with interfaces in the given subnet.
+func (s *Server) subnetLinodes(subnetID int) []Object {
+	result := make([]Object, 0)
+
+	for _, instance := range s.collection(instancesCollection).list() {
+		linodeID := asInt(instance["id"])
+		interfaces := make([]Object, 0)
+
+		for _, config := range s.collection(configsCollection(linodeID)).list() {
+			for _, iface := range asObjectSlice(config["interfaces"]) {
+				if iface["subnet_id"] != nil && asInt(iface["subnet_id"]) == subnetID {
+					interfaces = append(interfaces, Object{
+						"id":     iface["id"],
+						"active": iface["active"],
+					})
+				}
+			}
+		}
+
+		if len(interfaces) > 0 {
+			result = append(result, Object{
+				"id":         linodeID,
+				"interfaces": interfaces,
+			})
+		}
+	}
+
+	return result
+}
+
+// subnetResponse returns the given subnet as it is returned by the API.
+func (s *Server) subnetResponse(subnet Object) Object {
+	result := normalize(subnet)
+	result["linodes"] = normalize(Object{"v": s.subnetLinodes(asInt(subnet["id"]))})["v"]
+
+	return result
+}
+
+// vpcResponse returns the given VPC as it is returned by the API.
+func (s *Server) vpcResponse(vpc Object) Object {
+	subnets := make([]Object, 0)
+	for _, subnet := range s.collection(vpcSubnetsCollection(asInt(vpc["id"]))).list() {
+		subnets = append(subnets, s.subnetResponse(subnet))
+	}
+
+	result := normalize(vpc)
+	result["subnets"] = subnets
+
+	return result
+}
+
+// newSubnet creates a subnet in the given VPC.
+func (s *Server) newSubnet(vpc, body Object) (Object, error) {
+	if asString(body["label"]) == "" {
+		return nil, errBadRequest("label", "Label is required")
+	}
+
+	prefix, err := netip.ParsePrefix(asString(body["ipv4"]))
+	if err != nil || !prefix.Addr().Is4() {
+		return nil, errBadRequest("ipv4", "Must be a valid IPv4 range")
+	}
+
+	for _, existing := range s.collection(vpcSubnetsCollection(asInt(vpc["id"]))).list() {
+		other, err := netip.ParsePrefix(asString(existing["ipv4"]))
+		if err == nil && other.Overlaps(prefix) {
+			return nil, errBadRequest("ipv4", "Subnet ranges must not overlap")
+		}
+	}
+
+	subnet := Object{
+		"id":      s.allocateID(),
+		"label":   asString(body["label"]),
+		"ipv4":    prefix.Masked().String(),
+		"created": timestamp(),
+		"updated": timestamp(),
+	}
+
+	s.collection(vpcSubnetsCollection(asInt(vpc["id"]))).put(subnet)
+
+	return subnet, nil
+}
+
+func (s *Server) registerVPCRoutes() {
+	vpcs := s.collection(vpcsCollection)
+
+	getSubnet := func(r *http.Request) (Object, Object, error) {
+		vpc, err := s.getObject(r, vpcsCollection, "vpcID")
+		if err != nil {
+			return nil, nil, err
+		}
+
+		subnet, err := s.getObject(r, vpcSubnetsCollection(asInt(vpc["id"])), "subnetID")
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return vpc, subnet, nil
+	}
+
+	s.route("GET vpcs", func(r *http.Request) (any, error) {
+		result := make([]Object, 0)
+		for _, vpc := range vpcs.list() {
+			result = append(result, s.vpcResponse(vpc))
+		}
+
+		return listResponse(r, result)
+	})
+
+	s.route("POST vpcs", func(r *http.Request) (any, error) {
+		body, err := decodeBody(r)
+		if err != nil {
+			return nil, err
+		}
+
+		region := asString(body["region"])
+		if _, ok := s.getStaticObject(regionsCollection, region); !ok {
+			return nil, errBadRequest("region", "region is not valid")
+		}
+
+		if asString(body["label"]) == "" {
+			return nil, errBadRequest("label", "Label is required")
+		}
+
+		vpc := Object{
+			"id":          s.allocateID(),
+			"label":       asString(body["label"]),
+			"description": asString(body["description"]),
+			"region":      region,
+			"created":     timestamp(),
+			"updated":     timestamp(),
+		}
+
+		vpcs.put(vpc)
+
+		for _, subnet := range asObjectSlice(body["subnets"]) {
+			if _, err := s.newSubnet(vpc, subnet); err != nil {
+				return nil, err
+			}
+		}
+
+		return s.vpcResponse(vpc), nil
+	})
+
+	s.route("GET vpcs/{vpcID}", func(r *http.Request) (any, error) {
+		vpc, err := s.getObject(r, vpcsCollection, "vpcID")
+		if err != nil {
+			return nil, err
+		}
+
+		return s.vpcResponse(vpc), nil
+	})
+
+	s.route("PUT vpcs/{vpcID}", func(r *http.Request) (any, error) {
+		vpc, err := s.getObject(r, vpcsCollection, "vpcID")
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := decodeBody(r)
+		if err != nil {
+			return nil, err
+		}
+
+		merge(vpc, body, "label", "description")
+		vpc["updated"] = timestamp()
+
+		return s.vpcResponse(vpc), nil
+	})
+
+	s.route("DELETE vpcs/{vpcID}", func(r *http.Request) (any, error) {
+		vpc, err := s.getObject(r, vpcsCollection, "vpcID")
+		if err != nil {
+			return nil, err
+		}
+
+		vpcID := asInt(vpc["id"])
+
+		for _, subnet := range s.collection(vpcSubnetsCollection(vpcID)).list() {
+			if len(s.subnetLinodes(asInt(subnet["id"]))) > 0 {
+				return nil, errBadRequest("", "Cannot delete a VPC with assigned Linodes")
+			}
+		}
+
+		vpcs.delete(vpcID)
+		delete(s.collections, vpcSubnetsCollection(vpcID))
+
+		return nil, nil
+	})
+
+	s.route("GET vpcs/{vpcID}/subnets", func(r *http.Request) (any, error) {
+		vpc, err := s.getObject(r, vpcsCollection, "vpcID")
+		if err != nil {
+			return nil, err
+		}
+
+		result := make([]Object, 0)
+		for _, subnet := range s.collection(vpcSubnetsCollection(asInt(vpc["id"]))).list() {
+			result = append(result, s.subnetResponse(subnet))
+		}
+
+		return listResponse(r, result)
+	})
+
+	s.route("POST vpcs/{vpcID}/subnets", func(r *http.Request) (any, error) {
+		vpc, err := s.getObject(r, vpcsCollection, "vpcID")
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := decodeBody(r)
+		if err != nil {
+			return nil, err
+		}
+
+		subnet, err := s.newSubnet(vpc, body)
+		if err != nil {
+			return nil, err
+		}
+
+		return s.subnetResponse(subnet), nil
+	})
+
+	s.route("GET vpcs/{vpcID}/subnets/{subnetID}", func(r *http.Request) (any, error) {
+		_, subnet, err := getSubnet(r)
+		if err != nil {
+			return nil, err
+		}
+
+		return s.subnetResponse(subnet), nil
+	})
+
+	s.route("PUT vpcs/{vpcID}/subnets/{subnetID}", func(r *http.Request) (any, error) {
+		_, subnet, err := getSubnet(r)
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := decodeBody(r)
+		if err != nil {
+			return nil, err
+		}
+
+		merge(subnet, body, "label")
+		subnet["updated"] = timestamp()
+
+		return s.subnetResponse(subnet), nil
+	})
+
+	s.route("DELETE vpcs/{vpcID}/subnets/{subnetID}", func(r *http.Request) (any, error) {
+		vpc, subnet, err := getSubnet(r)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(s.subnetLinodes(asInt(subnet["id"]))) > 0 {
+			return nil, errBadRequest("", "Cannot delete a subnet with assigned Linodes")
+		}
+
+		s.collection(vpcSubnetsCollection(asInt(vpc["id"]))).delete(asInt(subnet["id"]))
+
+		return nil, nil
+	})
+}
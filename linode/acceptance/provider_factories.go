@@ -5,15 +5,18 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/linode/terraform-provider-linode/v2/linode"
 )
 
 var ProtoV5ProviderFactories = map[string]func() (tfprotov5.ProviderServer, error){
 	"linode": func() (tfprotov5.ProviderServer, error) {
 		ctx := context.Background()
 		providers := []func() tfprotov5.ProviderServer{
-			TestAccProviders["linode"].GRPCProvider,
+			linode.GRPCProvider(TestAccProviders["linode"]),
 			providerserver.NewProtocol5(
 				TestAccFrameworkProvider,
 			),
@@ -33,3 +36,9 @@ var HttpExternalProviders = map[string]resource.ExternalProvider{
 		Source: "hashicorp/http",
 	},
 }
+
+// ProtoV6EchoProviderFactories contains the echo provider used to
+// expose the results of ephemeral resources to test checks.
+var ProtoV6EchoProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"echo": echoprovider.NewProviderServer(),
+}
@@ -152,11 +152,22 @@ func TestProvider(t *testing.T) {
 func PreCheck(t *testing.T) {
 	t.Helper()
 
+	// Replayed tests do not make any requests to the Linode API
+	if isReplaying() {
+		return
+	}
+
 	if v := os.Getenv("LINODE_TOKEN"); v == "" {
 		t.Fatal("LINODE_TOKEN must be set for acceptance tests")
 	}
 }
 
+// isReplaying returns whether API interactions are
+// being replayed from a cassette.
+func isReplaying() bool {
+	return helper.CassetteMode(os.Getenv(helper.EnvCassetteMode)) == helper.CassetteModeReplay
+}
+
 func OptInTest(t *testing.T) {
 	t.Helper()
 
@@ -682,7 +693,7 @@ func GetRandomOBJCluster() (string, error) {
 
 func GetTestClient() (*linodego.Client, error) {
 	token := os.Getenv("LINODE_TOKEN")
-	if token == "" {
+	if token == "" && !isReplaying() {
 		return nil, fmt.Errorf("LINODE_TOKEN must be set for acceptance tests")
 	}
 
@@ -692,9 +703,11 @@ func GetTestClient() (*linodego.Client, error) {
 	}
 
 	config := &helper.Config{
-		AccessToken: token,
-		APIVersion:  apiVersion,
-		APIURL:      os.Getenv("LINODE_URL"),
+		AccessToken:  token,
+		APIVersion:   apiVersion,
+		APIURL:       os.Getenv("LINODE_URL"),
+		CassetteMode: helper.CassetteMode(os.Getenv(helper.EnvCassetteMode)),
+		CassettePath: os.Getenv(helper.EnvCassettePath),
 	}
 
 	client, err := config.Client(context.Background())
@@ -30,7 +30,7 @@ func Resource() *schema.Resource {
 func readResource(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*helper.ProviderMeta).Client
 
-	dbID, dbType, err := parseID(d.Id())
+	dbID, dbType, err := ParseID(d.Id())
 	if err != nil {
 		return diag.Errorf("failed to parse database id: %s", err)
 	}
@@ -57,7 +57,7 @@ func createResource(ctx context.Context, d *schema.ResourceData, meta interface{
 	dbID := d.Get("database_id").(int)
 	dbType := d.Get("database_type").(string)
 
-	d.SetId(formatID(dbID, dbType))
+	d.SetId(FormatID(dbID, dbType))
 
 	return updateResource(ctx, d, meta)
 }
@@ -65,7 +65,7 @@ func createResource(ctx context.Context, d *schema.ResourceData, meta interface{
 func updateResource(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*helper.ProviderMeta).Client
 
-	dbID, dbType, err := parseID(d.Id())
+	dbID, dbType, err := ParseID(d.Id())
 	if err != nil {
 		return diag.Errorf("failed to parse database id: %s", err)
 	}
@@ -84,7 +84,7 @@ func updateResource(ctx context.Context, d *schema.ResourceData, meta interface{
 func deleteResource(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*helper.ProviderMeta).Client
 
-	dbID, dbType, err := parseID(d.Id())
+	dbID, dbType, err := ParseID(d.Id())
 	if err != nil {
 		return diag.Errorf("failed to parse database id: %s", err)
 	}
@@ -156,11 +156,15 @@ func getDBAllowListByEngine(ctx context.Context, client linodego.Client, engine
 	return nil, fmt.Errorf("invalid database type: %s", engine)
 }
 
-func formatID(dbID int, dbType string) string {
+// FormatID returns the ID of a linode_database_access_controls resource
+// for the given database.
+func FormatID(dbID int, dbType string) string {
 	return fmt.Sprintf("%d:%s", dbID, dbType)
 }
 
-func parseID(id string) (int, string, error) {
+// ParseID returns the database ID and type from the ID of
+// a linode_database_access_controls resource.
+func ParseID(id string) (int, string, error) {
 	split := strings.Split(id, ":")
 	if len(split) != 2 {
 		return 0, "", fmt.Errorf("invalid number of segments")
@@ -0,0 +1,71 @@
+//go:build integration || databasecredentials
+
+package databasecredentials_test
+
+import (
+	"context"
+	"log"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+	"github.com/linode/terraform-provider-linode/v2/linode/databasecredentials/tmpl"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+var (
+	engineVersion string
+	testRegion    string
+)
+
+func init() {
+	client, err := acceptance.GetTestClient()
+	if err != nil {
+		log.Fatalf("failed to get client: %s", err)
+	}
+
+	v, err := helper.ResolveValidDBEngine(context.Background(), *client, "postgresql")
+	if err != nil {
+		log.Fatalf("failed to get db engine version: %s", err)
+	}
+
+	engineVersion = v.ID
+
+	region, err := acceptance.GetRandomRegionWithCaps([]string{"Managed Databases"}, "core")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	testRegion = region
+}
+
+func TestAccEphemeralResourceDatabaseCredentials_basic(t *testing.T) {
+	acceptance.LongRunningTest(t)
+
+	t.Parallel()
+
+	resName := "echo.test"
+	dbName := acctest.RandomWithPrefix("tf_test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acceptance.PreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acceptance.ProtoV6EchoProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: tmpl.Basic(t, dbName, engineVersion, testRegion),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resName, "data.database_id", "linode_database_postgresql.foobar", "id"),
+					resource.TestCheckResourceAttr(resName, "data.database_type", "postgresql"),
+					resource.TestCheckResourceAttrPair(resName, "data.username", "linode_database_postgresql.foobar", "root_username"),
+					resource.TestCheckResourceAttrPair(resName, "data.password", "linode_database_postgresql.foobar", "root_password"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,83 @@
+package databasecredentials
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+func NewEphemeralResource() ephemeral.EphemeralResource {
+	return &EphemeralResource{
+		BaseEphemeralResource: helper.NewBaseEphemeralResource(
+			helper.BaseEphemeralResourceConfig{
+				Name:   "linode_database_credentials",
+				Schema: &frameworkEphemeralResourceSchema,
+			},
+		),
+	}
+}
+
+type EphemeralResource struct {
+	helper.BaseEphemeralResource
+}
+
+func (r *EphemeralResource) Open(
+	ctx context.Context,
+	req ephemeral.OpenRequest,
+	resp *ephemeral.OpenResponse,
+) {
+	tflog.Debug(ctx, "Open ephemeral.linode_database_credentials")
+
+	client := r.Meta.Client
+
+	var data EphemeralModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	databaseID := helper.FrameworkSafeInt64ToInt(data.DatabaseID.ValueInt64(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = helper.SetLogFieldBulk(ctx, map[string]any{
+		"database_id":   databaseID,
+		"database_type": data.DatabaseType.ValueString(),
+	})
+
+	switch data.DatabaseType.ValueString() {
+	case "mysql":
+		tflog.Trace(ctx, "client.GetMySQLDatabaseCredentials(...)")
+
+		creds, err := client.GetMySQLDatabaseCredentials(ctx, databaseID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed to get credentials for MySQL database %d", databaseID),
+				err.Error(),
+			)
+			return
+		}
+
+		data.FlattenCredentials(creds.Username, creds.Password)
+	case "postgresql":
+		tflog.Trace(ctx, "client.GetPostgresDatabaseCredentials(...)")
+
+		creds, err := client.GetPostgresDatabaseCredentials(ctx, databaseID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed to get credentials for PostgreSQL database %d", databaseID),
+				err.Error(),
+			)
+			return
+		}
+
+		data.FlattenCredentials(creds.Username, creds.Password)
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,34 @@
+package databasecredentials
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+var frameworkEphemeralResourceSchema = schema.Schema{
+	Description: "Retrieves the root credentials of a Managed Database without persisting them to the Terraform state.",
+	Attributes: map[string]schema.Attribute{
+		"database_id": schema.Int64Attribute{
+			Description: "The ID of the Managed Database.",
+			Required:    true,
+		},
+		"database_type": schema.StringAttribute{
+			Description: "The type of the Managed Database.",
+			Required:    true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(helper.ValidDatabaseTypes...),
+			},
+		},
+		"username": schema.StringAttribute{
+			Description: "The root username for the Managed Database.",
+			Computed:    true,
+		},
+		"password": schema.StringAttribute{
+			Description: "The randomly-generated root password for the Managed Database.",
+			Computed:    true,
+			Sensitive:   true,
+		},
+	},
+}
@@ -0,0 +1,19 @@
+package databasecredentials
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// EphemeralModel describes the Terraform ephemeral resource data model to match the
+// ephemeral resource schema.
+type EphemeralModel struct {
+	DatabaseID   types.Int64  `tfsdk:"database_id"`
+	DatabaseType types.String `tfsdk:"database_type"`
+	Username     types.String `tfsdk:"username"`
+	Password     types.String `tfsdk:"password"`
+}
+
+func (m *EphemeralModel) FlattenCredentials(username, password string) {
+	m.Username = types.StringValue(username)
+	m.Password = types.StringValue(password)
+}
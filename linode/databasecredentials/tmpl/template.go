@@ -0,0 +1,17 @@
+package tmpl
+
+import (
+	"testing"
+
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+	postgresql "github.com/linode/terraform-provider-linode/v2/linode/databasepostgresql/tmpl"
+)
+
+func Basic(t *testing.T, label, engine, region string) string {
+	return acceptance.ExecuteTemplate(t,
+		"database_credentials_basic", postgresql.TemplateData{
+			Engine: engine,
+			Label:  label,
+			Region: region,
+		})
+}
@@ -2,13 +2,22 @@ package linode
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/linode/terraform-provider-linode/v2/linode/vpcips"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/linode/terraform-provider-linode/v2/linode/account"
 	"github.com/linode/terraform-provider-linode/v2/linode/accountavailabilities"
 	"github.com/linode/terraform-provider-linode/v2/linode/accountavailability"
@@ -19,6 +28,7 @@ import (
 	"github.com/linode/terraform-provider-linode/v2/linode/childaccount"
 	"github.com/linode/terraform-provider-linode/v2/linode/childaccounts"
 	"github.com/linode/terraform-provider-linode/v2/linode/databasebackups"
+	"github.com/linode/terraform-provider-linode/v2/linode/databasecredentials"
 	"github.com/linode/terraform-provider-linode/v2/linode/databaseengines"
 	"github.com/linode/terraform-provider-linode/v2/linode/databasemysql"
 	"github.com/linode/terraform-provider-linode/v2/linode/databasepostgresql"
@@ -30,13 +40,21 @@ import (
 	"github.com/linode/terraform-provider-linode/v2/linode/firewall"
 	"github.com/linode/terraform-provider-linode/v2/linode/firewalldevice"
 	"github.com/linode/terraform-provider-linode/v2/linode/firewalls"
+	"github.com/linode/terraform-provider-linode/v2/linode/functions"
 	"github.com/linode/terraform-provider-linode/v2/linode/helper"
 	"github.com/linode/terraform-provider-linode/v2/linode/image"
 	"github.com/linode/terraform-provider-linode/v2/linode/images"
+	"github.com/linode/terraform-provider-linode/v2/linode/instancebackuprestore"
 	"github.com/linode/terraform-provider-linode/v2/linode/instancedisk"
+	"github.com/linode/terraform-provider-linode/v2/linode/instanceinterface"
 	"github.com/linode/terraform-provider-linode/v2/linode/instanceip"
 	"github.com/linode/terraform-provider-linode/v2/linode/instancenetworking"
+	"github.com/linode/terraform-provider-linode/v2/linode/instancerescue"
+	"github.com/linode/terraform-provider-linode/v2/linode/instances"
 	"github.com/linode/terraform-provider-linode/v2/linode/instancesharedips"
+	"github.com/linode/terraform-provider-linode/v2/linode/instancesnapshot"
+	"github.com/linode/terraform-provider-linode/v2/linode/instancestats"
+	"github.com/linode/terraform-provider-linode/v2/linode/instancetransfer"
 	"github.com/linode/terraform-provider-linode/v2/linode/instancetype"
 	"github.com/linode/terraform-provider-linode/v2/linode/instancetypes"
 	"github.com/linode/terraform-provider-linode/v2/linode/ipv6range"
@@ -44,6 +62,7 @@ import (
 	"github.com/linode/terraform-provider-linode/v2/linode/kernel"
 	"github.com/linode/terraform-provider-linode/v2/linode/kernels"
 	"github.com/linode/terraform-provider-linode/v2/linode/lke"
+	"github.com/linode/terraform-provider-linode/v2/linode/lkeclustercontrolplaneacl"
 	"github.com/linode/terraform-provider-linode/v2/linode/lkeclusters"
 	"github.com/linode/terraform-provider-linode/v2/linode/lkenodepool"
 	"github.com/linode/terraform-provider-linode/v2/linode/lkeversions"
@@ -56,6 +75,7 @@ import (
 	"github.com/linode/terraform-provider-linode/v2/linode/objbucket"
 	"github.com/linode/terraform-provider-linode/v2/linode/objcluster"
 	"github.com/linode/terraform-provider-linode/v2/linode/objkey"
+	"github.com/linode/terraform-provider-linode/v2/linode/objtempkey"
 	"github.com/linode/terraform-provider-linode/v2/linode/placementgroup"
 	"github.com/linode/terraform-provider-linode/v2/linode/placementgroupassignment"
 	"github.com/linode/terraform-provider-linode/v2/linode/placementgroups"
@@ -84,13 +104,24 @@ type FrameworkProvider struct {
 	Meta            *helper.FrameworkProviderMeta
 }
 
+var (
+	_ provider.ProviderWithFunctions          = &FrameworkProvider{}
+	_ provider.ProviderWithEphemeralResources = &FrameworkProvider{}
+)
+
 // CreateFrameworkProviderWithMeta is used by the crossplane provider
 func CreateFrameworkProviderWithMeta(version string, meta *helper.ProviderMeta) provider.ProviderWithValidateConfig {
+	eventWatcher := meta.EventWatcher
+	if eventWatcher == nil {
+		eventWatcher = helper.GetEventWatcher(&meta.Client)
+	}
+
 	return &FrameworkProvider{
 		ProviderVersion: version,
 		Meta: &helper.FrameworkProviderMeta{
-			Client: &meta.Client,
-			Config: helper.GetFrameworkProviderModelFromSDKv2ProviderConfig(meta.Config),
+			Client:       &meta.Client,
+			Config:       helper.GetFrameworkProviderModelFromSDKv2ProviderConfig(meta.Config),
+			EventWatcher: eventWatcher,
 		},
 	}
 }
@@ -195,6 +226,131 @@ func (p *FrameworkProvider) Schema(
 				Description: "If true, when deleting a linode_object_storage_bucket any objects " +
 					"and versions will be force deleted.",
 			},
+			"cassette_mode": schema.StringAttribute{
+				Optional: true,
+				Description: "If set, API interactions will be recorded to or replayed from " +
+					"the cassette at cassette_path. (record, replay)",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(helper.CassetteModeRecord),
+						string(helper.CassetteModeReplay),
+					),
+				},
+			},
+			"cassette_path": schema.StringAttribute{
+				Optional:    true,
+				Description: "The path of the cassette file to record API interactions to or replay them from.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"retry": schema.ListNestedBlock{
+				Description: "An additional set of conditions under which failed API requests will be retried.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"status_codes": schema.ListAttribute{
+							ElementType: types.Int64Type,
+							Required:    true,
+							Description: "The response status codes to retry on.",
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+								listvalidator.ValueInt64sAre(int64validator.Between(400, 599)),
+							},
+						},
+						"path_patterns": schema.ListAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Regular expressions matched against the request path. " +
+								"If unset, requests to all paths will be retried.",
+							Validators: []validator.List{
+								listvalidator.ValueStringsAre(helper.ValidRegex()),
+							},
+						},
+						"methods": schema.ListAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "The HTTP methods to retry. " +
+								"If unset, requests with any method will be retried.",
+							Validators: []validator.List{
+								listvalidator.ValueStringsAre(stringvalidator.OneOf(
+									http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete,
+								)),
+							},
+						},
+						"max_attempts": schema.Int64Attribute{
+							Optional: true,
+							Description: "The maximum number of attempts for a matching request. " +
+								"If unset, matching requests will be retried up to the API client's default retry limit.",
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+					},
+				},
+			},
+			"rate_limit": schema.ListNestedBlock{
+				Description: "Limits the rate and concurrency of API requests in a given endpoint and operation class.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"endpoint": schema.StringAttribute{
+							Optional: true,
+							Description: "The class of API endpoints this limit applies to. (compute, object_storage) " +
+								"If unset, the limit applies to all endpoints.",
+							Validators: []validator.String{
+								stringvalidator.OneOf(
+									string(helper.RateLimitEndpointCompute),
+									string(helper.RateLimitEndpointObjectStorage),
+								),
+							},
+						},
+						"operation": schema.StringAttribute{
+							Optional: true,
+							Description: "The class of API operations this limit applies to. (read, write) " +
+								"If unset, the limit applies to all operations.",
+							Validators: []validator.String{
+								stringvalidator.OneOf(
+									string(helper.RateLimitOperationRead),
+									string(helper.RateLimitOperationWrite),
+								),
+							},
+						},
+						"requests_per_second": schema.Float64Attribute{
+							Optional:    true,
+							Description: "The number of requests per second allowed by the token bucket.",
+							Validators: []validator.Float64{
+								float64validator.AtLeast(0),
+							},
+						},
+						"burst": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of requests allowed to burst above the rate. (default: requests_per_second)",
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"max_in_flight": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of concurrent requests.",
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+					},
+				},
+			},
+			"child_account": schema.ListNestedBlock{
+				Description: "Manage resources in the given child account using a proxy user token minted by the parent account.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"euuid": schema.StringAttribute{
+							Required:    true,
+							Description: "The EUUID of the child account.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -216,7 +372,12 @@ func (p *FrameworkProvider) Resources(ctx context.Context) []func() resource.Res
 		volume.NewResource,
 		instancesharedips.NewResource,
 		instancedisk.NewResource,
+		instanceinterface.NewResource,
+		instancesnapshot.NewResource,
+		instancebackuprestore.NewResource,
+		instancerescue.NewResource,
 		lkenodepool.NewResource,
+		lkeclustercontrolplaneacl.NewResource,
 		image.NewResource,
 		nbconfig.NewResource,
 		firewall.NewResource,
@@ -244,6 +405,8 @@ func (p *FrameworkProvider) DataSources(ctx context.Context) []func() datasource
 		sshkey.NewDataSource,
 		sshkeys.NewDataSource,
 		instancenetworking.NewDataSource,
+		instancestats.NewDataSource,
+		instancetransfer.NewDataSource,
 		objcluster.NewDataSource,
 		domainrecord.NewDataSource,
 		databasepostgresql.NewDataSource,
@@ -286,5 +449,25 @@ func (p *FrameworkProvider) DataSources(ctx context.Context) []func() datasource
 		placementgroups.NewDataSource,
 		childaccount.NewDataSource,
 		childaccounts.NewDataSource,
+		instances.NewDataSource,
+	}
+}
+
+func (p *FrameworkProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		lke.NewEphemeralResource,
+		objtempkey.NewEphemeralResource,
+		databasecredentials.NewEphemeralResource,
+		token.NewEphemeralResource,
+	}
+}
+
+func (p *FrameworkProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		functions.NewParseBucketIDFunction,
+		functions.NewParseNodePoolIDFunction,
+		functions.NewParseDatabaseACLIDFunction,
+		functions.NewIPv6RangeToAddressesFunction,
+		functions.NewInstanceTypeFitsDisksFunction,
 	}
 }
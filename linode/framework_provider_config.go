@@ -2,22 +2,11 @@ package linode
 
 import (
 	"context"
-	"fmt"
-	"log"
-	"net/http"
 	"net/url"
-	"os"
-	"strconv"
-	"strings"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
-	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
-	"github.com/linode/linodego"
 	"github.com/linode/terraform-provider-linode/v2/linode/helper"
 )
 
@@ -34,11 +23,6 @@ func (fp *FrameworkProvider) Configure(
 		return
 	}
 
-	fp.HandleDefaults(&data, &resp.Diagnostics)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
 	fp.InitProvider(ctx, &data, req.TerraformVersion, &resp.Diagnostics, &meta)
 	if resp.Diagnostics.HasError() {
 		return
@@ -46,6 +30,7 @@ func (fp *FrameworkProvider) Configure(
 
 	resp.ResourceData = &meta
 	resp.DataSourceData = &meta
+	resp.EphemeralResourceData = &meta
 
 	fp.Meta = &meta
 }
@@ -76,141 +61,6 @@ func (fp *FrameworkProvider) ValidateConfig(
 	}
 }
 
-func GetIntFromEnv(
-	key string,
-	defaultValue basetypes.Int64Value,
-	diags *diag.Diagnostics,
-) basetypes.Int64Value {
-	envVarVal := os.Getenv(key)
-	if envVarVal == "" {
-		return defaultValue
-	}
-
-	intVal, err := strconv.ParseInt(envVarVal, 10, 64)
-	if err != nil {
-		diags.AddWarning(
-			fmt.Sprintf(
-				"Failed to parse the environment variable %v "+
-					"to an integer. Will use default value: %v instead",
-				key,
-				defaultValue.ValueInt64(),
-			),
-			err.Error(),
-		)
-
-		return defaultValue
-	}
-
-	return types.Int64Value(intVal)
-}
-
-func GetStringFromEnv(key string, defaultValue basetypes.StringValue) basetypes.StringValue {
-	envVarVal := os.Getenv(key)
-
-	if envVarVal == "" {
-		return defaultValue
-	}
-
-	return types.StringValue(envVarVal)
-}
-
-func (fp *FrameworkProvider) HandleDefaults(
-	lpm *helper.FrameworkProviderModel,
-	diags *diag.Diagnostics,
-) {
-	if lpm.AccessToken.IsNull() {
-		lpm.AccessToken = GetStringFromEnv("LINODE_TOKEN", types.StringNull())
-	}
-
-	if lpm.ConfigPath.IsNull() {
-		homeDir, err := os.UserHomeDir()
-		if err != nil {
-			diags.AddError(
-				"Failed to get the home directory of the user for the config path.",
-				err.Error(),
-			)
-			return
-		}
-		configPath := fmt.Sprintf("%s/.config/linode", homeDir)
-		lpm.ConfigPath = types.StringValue(configPath)
-	}
-
-	if lpm.ConfigProfile.IsNull() {
-		lpm.ConfigProfile = types.StringValue("default")
-	}
-
-	if lpm.APIURL.IsNull() {
-		lpm.APIURL = GetStringFromEnv(
-			"LINODE_URL",
-			types.StringValue(helper.DefaultLinodeURL),
-		)
-	}
-
-	if lpm.UAPrefix.IsNull() {
-		lpm.UAPrefix = GetStringFromEnv("LINODE_UA_PREFIX", types.StringNull())
-	}
-
-	if lpm.APIVersion.IsNull() {
-		lpm.APIVersion = GetStringFromEnv(
-			"LINODE_API_VERSION",
-			types.StringValue("v4"),
-		)
-	}
-
-	if lpm.SkipInstanceReadyPoll.IsNull() {
-		lpm.SkipInstanceReadyPoll = types.BoolValue(false)
-	}
-
-	if lpm.SkipInstanceDeletePoll.IsNull() {
-		lpm.SkipInstanceDeletePoll = types.BoolValue(false)
-	}
-
-	if lpm.SkipImplicitReboots.IsNull() {
-		lpm.SkipImplicitReboots = types.BoolValue(false)
-	}
-
-	if lpm.DisableInternalCache.IsNull() {
-		lpm.DisableInternalCache = types.BoolValue(false)
-	}
-
-	if lpm.EventPollMilliseconds.IsNull() {
-		lpm.EventPollMilliseconds = GetIntFromEnv(
-			"LINODE_EVENT_POLL_MS",
-			types.Int64Value(4000),
-			diags,
-		)
-	}
-
-	if lpm.LKEEventPollMilliseconds.IsNull() {
-		lpm.LKEEventPollMilliseconds = types.Int64Value(3000)
-	}
-
-	if lpm.LKENodeReadyPollMilliseconds.IsNull() {
-		lpm.LKENodeReadyPollMilliseconds = types.Int64Value(3000)
-	}
-
-	if lpm.ObjAccessKey.IsNull() {
-		lpm.ObjAccessKey = GetStringFromEnv(
-			"LINODE_OBJ_ACCESS_KEY",
-			types.StringNull(),
-		)
-	}
-
-	if lpm.ObjSecretKey.IsNull() {
-		lpm.ObjSecretKey = GetStringFromEnv(
-			"LINODE_OBJ_SECRET_KEY",
-			types.StringNull(),
-		)
-	}
-
-	if lpm.ObjUseTempKeys.IsNull() {
-		lpm.ObjUseTempKeys = types.BoolValue(false)
-	}
-	if lpm.ObjBucketForceDelete.IsNull() {
-		lpm.ObjBucketForceDelete = types.BoolValue(false)
-	}
-}
-
 func (fp *FrameworkProvider) InitProvider(
 	ctx context.Context,
 	lpm *helper.FrameworkProviderModel,
@@ -226,115 +76,32 @@ func (fp *FrameworkProvider) InitProvider(
 		tflog.Info(ctx, "Linode client was already configured, re-using..")
 		meta.Client = fp.Meta.Client
 		meta.Config = fp.Meta.Config
+		meta.EventWatcher = fp.Meta.EventWatcher
 		return
 	}
 
-	loggingTransport := helper.NewAPILoggerTransport(
-		logging.NewSubsystemLoggingHTTPTransport(
-			helper.APILoggerSubsystem,
-			http.DefaultTransport,
-		),
-	)
-
-	oauth2Client := &http.Client{
-		Transport: loggingTransport,
-	}
-
-	accessToken := lpm.AccessToken.ValueString()
-	APIURL := lpm.APIURL.ValueString()
-	APIVersion := lpm.APIVersion.ValueString()
-	UAPrefix := lpm.UAPrefix.ValueString()
-
-	configPath := lpm.ConfigPath.ValueString()
-	configProfile := lpm.ConfigProfile.ValueString()
-
-	// skipInstanceReadyPoll := lpm.SkipInstanceReadyPoll.ValueBool()
-	// skipInstanceDeletePoll := lpm.SkipInstanceDeletePoll.ValueBool()
-
-	disableInternalCache := lpm.DisableInternalCache.ValueBool()
-
-	minRetryDelayMilliseconds := lpm.MinRetryDelayMilliseconds.ValueInt64()
-	maxRetryDelayMilliseconds := lpm.MaxRetryDelayMilliseconds.ValueInt64()
-
-	eventPollMilliseconds := lpm.EventPollMilliseconds.ValueInt64()
-	// LKENodeReadyPollMilliseconds := lpm.LKEEventPollMilliseconds.ValueInt64()
-
-	client := linodego.NewClient(oauth2Client)
-	// Load the config file if it exists
-	if _, err := os.Stat(configPath); err == nil {
-		tflog.Info(ctx, "Using Linode profile", map[string]any{
-			"config_path": lpm.ConfigPath,
-		})
-		err = client.LoadConfig(&linodego.LoadConfigOptions{
-			Path:    configPath,
-			Profile: configProfile,
-		})
-		if err != nil {
-			diags.AddError("Error occurs when loading linode profile.", err.Error())
-			return
-		}
-	} else {
-		tflog.Info(ctx, "Linode config does not exist, skipping..")
-	}
-
-	// Overrides
-	if accessToken != "" {
-		client.SetToken(accessToken)
-	}
-
-	if APIURL != "" {
-		client.SetBaseURL(APIURL)
-	}
-
-	if len(APIVersion) > 0 {
-		client.SetAPIVersion(APIVersion)
-	}
+	// The configuration is resolved and the client is initialized
+	// the same way as the SDKv2 provider so both providers share a single client.
+	config := helper.GetConfigFromFrameworkProviderModel(lpm)
+	config.TerraformVersion = tfVersion
 
-	client.UseCache(!disableInternalCache)
-
-	if eventPollMilliseconds != 0 {
-		client.SetPollDelay(time.Duration(eventPollMilliseconds) * time.Millisecond)
+	warnings, err := config.ApplyDefaults()
+	for _, warning := range warnings {
+		diags.AddWarning(warning, "")
 	}
 
-	if minRetryDelayMilliseconds != 0 {
-		client.SetRetryWaitTime(time.Duration(minRetryDelayMilliseconds) * time.Millisecond)
-	}
-	if maxRetryDelayMilliseconds != 0 {
-		client.SetRetryMaxWaitTime(time.Duration(maxRetryDelayMilliseconds) * time.Millisecond)
-	}
-
-	userAgent := fp.terraformUserAgent(tfVersion, UAPrefix)
-	client.SetUserAgent(userAgent)
-
-	helper.ApplyAllRetryConditions(&client)
-
-	meta.Config = lpm
-	meta.Client = &client
-}
-
-func (fp *FrameworkProvider) terraformUserAgent(
-	tfVersion string,
-	UAPrefix string,
-) string {
-	userAgent := strings.TrimSpace(
-		fmt.Sprintf(
-			"HashiCorp Terraform/%s (+https://www.terraform.io) "+
-				"Terraform-Plugin-Framework/%s terraform-provider-linode/%s",
-			tfVersion, helper.GetFrameworkVersion(), fp.ProviderVersion,
-		),
-	)
-
-	if add := os.Getenv(helper.UAEnvVar); add != "" {
-		add = strings.TrimSpace(add)
-		if len(add) > 0 {
-			userAgent += " " + add
-			log.Printf("[DEBUG] Using modified User-Agent: %s", userAgent)
-		}
+	if err != nil {
+		diags.AddError("Failed to resolve the provider configuration.", err.Error())
+		return
 	}
 
-	if UAPrefix != "" {
-		userAgent = UAPrefix + " " + userAgent
+	client, err := config.SharedClient(ctx)
+	if err != nil {
+		diags.AddError("Failed to initialize the Linode client.", err.Error())
+		return
 	}
 
-	return userAgent
+	meta.Config = helper.GetFrameworkProviderModelFromSDKv2ProviderConfig(config)
+	meta.Client = client
+	meta.EventWatcher = helper.GetEventWatcher(client)
 }
@@ -0,0 +1,171 @@
+//go:build unit
+
+package functions_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/terraform-provider-linode/v2/linode/functions"
+)
+
+func runFunction(
+	t *testing.T,
+	newFunction func() function.Function,
+	arguments ...attr.Value,
+) (attr.Value, *function.FuncError) {
+	t.Helper()
+
+	ctx := context.Background()
+	f := newFunction()
+
+	var definitionResp function.DefinitionResponse
+	f.Definition(ctx, function.DefinitionRequest{}, &definitionResp)
+
+	if definitionResp.Diagnostics.HasError() {
+		t.Fatalf("invalid function definition: %v", definitionResp.Diagnostics)
+	}
+
+	result, err := definitionResp.Definition.Return.NewResultData(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp := function.RunResponse{
+		Result: result,
+	}
+
+	f.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData(arguments)}, &resp)
+
+	return resp.Result.Value(), resp.Error
+}
+
+func TestParseBucketID(t *testing.T) {
+	result, err := runFunction(t, functions.NewParseBucketIDFunction, types.StringValue("us-mia-1:my-bucket"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	attrs := result.(types.Object).Attributes()
+	if attrs["cluster_or_region"].(types.String).ValueString() != "us-mia-1" ||
+		attrs["label"].(types.String).ValueString() != "my-bucket" {
+		t.Fatalf("unexpected result: %v", result)
+	}
+
+	if _, err := runFunction(t, functions.NewParseBucketIDFunction, types.StringValue("my-bucket")); err == nil {
+		t.Fatal("expected error for invalid bucket ID")
+	}
+}
+
+func TestParseNodePoolID(t *testing.T) {
+	result, err := runFunction(t, functions.NewParseNodePoolIDFunction, types.StringValue("123, 456"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	attrs := result.(types.Object).Attributes()
+	if attrs["cluster_id"].(types.Int64).ValueInt64() != 123 || attrs["pool_id"].(types.Int64).ValueInt64() != 456 {
+		t.Fatalf("unexpected result: %v", result)
+	}
+
+	for _, id := range []string{"123", "123,abc", "123,456,789"} {
+		if _, err := runFunction(t, functions.NewParseNodePoolIDFunction, types.StringValue(id)); err == nil {
+			t.Fatalf("expected error for invalid node pool ID %q", id)
+		}
+	}
+}
+
+func TestParseDatabaseACLID(t *testing.T) {
+	result, err := runFunction(t, functions.NewParseDatabaseACLIDFunction, types.StringValue("123:mysql"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	attrs := result.(types.Object).Attributes()
+	if attrs["database_id"].(types.Int64).ValueInt64() != 123 ||
+		attrs["database_type"].(types.String).ValueString() != "mysql" {
+		t.Fatalf("unexpected result: %v", result)
+	}
+
+	if _, err := runFunction(t, functions.NewParseDatabaseACLIDFunction, types.StringValue("mysql")); err == nil {
+		t.Fatal("expected error for invalid database ACL ID")
+	}
+}
+
+func TestIPv6RangeToAddresses(t *testing.T) {
+	result, err := runFunction(
+		t, functions.NewIPv6RangeToAddressesFunction,
+		types.StringValue("2001:db8::1/126"), types.Int64Value(10),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var addresses []string
+	if diags := result.(types.List).ElementsAs(context.Background(), &addresses, false); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	expected := []string{"2001:db8::", "2001:db8::1", "2001:db8::2", "2001:db8::3"}
+	if len(addresses) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, addresses)
+	}
+
+	for i := range expected {
+		if addresses[i] != expected[i] {
+			t.Fatalf("expected %v, got %v", expected, addresses)
+		}
+	}
+
+	if _, err := runFunction(
+		t, functions.NewIPv6RangeToAddressesFunction,
+		types.StringValue("192.0.2.0/24"), types.Int64Value(1),
+	); err == nil {
+		t.Fatal("expected error for IPv4 range")
+	}
+
+	if _, err := runFunction(
+		t, functions.NewIPv6RangeToAddressesFunction,
+		types.StringValue("2001:db8::/64"), types.Int64Value(1<<20),
+	); err == nil {
+		t.Fatal("expected error for limit exceeding maximum")
+	}
+}
+
+func TestInstanceTypeFitsDisks(t *testing.T) {
+	diskSizes := func(sizes ...int64) types.List {
+		values := make([]attr.Value, len(sizes))
+		for i, size := range sizes {
+			values[i] = types.Int64Value(size)
+		}
+
+		return types.ListValueMust(types.Int64Type, values)
+	}
+
+	testCases := []struct {
+		typeDisk int64
+		disks    types.List
+		expected bool
+	}{
+		{25600, diskSizes(25088, 512), true},
+		{25600, diskSizes(25600, 512), false},
+		{25600, diskSizes(), true},
+	}
+
+	for _, testCase := range testCases {
+		result, err := runFunction(
+			t, functions.NewInstanceTypeFitsDisksFunction,
+			types.Int64Value(testCase.typeDisk), testCase.disks,
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if result.(types.Bool).ValueBool() != testCase.expected {
+			t.Fatalf("expected %v for disks %v in %d MB", testCase.expected, testCase.disks, testCase.typeDisk)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &InstanceTypeFitsDisksFunction{}
+
+// InstanceTypeFitsDisksFunction checks whether disks fit
+// within the disk space of an instance type.
+type InstanceTypeFitsDisksFunction struct{}
+
+func NewInstanceTypeFitsDisksFunction() function.Function {
+	return &InstanceTypeFitsDisksFunction{}
+}
+
+func (f *InstanceTypeFitsDisksFunction) Metadata(
+	ctx context.Context,
+	req function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "instance_type_fits_disks"
+}
+
+func (f *InstanceTypeFitsDisksFunction) Definition(
+	ctx context.Context,
+	req function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Checks whether disks fit within the disk space of an instance type.",
+		Description: "Returns whether the total size of the given disks is within " +
+			"the given disk space of an instance type, e.g. the disk attribute of the linode_instance_type data source.",
+		Parameters: []function.Parameter{
+			function.Int64Parameter{
+				Name:        "type_disk",
+				Description: "The disk space of the instance type in MB.",
+			},
+			function.ListParameter{
+				Name:        "disk_sizes",
+				Description: "The sizes of the disks in MB.",
+				ElementType: types.Int64Type,
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *InstanceTypeFitsDisksFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var typeDisk int64
+	var diskSizes []int64
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &typeDisk, &diskSizes))
+	if resp.Error != nil {
+		return
+	}
+
+	if typeDisk < 0 {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("type_disk must not be negative, got %d", typeDisk))
+		return
+	}
+
+	var total int64
+
+	for _, size := range diskSizes {
+		if size < 0 {
+			resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("disk sizes must not be negative, got %d", size))
+			return
+		}
+
+		total += size
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, total <= typeDisk))
+}
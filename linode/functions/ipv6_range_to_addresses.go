@@ -0,0 +1,95 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// maxIPv6RangeAddresses is the maximum number of addresses
+// that can be expanded from a single range.
+const maxIPv6RangeAddresses = 65536
+
+var _ function.Function = &IPv6RangeToAddressesFunction{}
+
+// IPv6RangeToAddressesFunction expands an IPv6 range into its addresses.
+type IPv6RangeToAddressesFunction struct{}
+
+func NewIPv6RangeToAddressesFunction() function.Function {
+	return &IPv6RangeToAddressesFunction{}
+}
+
+func (f *IPv6RangeToAddressesFunction) Metadata(
+	ctx context.Context,
+	req function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "ipv6_range_to_addresses"
+}
+
+func (f *IPv6RangeToAddressesFunction) Definition(
+	ctx context.Context,
+	req function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Expands an IPv6 range into its addresses.",
+		Description: fmt.Sprintf(
+			"Returns up to the given number of addresses in the given IPv6 range, "+
+				"starting from the first address in the range. At most %d addresses can be returned.",
+			maxIPv6RangeAddresses,
+		),
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "range",
+				Description: "The IPv6 range in CIDR notation, e.g. 2001:db8::/64.",
+			},
+			function.Int64Parameter{
+				Name:        "limit",
+				Description: "The maximum number of addresses to return.",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *IPv6RangeToAddressesFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var ipv6Range string
+	var limit int64
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &ipv6Range, &limit))
+	if resp.Error != nil {
+		return
+	}
+
+	prefix, err := netip.ParsePrefix(ipv6Range)
+	if err != nil || !prefix.Addr().Is6() || prefix.Addr().Is4In6() {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("%q is not a valid IPv6 range", ipv6Range))
+		return
+	}
+
+	if limit < 0 || limit > maxIPv6RangeAddresses {
+		resp.Error = function.NewArgumentFuncError(
+			1, fmt.Sprintf("limit must be between 0 and %d, got %d", maxIPv6RangeAddresses, limit),
+		)
+		return
+	}
+
+	prefix = prefix.Masked()
+
+	addresses := make([]string, 0, limit)
+	for addr := prefix.Addr(); addr.IsValid() && prefix.Contains(addr) && int64(len(addresses)) < limit; addr = addr.Next() {
+		addresses = append(addresses, addr.String())
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, addresses))
+}
@@ -0,0 +1,83 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/terraform-provider-linode/v2/linode/objbucket"
+)
+
+var bucketIDAttributeTypes = map[string]attr.Type{
+	"cluster_or_region": types.StringType,
+	"label":             types.StringType,
+}
+
+var _ function.Function = &ParseBucketIDFunction{}
+
+// ParseBucketIDFunction parses the ID of a linode_object_storage_bucket.
+type ParseBucketIDFunction struct{}
+
+func NewParseBucketIDFunction() function.Function {
+	return &ParseBucketIDFunction{}
+}
+
+func (f *ParseBucketIDFunction) Metadata(
+	ctx context.Context,
+	req function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "parse_bucket_id"
+}
+
+func (f *ParseBucketIDFunction) Definition(
+	ctx context.Context,
+	req function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Parses the ID of a linode_object_storage_bucket.",
+		Description: "Returns the cluster or region and the label of the bucket " +
+			"with the given ID of the form <ClusterOrRegion>:<Label>.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "id",
+				Description: "The ID of the bucket.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: bucketIDAttributeTypes,
+		},
+	}
+}
+
+func (f *ParseBucketIDFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var id string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &id))
+	if resp.Error != nil {
+		return
+	}
+
+	regionOrCluster, label, err := objbucket.ParseBucketID(id)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	result, diags := types.ObjectValue(bucketIDAttributeTypes, map[string]attr.Value{
+		"cluster_or_region": types.StringValue(regionOrCluster),
+		"label":             types.StringValue(label),
+	})
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,86 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/terraform-provider-linode/v2/linode/databaseaccesscontrols"
+)
+
+var databaseACLIDAttributeTypes = map[string]attr.Type{
+	"database_id":   types.Int64Type,
+	"database_type": types.StringType,
+}
+
+var _ function.Function = &ParseDatabaseACLIDFunction{}
+
+// ParseDatabaseACLIDFunction parses the ID of a linode_database_access_controls.
+type ParseDatabaseACLIDFunction struct{}
+
+func NewParseDatabaseACLIDFunction() function.Function {
+	return &ParseDatabaseACLIDFunction{}
+}
+
+func (f *ParseDatabaseACLIDFunction) Metadata(
+	ctx context.Context,
+	req function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "parse_database_acl_id"
+}
+
+func (f *ParseDatabaseACLIDFunction) Definition(
+	ctx context.Context,
+	req function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Parses the ID of a linode_database_access_controls.",
+		Description: "Returns the database ID and the database type of the access controls " +
+			"with the given ID of the form <DatabaseID>:<DatabaseType>.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "id",
+				Description: "The ID of the database access controls.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: databaseACLIDAttributeTypes,
+		},
+	}
+}
+
+func (f *ParseDatabaseACLIDFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var id string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &id))
+	if resp.Error != nil {
+		return
+	}
+
+	dbID, dbType, err := databaseaccesscontrols.ParseID(id)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			0,
+			"database access controls ID must be of the form <DatabaseID>:<DatabaseType>: "+err.Error(),
+		)
+		return
+	}
+
+	result, diags := types.ObjectValue(databaseACLIDAttributeTypes, map[string]attr.Value{
+		"database_id":   types.Int64Value(int64(dbID)),
+		"database_type": types.StringValue(dbType),
+	})
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
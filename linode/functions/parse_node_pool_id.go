@@ -0,0 +1,106 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var nodePoolIDAttributeTypes = map[string]attr.Type{
+	"cluster_id": types.Int64Type,
+	"pool_id":    types.Int64Type,
+}
+
+var _ function.Function = &ParseNodePoolIDFunction{}
+
+// ParseNodePoolIDFunction parses the import ID of a linode_lke_node_pool.
+type ParseNodePoolIDFunction struct{}
+
+func NewParseNodePoolIDFunction() function.Function {
+	return &ParseNodePoolIDFunction{}
+}
+
+func (f *ParseNodePoolIDFunction) Metadata(
+	ctx context.Context,
+	req function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "parse_node_pool_id"
+}
+
+func (f *ParseNodePoolIDFunction) Definition(
+	ctx context.Context,
+	req function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Parses the import ID of a linode_lke_node_pool.",
+		Description: "Returns the cluster ID and the pool ID of the node pool " +
+			"with the given ID of the form <ClusterID>,<PoolID>.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "id",
+				Description: "The import ID of the node pool.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: nodePoolIDAttributeTypes,
+		},
+	}
+}
+
+func (f *ParseNodePoolIDFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var id string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &id))
+	if resp.Error != nil {
+		return
+	}
+
+	clusterID, poolID, err := parseNodePoolID(id)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	result, diags := types.ObjectValue(nodePoolIDAttributeTypes, map[string]attr.Value{
+		"cluster_id": types.Int64Value(clusterID),
+		"pool_id":    types.Int64Value(poolID),
+	})
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// parseNodePoolID parses a node pool ID using the same format
+// as the linode_lke_node_pool import ID.
+func parseNodePoolID(id string) (clusterID, poolID int64, err error) {
+	parts := strings.Split(strings.ReplaceAll(id, " ", ""), ",")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("node pool ID must be of the form <ClusterID>,<PoolID>, got %q", id)
+	}
+
+	clusterID, err = strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to parse cluster ID %q: %w", parts[0], err)
+	}
+
+	poolID, err = strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to parse pool ID %q: %w", parts[1], err)
+	}
+
+	return clusterID, poolID, nil
+}
@@ -0,0 +1,356 @@
+package helper
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+const (
+	EnvCassetteMode = "LINODE_CASSETTE_MODE"
+	EnvCassettePath = "LINODE_CASSETTE_PATH"
+
+	// CassetteRedacted is the value substituted for scrubbed secrets.
+	CassetteRedacted = "REDACTED"
+)
+
+// CassetteMode determines whether API interactions are recorded or replayed.
+type CassetteMode string
+
+const (
+	CassetteModeDisabled CassetteMode = ""
+	CassetteModeRecord   CassetteMode = "record"
+	CassetteModeReplay   CassetteMode = "replay"
+)
+
+// cassetteScrubbedHeaders are headers that are never written to a cassette.
+var cassetteScrubbedHeaders = []string{
+	"Authorization",
+	"Cookie",
+	"Set-Cookie",
+}
+
+// cassetteScrubbedFields are JSON body fields whose values are
+// replaced with CassetteRedacted before being written to a cassette.
+var cassetteScrubbedFields = map[string]bool{
+	"access_key":     true,
+	"kubeconfig":     true,
+	"password":       true,
+	"root_pass":      true,
+	"secret_key":     true,
+	"ssl_key":        true,
+	"token":          true,
+	"ca_certificate": true,
+}
+
+// CassetteRequest is a recorded API request.
+type CassetteRequest struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Filter string `json:"filter,omitempty"`
+	Body   string `json:"body,omitempty"`
+}
+
+// CassetteResponse is a recorded API response.
+type CassetteResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header"`
+	Body       string      `json:"body"`
+}
+
+// CassetteInteraction is a single recorded request/response pair.
+type CassetteInteraction struct {
+	Request  CassetteRequest  `json:"request"`
+	Response CassetteResponse `json:"response"`
+}
+
+// Cassette is a collection of recorded API interactions persisted to a file.
+type Cassette struct {
+	path string
+
+	mu           sync.Mutex
+	Interactions []*CassetteInteraction `json:"interactions"`
+	used         []bool
+}
+
+// cassettes holds all loaded cassettes by path so that the muxed
+// SDKv2 and framework providers share a single recording.
+var (
+	cassettes   = make(map[string]*Cassette)
+	cassettesMu sync.Mutex
+)
+
+// GetCassette returns the cassette at the given path, loading it
+// from disk in replay mode or creating a new cassette in record mode.
+func GetCassette(path string, mode CassetteMode) (*Cassette, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve cassette path %s: %w", path, err)
+	}
+
+	cassettesMu.Lock()
+	defer cassettesMu.Unlock()
+
+	if c, ok := cassettes[absPath]; ok {
+		return c, nil
+	}
+
+	c := &Cassette{path: absPath}
+
+	if mode == CassetteModeReplay {
+		data, err := os.ReadFile(absPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read cassette %s: %w", absPath, err)
+		}
+
+		if err := json.Unmarshal(data, c); err != nil {
+			return nil, fmt.Errorf("failed to parse cassette %s: %w", absPath, err)
+		}
+
+		c.used = make([]bool, len(c.Interactions))
+	}
+
+	cassettes[absPath] = c
+
+	return c, nil
+}
+
+// save writes the cassette to disk. The caller must hold the cassette lock.
+func (c *Cassette) save() error {
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o755); err != nil {
+		return fmt.Errorf("failed to create cassette directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal cassette: %w", err)
+	}
+
+	// #nosec G306 -- Cassettes are scrubbed of secrets and intended to be committed
+	if err := os.WriteFile(c.path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write cassette %s: %w", c.path, err)
+	}
+
+	return nil
+}
+
+// record appends the given interaction and persists the cassette.
+func (c *Cassette) record(interaction *CassetteInteraction) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.Interactions = append(c.Interactions, interaction)
+
+	return c.save()
+}
+
+// match returns the first unused interaction matching the given request.
+// Requests are matched on their method, URL and filter; request bodies
+// are not compared as they commonly contain randomly generated values.
+// If all matching interactions have been used, the last matching
+// interaction is returned to allow for variations in polling.
+func (c *Cassette) match(req CassetteRequest) (*CassetteInteraction, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var last *CassetteInteraction
+
+	for i, interaction := range c.Interactions {
+		recorded := interaction.Request
+		if recorded.Method != req.Method || recorded.URL != req.URL || recorded.Filter != req.Filter {
+			continue
+		}
+
+		if !c.used[i] {
+			c.used[i] = true
+			return interaction, true
+		}
+
+		last = interaction
+	}
+
+	return last, last != nil
+}
+
+// CassetteTransport is a RoundTripper that records API interactions
+// to a cassette or replays them from a cassette.
+type CassetteTransport struct {
+	transport http.RoundTripper
+	cassette  *Cassette
+	mode      CassetteMode
+}
+
+// NewCassetteTransport wraps the given RoundTripper to record or replay
+// API interactions using the cassette at the given path.
+func NewCassetteTransport(
+	transport http.RoundTripper,
+	mode CassetteMode,
+	path string,
+) (*CassetteTransport, error) {
+	switch mode {
+	case CassetteModeRecord, CassetteModeReplay:
+	default:
+		return nil, fmt.Errorf("invalid cassette mode %q; must be %q or %q", mode, CassetteModeRecord, CassetteModeReplay)
+	}
+
+	if path == "" {
+		return nil, fmt.Errorf("a cassette path must be specified when using cassette mode %q", mode)
+	}
+
+	cassette, err := GetCassette(path, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CassetteTransport{
+		transport: transport,
+		cassette:  cassette,
+		mode:      mode,
+	}, nil
+}
+
+// WrapCassetteTransport wraps the given RoundTripper in a CassetteTransport
+// if the given mode is not CassetteModeDisabled.
+func WrapCassetteTransport(
+	transport http.RoundTripper,
+	mode CassetteMode,
+	path string,
+) (http.RoundTripper, error) {
+	if mode == CassetteModeDisabled {
+		return transport, nil
+	}
+
+	return NewCassetteTransport(transport, mode, path)
+}
+
+// RoundTrip records or replays the given API request.
+func (t *CassetteTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	reqBody, err := readAndRestoreBody(&r.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read request body: %w", err)
+	}
+
+	cassetteReq := CassetteRequest{
+		Method: r.Method,
+		URL:    r.URL.RequestURI(),
+		Filter: r.Header.Get("X-Filter"),
+		Body:   scrubCassetteBody(reqBody),
+	}
+
+	if t.mode == CassetteModeReplay {
+		interaction, ok := t.cassette.match(cassetteReq)
+		if !ok {
+			return nil, fmt.Errorf(
+				"no recorded interaction found in cassette %s for %s %s",
+				t.cassette.path, r.Method, cassetteReq.URL,
+			)
+		}
+
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        interaction.Response.Header.Clone(),
+			Body:          io.NopCloser(strings.NewReader(interaction.Response.Body)),
+			ContentLength: int64(len(interaction.Response.Body)),
+			Request:       r,
+		}, nil
+	}
+
+	resp, err := t.transport.RoundTrip(r)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := readAndRestoreBody(&resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	header := resp.Header.Clone()
+	for _, h := range cassetteScrubbedHeaders {
+		header.Del(h)
+	}
+
+	if err := t.cassette.record(&CassetteInteraction{
+		Request: cassetteReq,
+		Response: CassetteResponse{
+			StatusCode: resp.StatusCode,
+			Header:     header,
+			Body:       scrubCassetteBody(respBody),
+		},
+	}); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// readAndRestoreBody reads the given body and replaces it
+// with a reader over the same content.
+func readAndRestoreBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+
+	data, err := io.ReadAll(*body)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := (*body).Close(); err != nil {
+		return nil, err
+	}
+
+	*body = io.NopCloser(bytes.NewReader(data))
+
+	return data, nil
+}
+
+// scrubCassetteBody redacts secret fields in the given JSON body.
+// Non-JSON bodies are returned unchanged.
+func scrubCassetteBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	var decoded any
+	if err := json.Unmarshal(body, &decoded); err != nil {
+		return string(body)
+	}
+
+	scrubbed, err := json.Marshal(scrubCassetteValue(decoded))
+	if err != nil {
+		return string(body)
+	}
+
+	return string(scrubbed)
+}
+
+func scrubCassetteValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for key, value := range v {
+			if cassetteScrubbedFields[key] && value != nil {
+				v[key] = CassetteRedacted
+				continue
+			}
+
+			v[key] = scrubCassetteValue(value)
+		}
+	case []any:
+		for i, value := range v {
+			v[i] = scrubCassetteValue(value)
+		}
+	}
+
+	return v
+}
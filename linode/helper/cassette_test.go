@@ -0,0 +1,113 @@
+//go:build unit
+
+package helper_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+func TestCassetteTransport_recordReplay(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "session=secret")
+		_, _ = w.Write([]byte(`{"id": 123, "label": "test", "root_pass": "hunter2"}`))
+	}))
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+
+	recorder, err := helper.NewCassetteTransport(http.DefaultTransport, helper.CassetteModeRecord, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	doRequest := func(transport http.RoundTripper) string {
+		req, err := http.NewRequest(
+			http.MethodPost,
+			server.URL+"/v4/linode/instances",
+			strings.NewReader(`{"label": "test", "root_pass": "hunter2"}`),
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		req.Header.Set("Authorization", "Bearer secret-token")
+
+		resp, err := transport.RoundTrip(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return string(body)
+	}
+
+	if body := doRequest(recorder); !strings.Contains(body, "hunter2") {
+		t.Fatalf("expected live response to be unmodified, got %s", body)
+	}
+
+	server.Close()
+
+	recorded, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, secret := range []string{"hunter2", "secret-token", "session=secret"} {
+		if strings.Contains(string(recorded), secret) {
+			t.Fatalf("expected %q to be scrubbed from cassette: %s", secret, recorded)
+		}
+	}
+
+	// Replay from a fresh cassette as if in a new process
+	replayPath := filepath.Join(t.TempDir(), "replay.json")
+	if err := os.WriteFile(replayPath, recorded, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	replayer, err := helper.NewCassetteTransport(http.DefaultTransport, helper.CassetteModeReplay, replayPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body := doRequest(replayer)
+	if !strings.Contains(body, `"id":123`) || !strings.Contains(body, helper.CassetteRedacted) {
+		t.Fatalf("unexpected replayed response: %s", body)
+	}
+
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/v4/volumes", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := replayer.RoundTrip(req); err == nil {
+		t.Fatal("expected error for unrecorded request")
+	}
+}
+
+func TestCassetteTransport_invalidMode(t *testing.T) {
+	if _, err := helper.NewCassetteTransport(http.DefaultTransport, "bogus", "cassette.json"); err == nil {
+		t.Fatal("expected error for invalid mode")
+	}
+
+	transport, err := helper.WrapCassetteTransport(http.DefaultTransport, helper.CassetteModeDisabled, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if transport != http.DefaultTransport {
+		t.Fatal("expected transport to be unwrapped when cassettes are disabled")
+	}
+}
@@ -0,0 +1,127 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/linodego"
+)
+
+// childAccountTokenRefreshMargin is how long before a proxy token's
+// expiry a new token will be minted.
+const childAccountTokenRefreshMargin = time.Minute
+
+// ChildAccountTokenTransport is a RoundTripper that authenticates API requests
+// using a proxy user token for a child account, minting a new token from the
+// parent account when the current token is about to expire.
+type ChildAccountTokenTransport struct {
+	transport http.RoundTripper
+	parent    *linodego.Client
+	euuid     string
+
+	mu     sync.Mutex
+	token  string
+	expiry *time.Time
+}
+
+// NewChildAccountTokenTransport wraps the given RoundTripper to authenticate
+// requests as the child account with the given EUUID. The given parent client
+// is used to mint proxy user tokens.
+func NewChildAccountTokenTransport(
+	transport http.RoundTripper,
+	parent *linodego.Client,
+	euuid string,
+) *ChildAccountTokenTransport {
+	return &ChildAccountTokenTransport{
+		transport: transport,
+		parent:    parent,
+		euuid:     euuid,
+	}
+}
+
+// Token returns the current proxy user token, minting a new
+// token if there is none or the current token is about to expire.
+func (t *ChildAccountTokenTransport) Token(ctx context.Context) (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.token != "" && (t.expiry == nil || time.Until(*t.expiry) > childAccountTokenRefreshMargin) {
+		return t.token, nil
+	}
+
+	tflog.Debug(ctx, "Creating proxy user token for child account", map[string]any{
+		"euuid": t.euuid,
+	})
+
+	token, err := t.parent.CreateChildAccountToken(ctx, t.euuid)
+	if err != nil {
+		return "", fmt.Errorf("failed to create token for child account %s: %w", t.euuid, err)
+	}
+
+	t.token = token.Token
+	t.expiry = token.Expiry
+
+	return t.token, nil
+}
+
+// invalidate discards the given token so that a new token
+// will be minted on the next request.
+func (t *ChildAccountTokenTransport) invalidate(token string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.token == token {
+		t.token = ""
+	}
+}
+
+// RoundTrip authenticates the given request using the proxy user token.
+// If the token is rejected, a new token is minted and the request is retried once.
+func (t *ChildAccountTokenTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	token, err := t.Token(r.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := t.transport.RoundTrip(t.authorize(r, token))
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	// The request can only be retried if its body can be recreated
+	if r.Body != nil && r.Body != http.NoBody && r.GetBody == nil {
+		return resp, nil
+	}
+
+	t.invalidate(token)
+
+	token, err = t.Token(r.Context())
+	if err != nil {
+		return resp, nil
+	}
+
+	retry := t.authorize(r, token)
+	if r.GetBody != nil {
+		body, err := r.GetBody()
+		if err != nil {
+			return resp, nil
+		}
+
+		retry.Body = body
+	}
+
+	resp.Body.Close()
+
+	return t.transport.RoundTrip(retry)
+}
+
+func (t *ChildAccountTokenTransport) authorize(r *http.Request, token string) *http.Request {
+	result := r.Clone(r.Context())
+	result.Header.Set("Authorization", "Bearer "+token)
+
+	return result
+}
@@ -0,0 +1,160 @@
+//go:build unit
+
+package helper_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+// childAccountServer issues proxy tokens for a single child account and
+// records the tokens used to authenticate other requests.
+type childAccountServer struct {
+	*httptest.Server
+
+	mu          sync.Mutex
+	minted      int
+	expiry      time.Duration
+	revoked     map[string]bool
+	tokensUsed  []string
+	parentToken string
+}
+
+func newChildAccountServer(t *testing.T, expiry time.Duration) *childAccountServer {
+	s := &childAccountServer{
+		expiry:      expiry,
+		revoked:     make(map[string]bool),
+		parentToken: "parent-token",
+	}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Path == "/v4/account/child-accounts/child-euuid/token" {
+			if token != s.parentToken {
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = w.Write([]byte(`{"errors": [{"reason": "Invalid Token"}]}`))
+				return
+			}
+
+			s.minted++
+
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"id":     s.minted,
+				"token":  fmt.Sprintf("proxy-token-%d", s.minted),
+				"expiry": time.Now().UTC().Add(s.expiry).Format("2006-01-02T15:04:05"),
+			})
+
+			return
+		}
+
+		s.tokensUsed = append(s.tokensUsed, token)
+
+		if !strings.HasPrefix(token, "proxy-token-") || s.revoked[token] {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"errors": [{"reason": "Invalid Token"}]}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"data": [], "page": 1, "pages": 1, "results": 0}`))
+	}))
+
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+func (s *childAccountServer) parentClient() *linodego.Client {
+	client := linodego.NewClient(http.DefaultClient)
+	client.SetBaseURL(s.URL)
+	client.SetToken(s.parentToken)
+
+	return &client
+}
+
+func (s *childAccountServer) get(t *testing.T, transport http.RoundTripper) int {
+	req, err := http.NewRequest(http.MethodGet, s.URL+"/v4/linode/instances", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+s.parentToken)
+
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	return resp.StatusCode
+}
+
+func TestChildAccountTokenTransport(t *testing.T) {
+	server := newChildAccountServer(t, time.Hour)
+
+	transport := helper.NewChildAccountTokenTransport(http.DefaultTransport, server.parentClient(), "child-euuid")
+
+	for i := 0; i < 3; i++ {
+		if status := server.get(t, transport); status != http.StatusOK {
+			t.Fatalf("expected status 200, got %d", status)
+		}
+	}
+
+	if server.minted != 1 {
+		t.Fatalf("expected a single token to be minted, got %d", server.minted)
+	}
+
+	for _, token := range server.tokensUsed {
+		if token != "proxy-token-1" {
+			t.Fatalf("expected requests to use the proxy token, got %q", token)
+		}
+	}
+}
+
+func TestChildAccountTokenTransport_refreshExpired(t *testing.T) {
+	// Tokens expiring within the refresh margin are replaced on every request
+	server := newChildAccountServer(t, 30*time.Second)
+
+	transport := helper.NewChildAccountTokenTransport(http.DefaultTransport, server.parentClient(), "child-euuid")
+
+	server.get(t, transport)
+	server.get(t, transport)
+
+	if server.minted != 2 {
+		t.Fatalf("expected expiring token to be refreshed, got %d tokens minted", server.minted)
+	}
+}
+
+func TestChildAccountTokenTransport_refreshRevoked(t *testing.T) {
+	server := newChildAccountServer(t, time.Hour)
+
+	transport := helper.NewChildAccountTokenTransport(http.DefaultTransport, server.parentClient(), "child-euuid")
+
+	if _, err := transport.Token(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	server.revoked["proxy-token-1"] = true
+
+	if status := server.get(t, transport); status != http.StatusOK {
+		t.Fatalf("expected request to be retried with a new token, got status %d", status)
+	}
+
+	if server.minted != 2 {
+		t.Fatalf("expected revoked token to be replaced, got %d tokens minted", server.minted)
+	}
+}
@@ -2,11 +2,16 @@ package helper
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"log"
 	"net/http"
 	"os"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -21,8 +26,9 @@ const UAEnvVar = "TF_APPEND_USER_AGENT"
 const DefaultLinodeURL = "https://api.linode.com"
 
 type ProviderMeta struct {
-	Client linodego.Client
-	Config *Config
+	Client       linodego.Client
+	Config       *Config
+	EventWatcher *EventWatcher
 }
 
 // Config represents the Linode provider configuration.
@@ -51,19 +57,55 @@ type Config struct {
 	ObjSecretKey         string
 	ObjUseTempKeys       bool
 	ObjBucketForceDelete bool
+
+	CassetteMode CassetteMode
+	CassettePath string
+
+	RetryPolicies []RetryPolicy
+	RateLimits    []RateLimit
+
+	ChildAccountEUUID string
 }
 
 // Client returns a fully initialized Linode client.
 func (c *Config) Client(ctx context.Context) (*linodego.Client, error) {
+	cassetteTransport, err := WrapCassetteTransport(http.DefaultTransport, c.CassetteMode, c.CassettePath)
+	if err != nil {
+		return nil, err
+	}
+
 	loggingTransport := NewAPILoggerTransport(
 		logging.NewSubsystemLoggingHTTPTransport(
 			APILoggerSubsystem,
-			http.DefaultTransport,
+			cassetteTransport,
 		),
 	)
 
+	transport := WrapRateLimitTransport(WrapTelemetryTransport(loggingTransport), c.RateLimits)
+
+	if c.ChildAccountEUUID != "" {
+		// Proxy user tokens for the child account are minted using a client
+		// authenticated as the parent account.
+		parentConfig := *c
+		parentConfig.ChildAccountEUUID = ""
+
+		parent, err := parentConfig.Client(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		childTransport := NewChildAccountTokenTransport(transport, parent, c.ChildAccountEUUID)
+
+		// Mint the initial token at configure-time to fail early
+		if _, err := childTransport.Token(ctx); err != nil {
+			return nil, err
+		}
+
+		transport = childTransport
+	}
+
 	oauth2Client := &http.Client{
-		Transport: loggingTransport,
+		Transport: transport,
 	}
 
 	client := linodego.NewClient(oauth2Client)
@@ -111,15 +153,19 @@ func (c *Config) Client(ctx context.Context) (*linodego.Client, error) {
 		client.SetRetryMaxWaitTime(time.Duration(c.MaxRetryDelayMilliseconds) * time.Millisecond)
 	}
 
-	tfUserAgent := terraformUserAgent(c.TerraformVersion)
-	userAgent := strings.TrimSpace(fmt.Sprintf("%s terraform-provider-linode/%s",
-		tfUserAgent, version.ProviderVersion))
+	userAgent := terraformUserAgent(c.TerraformVersion)
 	if c.UAPrefix != "" {
 		userAgent = c.UAPrefix + " " + userAgent
 	}
 	client.SetUserAgent(userAgent)
 	ApplyAllRetryConditions(&client)
 
+	if err := ApplyRetryPolicies(&client, c.RetryPolicies); err != nil {
+		return nil, err
+	}
+
+	ApplyTelemetryHooks(&client)
+
 	// We always want to disable resty debugging in favor
 	// of Terraform transport debugging.
 	client.SetDebug(false)
@@ -127,10 +173,13 @@ func (c *Config) Client(ctx context.Context) (*linodego.Client, error) {
 	return &client, nil
 }
 
-func terraformUserAgent(version string) string {
-	ua := fmt.Sprintf(
-		"HashiCorp Terraform/%s (+https://www.terraform.io) Terraform-Plugin-SDK/%s",
-		version, GetSDKv2Version(),
+func terraformUserAgent(tfVersion string) string {
+	ua := strings.TrimSpace(
+		fmt.Sprintf(
+			"HashiCorp Terraform/%s (+https://www.terraform.io) Terraform-Plugin-SDK/%s "+
+				"Terraform-Plugin-Framework/%s terraform-provider-linode/%s",
+			tfVersion, GetSDKv2Version(), GetFrameworkVersion(), version.ProviderVersion,
+		),
 	)
 
 	if add := os.Getenv(UAEnvVar); add != "" {
@@ -143,3 +192,215 @@ func terraformUserAgent(version string) string {
 
 	return ua
 }
+
+// ApplyDefaults resolves any unset configuration values from the environment
+// and the provider defaults. A warning is returned for each environment
+// variable that could not be parsed.
+func (c *Config) ApplyDefaults() (warnings []string, err error) {
+	getIntFromEnv := func(key string, defaultValue int) int {
+		envVarVal := os.Getenv(key)
+		if envVarVal == "" {
+			return defaultValue
+		}
+
+		intVal, err := strconv.Atoi(envVarVal)
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf(
+				"Failed to parse the environment variable %v to an integer. "+
+					"Will use default value: %v instead: %s",
+				key, defaultValue, err,
+			))
+			return defaultValue
+		}
+
+		return intVal
+	}
+
+	if c.AccessToken == "" {
+		c.AccessToken = os.Getenv("LINODE_TOKEN")
+	}
+
+	if c.APIURL == "" {
+		c.APIURL = os.Getenv("LINODE_URL")
+	}
+
+	if c.APIVersion == "" {
+		c.APIVersion = os.Getenv("LINODE_API_VERSION")
+	}
+
+	if c.UAPrefix == "" {
+		c.UAPrefix = os.Getenv("LINODE_UA_PREFIX")
+	}
+
+	c.UAPrefix = strings.TrimSpace(c.UAPrefix)
+
+	if c.ConfigPath == "" {
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			return warnings, fmt.Errorf("failed to get the home directory of the user for the config path: %w", err)
+		}
+
+		c.ConfigPath = fmt.Sprintf("%s/.config/linode", homeDir)
+	}
+
+	if c.ConfigProfile == "" {
+		c.ConfigProfile = "default"
+	}
+
+	if c.EventPollMilliseconds == 0 {
+		c.EventPollMilliseconds = getIntFromEnv("LINODE_EVENT_POLL_MS", 4000)
+	}
+
+	if c.LKEEventPollMilliseconds == 0 {
+		c.LKEEventPollMilliseconds = getIntFromEnv("LINODE_LKE_EVENT_POLL_MS", 3000)
+	}
+
+	if c.LKENodeReadyPollMilliseconds == 0 {
+		c.LKENodeReadyPollMilliseconds = 3000
+	}
+
+	if c.ObjAccessKey == "" {
+		c.ObjAccessKey = os.Getenv("LINODE_OBJ_ACCESS_KEY")
+	}
+
+	if c.ObjSecretKey == "" {
+		c.ObjSecretKey = os.Getenv("LINODE_OBJ_SECRET_KEY")
+	}
+
+	if c.CassetteMode == CassetteModeDisabled {
+		c.CassetteMode = CassetteMode(os.Getenv(EnvCassetteMode))
+	}
+
+	if c.CassettePath == "" {
+		c.CassettePath = os.Getenv(EnvCassettePath)
+	}
+
+	return warnings, nil
+}
+
+// sharedClientConsumers is the number of muxed providers that retrieve
+// the shared client of a configuration when the provider is configured.
+const sharedClientConsumers = 2
+
+// sharedClient is a client being initialized or initialized for a single
+// configuration. It is removed from sharedClients once all muxed providers
+// have retrieved it, so configurations don't accumulate for the lifetime
+// of the process.
+type sharedClient struct {
+	once      sync.Once
+	client    *linodego.Client
+	err       error
+	remaining int
+}
+
+// sharedClients holds clients by the configuration they are initialized with
+// so that the muxed SDKv2 and framework providers use a single client, cache
+// and set of retry conditions.
+var (
+	sharedClients   = make(map[string]*sharedClient)
+	sharedClientsMu sync.Mutex
+)
+
+// sharedClientKey contains the fields of a Config used to initialize its client.
+type sharedClientKey struct {
+	AccessToken       string
+	APIURL            string
+	APIVersion        string
+	UAPrefix          string
+	ConfigPath        string
+	ConfigProfile     string
+	TerraformVersion  string
+	ChildAccountEUUID string
+
+	DisableInternalCache      bool
+	MinRetryDelayMilliseconds int
+	MaxRetryDelayMilliseconds int
+	EventPollMilliseconds     int
+
+	CassetteMode CassetteMode
+	CassettePath string
+
+	RetryPolicies []RetryPolicy
+	RateLimits    []RateLimit
+}
+
+// sharedClientKey returns the key of the shared client of this configuration.
+// Only the fields that affect the client are included, so configurations that
+// only differ in other settings share a client.
+func (c *Config) sharedClientKey() (string, error) {
+	encodedKey, err := json.Marshal(sharedClientKey{
+		AccessToken:               c.AccessToken,
+		APIURL:                    c.APIURL,
+		APIVersion:                c.APIVersion,
+		UAPrefix:                  c.UAPrefix,
+		ConfigPath:                c.ConfigPath,
+		ConfigProfile:             c.ConfigProfile,
+		TerraformVersion:          c.TerraformVersion,
+		ChildAccountEUUID:         c.ChildAccountEUUID,
+		DisableInternalCache:      c.DisableInternalCache,
+		MinRetryDelayMilliseconds: c.MinRetryDelayMilliseconds,
+		MaxRetryDelayMilliseconds: c.MaxRetryDelayMilliseconds,
+		EventPollMilliseconds:     c.EventPollMilliseconds,
+		CassetteMode:              c.CassetteMode,
+		CassettePath:              c.CassettePath,
+		RetryPolicies:             c.RetryPolicies,
+		RateLimits:                c.RateLimits,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode provider configuration: %w", err)
+	}
+
+	keyHash := sha256.Sum256(encodedKey)
+
+	return hex.EncodeToString(keyHash[:]), nil
+}
+
+// SharedClient returns the client for the given configuration, initializing
+// and verifying it if it has not already been initialized by another provider.
+func (c *Config) SharedClient(ctx context.Context) (*linodego.Client, error) {
+	key, err := c.sharedClientKey()
+	if err != nil {
+		return nil, err
+	}
+
+	sharedClientsMu.Lock()
+
+	shared, ok := sharedClients[key]
+	if !ok {
+		shared = &sharedClient{remaining: sharedClientConsumers}
+		sharedClients[key] = shared
+	} else {
+		tflog.Info(ctx, "Linode client was already configured, re-using..")
+	}
+
+	shared.remaining--
+	if shared.remaining < 1 {
+		delete(sharedClients, key)
+	}
+
+	sharedClientsMu.Unlock()
+
+	// The client is initialized outside of the lock so that providers
+	// with other configurations aren't blocked by the API request
+	shared.once.Do(func() {
+		shared.client, shared.err = c.verifiedClient(ctx)
+	})
+
+	return shared.client, shared.err
+}
+
+// verifiedClient returns a new client for the given configuration after
+// verifying that it can connect to the API.
+func (c *Config) verifiedClient(ctx context.Context) (*linodego.Client, error) {
+	client, err := c.Client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize client: %w", err)
+	}
+
+	// Ping the API for an empty response to verify the configuration works
+	if _, err := client.ListTypes(ctx, linodego.NewListOptions(100, "")); err != nil {
+		return nil, fmt.Errorf("error connecting to the Linode API: %w", err)
+	}
+
+	return client, nil
+}
@@ -0,0 +1,141 @@
+//go:build unit
+
+package helper_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+func TestConfig_ApplyDefaults(t *testing.T) {
+	t.Setenv("LINODE_TOKEN", "env-token")
+	t.Setenv("LINODE_UA_PREFIX", "  my-prefix  ")
+	t.Setenv("LINODE_EVENT_POLL_MS", "1234")
+	t.Setenv("LINODE_LKE_EVENT_POLL_MS", "not-a-number")
+
+	config := &helper.Config{
+		AccessToken:   "config-token",
+		ConfigProfile: "cool",
+	}
+
+	warnings, err := config.ApplyDefaults()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(warnings) != 1 {
+		t.Fatalf("expected a single warning for LINODE_LKE_EVENT_POLL_MS, got %v", warnings)
+	}
+
+	if config.AccessToken != "config-token" {
+		t.Fatalf("expected configured token to take precedence, got %q", config.AccessToken)
+	}
+
+	if config.UAPrefix != "my-prefix" {
+		t.Fatalf("expected trimmed UA prefix, got %q", config.UAPrefix)
+	}
+
+	if config.ConfigProfile != "cool" {
+		t.Fatalf("expected configured profile, got %q", config.ConfigProfile)
+	}
+
+	if config.EventPollMilliseconds != 1234 {
+		t.Fatalf("expected event poll from environment, got %d", config.EventPollMilliseconds)
+	}
+
+	if config.LKEEventPollMilliseconds != 3000 {
+		t.Fatalf("expected default LKE event poll, got %d", config.LKEEventPollMilliseconds)
+	}
+
+	if config.ConfigPath == "" {
+		t.Fatal("expected default config path")
+	}
+}
+
+func TestConfig_ApplyDefaults_frameworkModelRoundTrip(t *testing.T) {
+	t.Setenv("LINODE_TOKEN", "")
+
+	config := &helper.Config{
+		APIURL:        "https://api.linode.com",
+		RetryPolicies: []helper.RetryPolicy{{StatusCodes: []int{503}, MaxAttempts: 3}},
+		RateLimits:    []helper.RateLimit{{Operation: helper.RateLimitOperationWrite, MaxInFlight: 4}},
+	}
+
+	if _, err := config.ApplyDefaults(); err != nil {
+		t.Fatal(err)
+	}
+
+	model := helper.GetFrameworkProviderModelFromSDKv2ProviderConfig(config)
+	result := helper.GetConfigFromFrameworkProviderModel(model)
+
+	if result.APIURL != config.APIURL || result.ConfigPath != config.ConfigPath ||
+		result.EventPollMilliseconds != config.EventPollMilliseconds {
+		t.Fatalf("expected config to round trip, got %+v", result)
+	}
+
+	if len(result.RetryPolicies) != 1 || result.RetryPolicies[0].MaxAttempts != 3 {
+		t.Fatalf("expected retry policies to round trip, got %+v", result.RetryPolicies)
+	}
+
+	if len(result.RateLimits) != 1 || result.RateLimits[0].MaxInFlight != 4 {
+		t.Fatalf("expected rate limits to round trip, got %+v", result.RateLimits)
+	}
+}
+
+func TestConfig_SharedClient(t *testing.T) {
+	var pings atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pings.Add(1)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data": [], "page": 1, "pages": 1, "results": 0}`))
+	}))
+	t.Cleanup(server.Close)
+
+	configPath := t.TempDir() + "/missing"
+
+	newConfig := func(skipImplicitReboots bool) *helper.Config {
+		return &helper.Config{
+			AccessToken: "shared-client-token",
+			APIURL:      server.URL,
+			ConfigPath:  configPath,
+
+			// Settings that don't affect the client don't prevent it from being shared
+			SkipImplicitReboots: skipImplicitReboots,
+		}
+	}
+
+	first, err := newConfig(false).SharedClient(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	second, err := newConfig(true).SharedClient(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if first != second {
+		t.Error("expected both providers to share a client")
+	}
+
+	if pings.Load() != 1 {
+		t.Errorf("expected the client to be verified once, got %d requests", pings.Load())
+	}
+
+	// The client is released once both providers have retrieved it
+	third, err := newConfig(false).SharedClient(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if third == first {
+		t.Error("expected a new client after the shared client has been released")
+	}
+}
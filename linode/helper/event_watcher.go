@@ -0,0 +1,353 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/linode/linodego"
+)
+
+// eventWatchers holds event watchers by their client so that every
+// resource of both muxed providers shares a single polling loop.
+var (
+	eventWatchers   = make(map[*linodego.Client]*EventWatcher)
+	eventWatchersMu sync.Mutex
+)
+
+// EventWatcher polls the account's events once per interval and dispatches
+// them to the registered EventWaiters, rather than each waiter polling
+// the API on its own.
+type EventWatcher struct {
+	client   *linodego.Client
+	interval time.Duration
+
+	mu      sync.Mutex
+	waiters map[*EventWaiter]struct{}
+	running bool
+}
+
+// GetEventWatcher returns the event watcher for the given client, creating
+// it if necessary. Events are polled using the client's poll delay.
+func GetEventWatcher(client *linodego.Client) *EventWatcher {
+	eventWatchersMu.Lock()
+	defer eventWatchersMu.Unlock()
+
+	if watcher, ok := eventWatchers[client]; ok {
+		return watcher
+	}
+
+	watcher := NewEventWatcher(client, client.GetPollDelay())
+	eventWatchers[client] = watcher
+
+	return watcher
+}
+
+// NewEventWatcher returns a new EventWatcher polling events
+// using the given client at the given interval.
+func NewEventWatcher(client *linodego.Client, interval time.Duration) *EventWatcher {
+	return &EventWatcher{
+		client:   client,
+		interval: interval,
+		waiters:  make(map[*EventWaiter]struct{}),
+	}
+}
+
+// EventWaiter waits for a new event matching its entity and action.
+// This should be created before the event is triggered as only
+// events created after the waiter are considered.
+type EventWaiter struct {
+	EntityID   any
+	EntityType linodego.EntityType
+
+	// Type is excluded here because it is implicitly determined
+	// by the event action.
+	SecondaryEntityID any
+
+	Action linodego.EventAction
+
+	watcher *EventWatcher
+
+	// afterID is the ID of the latest event created
+	// before this waiter.
+	afterID int
+
+	// eventID is the ID of the event being waited on,
+	// or zero if no matching event has been found yet.
+	eventID int
+
+	result chan eventWaiterResult
+}
+
+type eventWaiterResult struct {
+	event *linodego.Event
+	err   error
+}
+
+// NewEventWaiter initializes a new waiter for events of the given entity and action.
+func (w *EventWatcher) NewEventWaiter(
+	ctx context.Context, id any, entityType linodego.EntityType, action linodego.EventAction,
+) (*EventWaiter, error) {
+	latestID, err := w.latestEventID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest event: %w", err)
+	}
+
+	return &EventWaiter{
+		EntityID:   id,
+		EntityType: entityType,
+		Action:     action,
+
+		watcher: w,
+		afterID: latestID,
+	}, nil
+}
+
+// NewEventWaiterWithSecondary initializes a new waiter for events of the
+// given entity and action with a specific secondary entity.
+func (w *EventWatcher) NewEventWaiterWithSecondary(
+	ctx context.Context, id any, primaryEntityType linodego.EntityType, secondaryID int, action linodego.EventAction,
+) (*EventWaiter, error) {
+	waiter, err := w.NewEventWaiter(ctx, id, primaryEntityType, action)
+	if err != nil {
+		return nil, err
+	}
+
+	waiter.SecondaryEntityID = secondaryID
+
+	return waiter, nil
+}
+
+// NewEventWaiterWithoutEntity initializes a new waiter for events of the given
+// entity type and action before the entity exists. EntityID must be set
+// before waiting.
+func (w *EventWatcher) NewEventWaiterWithoutEntity(
+	ctx context.Context, entityType linodego.EntityType, action linodego.EventAction,
+) (*EventWaiter, error) {
+	return w.NewEventWaiter(ctx, nil, entityType, action)
+}
+
+// WaitForFinished waits for a new matching event to be finished.
+func (p *EventWaiter) WaitForFinished(ctx context.Context, timeoutSeconds int) (*linodego.Event, error) {
+	if p.EntityID == nil {
+		return nil, fmt.Errorf("failed to wait for event finished: no entity ID was specified")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
+	defer cancel()
+
+	p.result = make(chan eventWaiterResult, 1)
+	p.eventID = 0
+
+	p.watcher.register(p)
+	defer p.watcher.deregister(p)
+
+	select {
+	case result := <-p.result:
+		if result.event != nil {
+			// Subsequent waits should only consider newer events
+			p.afterID = result.event.ID
+		}
+
+		return result.event, result.err
+	case <-ctx.Done():
+		return nil, fmt.Errorf("failed to wait for event finished: %w", ctx.Err())
+	}
+}
+
+// handle processes the given event, returning whether
+// the waiter has received its result.
+func (p *EventWaiter) handle(event *linodego.Event) bool {
+	if event.ID <= p.afterID || !p.matches(event) {
+		return false
+	}
+
+	// Wait on the first new matching event
+	if p.eventID == 0 {
+		p.eventID = event.ID
+	}
+
+	if event.ID != p.eventID {
+		return false
+	}
+
+	switch event.Status {
+	case linodego.EventFinished:
+		p.result <- eventWaiterResult{event: event}
+	case linodego.EventFailed:
+		p.result <- eventWaiterResult{err: fmt.Errorf("event %d has failed", event.ID)}
+	default:
+		return false
+	}
+
+	return true
+}
+
+func (p *EventWaiter) matches(event *linodego.Event) bool {
+	if event.Action != p.Action || event.Entity == nil ||
+		event.Entity.Type != p.EntityType || !eventEntityIDEquals(event.Entity.ID, p.EntityID) {
+		return false
+	}
+
+	if p.SecondaryEntityID != nil {
+		return event.SecondaryEntity != nil && eventEntityIDEquals(event.SecondaryEntity.ID, p.SecondaryEntityID)
+	}
+
+	return true
+}
+
+// latestEventID returns the ID of the latest event on the account.
+// This is always fetched rather than reused from the last poll so
+// that events created since then are never matched by new waiters.
+func (w *EventWatcher) latestEventID(ctx context.Context) (int, error) {
+	filter := linodego.Filter{
+		Order:   linodego.Descending,
+		OrderBy: "created",
+	}
+
+	filterStr, err := filter.MarshalJSON()
+	if err != nil {
+		return 0, err
+	}
+
+	events, err := w.client.ListEvents(ctx, &linodego.ListOptions{
+		PageOptions: &linodego.PageOptions{Page: 1},
+		PageSize:    25,
+		Filter:      string(filterStr),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	latestID := 0
+	for _, event := range events {
+		latestID = max(latestID, event.ID)
+	}
+
+	return latestID, nil
+}
+
+func (w *EventWatcher) register(waiter *EventWaiter) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.waiters[waiter] = struct{}{}
+
+	if !w.running {
+		w.running = true
+		go w.run()
+	}
+}
+
+func (w *EventWatcher) deregister(waiter *EventWaiter) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	delete(w.waiters, waiter)
+}
+
+// run polls events until there are no registered waiters.
+func (w *EventWatcher) run() {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		if !w.poll(context.Background()) {
+			return
+		}
+	}
+}
+
+// poll lists the events created since the oldest registered waiter and
+// dispatches them, returning whether there are waiters remaining.
+func (w *EventWatcher) poll(ctx context.Context) bool {
+	w.mu.Lock()
+
+	if len(w.waiters) == 0 {
+		w.running = false
+		w.mu.Unlock()
+		return false
+	}
+
+	afterID := -1
+	for waiter := range w.waiters {
+		if afterID < 0 || waiter.afterID < afterID {
+			afterID = waiter.afterID
+		}
+	}
+
+	w.mu.Unlock()
+
+	events, err := w.listEventsAfter(ctx, afterID)
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if err != nil {
+		// The waiters are not failed here since the error may be transient;
+		// each waiter instead times out according to its own context.
+		log.Printf("[WARN] failed to list events: %s", err)
+		return true
+	}
+
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].ID < events[j].ID
+	})
+
+	for i := range events {
+		event := &events[i]
+
+		for waiter := range w.waiters {
+			if waiter.handle(event) {
+				delete(w.waiters, waiter)
+			}
+		}
+	}
+
+	if len(w.waiters) == 0 {
+		w.running = false
+		return false
+	}
+
+	return true
+}
+
+func (w *EventWatcher) listEventsAfter(ctx context.Context, afterID int) ([]linodego.Event, error) {
+	filter := linodego.Filter{
+		Order:   linodego.Descending,
+		OrderBy: "created",
+	}
+	filter.AddField(linodego.Gt, "id", afterID)
+
+	filterStr, err := filter.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	return w.client.ListEvents(ctx, &linodego.ListOptions{
+		PageSize: 500,
+		Filter:   string(filterStr),
+	})
+}
+
+// eventEntityIDEquals returns whether the given entity IDs are equal,
+// accounting for IDs parsed as floats.
+func eventEntityIDEquals(entityID, id any) bool {
+	return fmt.Sprintf("%v", normalizeEventEntityID(entityID)) == fmt.Sprintf("%v", normalizeEventEntityID(id))
+}
+
+func normalizeEventEntityID(id any) any {
+	switch id := id.(type) {
+	case float64:
+		return int64(id)
+	case float32:
+		return int64(id)
+	case int:
+		return int64(id)
+	}
+
+	return id
+}
@@ -0,0 +1,317 @@
+//go:build unit
+
+package helper_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+type testEvent struct {
+	ID     int                  `json:"id"`
+	Action linodego.EventAction `json:"action"`
+	Status linodego.EventStatus `json:"status"`
+	Entity map[string]any       `json:"entity"`
+
+	SecondaryEntity map[string]any `json:"secondary_entity,omitempty"`
+}
+
+// newTestEventServer returns a server listing the given events, which may
+// be updated by the caller, and a counter of the requests polling for events.
+func newTestEventServer(t *testing.T, events *[]testEvent, mu *sync.Mutex) (*linodego.Client, *atomic.Int64) {
+	t.Helper()
+
+	return newTestEventServerWithFailures(t, events, mu, 0)
+}
+
+// newTestEventServerWithFailures returns a server like newTestEventServer
+// that fails the given number of requests polling for events first.
+func newTestEventServerWithFailures(
+	t *testing.T, events *[]testEvent, mu *sync.Mutex, failures int64,
+) (*linodego.Client, *atomic.Int64) {
+	t.Helper()
+
+	var polls atomic.Int64
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v4/account/events" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		if strings.Contains(r.Header.Get("X-Filter"), "+gt") {
+			if polls.Add(1) <= failures {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+		}
+
+		mu.Lock()
+		data, err := json.Marshal(map[string]any{
+			"data":    *events,
+			"page":    1,
+			"pages":   1,
+			"results": len(*events),
+		})
+		mu.Unlock()
+
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(data)
+	}))
+	t.Cleanup(server.Close)
+
+	client := linodego.NewClient(http.DefaultClient)
+	client.SetBaseURL(server.URL)
+
+	return &client, &polls
+}
+
+func newTestEvent(id, entityID int, action linodego.EventAction, status linodego.EventStatus) testEvent {
+	return testEvent{
+		ID:     id,
+		Action: action,
+		Status: status,
+		Entity: map[string]any{"id": entityID, "type": linodego.EntityLinode},
+	}
+}
+
+func TestEventWatcher_sharedPolling(t *testing.T) {
+	var mu sync.Mutex
+
+	events := []testEvent{
+		newTestEvent(10, 1, linodego.ActionLinodeBoot, linodego.EventFinished),
+	}
+
+	client, polls := newTestEventServer(t, &events, &mu)
+	watcher := helper.NewEventWatcher(client, 10*time.Millisecond)
+
+	ctx := context.Background()
+
+	var waiters []*helper.EventWaiter
+
+	for _, id := range []int{1, 2, 3} {
+		waiter, err := watcher.NewEventWaiter(ctx, id, linodego.EntityLinode, linodego.ActionLinodeBoot)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		waiters = append(waiters, waiter)
+	}
+
+	mu.Lock()
+	events = append(events,
+		newTestEvent(11, 1, linodego.ActionLinodeBoot, linodego.EventStarted),
+		newTestEvent(12, 2, linodego.ActionLinodeShutdown, linodego.EventFinished),
+		newTestEvent(13, 2, linodego.ActionLinodeBoot, linodego.EventFinished),
+		newTestEvent(14, 3, linodego.ActionLinodeBoot, linodego.EventFinished),
+	)
+	mu.Unlock()
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		events[1].Status = linodego.EventFinished
+	}()
+
+	results := make([]int, len(waiters))
+
+	var wg sync.WaitGroup
+
+	for i, waiter := range waiters {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			event, err := waiter.WaitForFinished(ctx, 5)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+
+			results[i] = event.ID
+		}()
+	}
+
+	wg.Wait()
+
+	expected := []int{11, 13, 14}
+	for i := range expected {
+		if results[i] != expected[i] {
+			t.Errorf("expected events %v, got %v", expected, results)
+			break
+		}
+	}
+
+	// Waiters share a single polling loop, so the number of polls depends only on
+	// how long the slowest event takes rather than the number of waiters.
+	if count := polls.Load(); count > 15 {
+		t.Errorf("expected waiters to share polls, got %d polls", count)
+	}
+}
+
+func TestEventWaiter_failed(t *testing.T) {
+	var mu sync.Mutex
+
+	events := []testEvent{}
+
+	client, _ := newTestEventServer(t, &events, &mu)
+	watcher := helper.NewEventWatcher(client, 10*time.Millisecond)
+
+	waiter, err := watcher.NewEventWaiterWithSecondary(
+		context.Background(), 1, linodego.EntityLinode, 2, linodego.ActionDiskResize,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	otherDisk := newTestEvent(1, 1, linodego.ActionDiskResize, linodego.EventFinished)
+	otherDisk.SecondaryEntity = map[string]any{"id": 3, "type": "disk"}
+
+	disk := newTestEvent(2, 1, linodego.ActionDiskResize, linodego.EventFailed)
+	disk.SecondaryEntity = map[string]any{"id": 2, "type": "disk"}
+
+	mu.Lock()
+	events = append(events, otherDisk, disk)
+	mu.Unlock()
+
+	_, err = waiter.WaitForFinished(context.Background(), 5)
+	if err == nil || err.Error() != "event 2 has failed" {
+		t.Fatalf("expected event 2 to have failed, got %v", err)
+	}
+}
+
+func TestEventWatcher_listEventsFailure(t *testing.T) {
+	var mu sync.Mutex
+
+	events := []testEvent{}
+
+	client, polls := newTestEventServerWithFailures(t, &events, &mu, 3)
+	watcher := helper.NewEventWatcher(client, 10*time.Millisecond)
+
+	waiter, err := watcher.NewEventWaiter(context.Background(), 1, linodego.EntityLinode, linodego.ActionLinodeBoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mu.Lock()
+	events = append(events, newTestEvent(1, 1, linodego.ActionLinodeBoot, linodego.EventFinished))
+	mu.Unlock()
+
+	// Failing to list events should not fail the waiter
+	event, err := waiter.WaitForFinished(context.Background(), 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if event.ID != 1 {
+		t.Errorf("expected event 1, got %d", event.ID)
+	}
+
+	if count := polls.Load(); count <= 3 {
+		t.Errorf("expected polling to continue after failures, got %d polls", count)
+	}
+}
+
+func TestEventWatcher_newWaiterIgnoresOlderEvents(t *testing.T) {
+	var mu sync.Mutex
+
+	events := []testEvent{}
+
+	client, polls := newTestEventServer(t, &events, &mu)
+	watcher := helper.NewEventWatcher(client, 10*time.Millisecond)
+
+	ctx := context.Background()
+
+	// Keep the watcher polling with a waiter that never receives its event
+	idle, err := watcher.NewEventWaiter(ctx, 1, linodego.EntityLinode, linodego.ActionLinodeBoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	go idle.WaitForFinished(ctx, 1)
+
+	for polls.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	// This event is created before the waiter and must not be matched
+	// even though the watcher has not polled it yet.
+	mu.Lock()
+	events = append(events, newTestEvent(20, 2, linodego.ActionLinodeBoot, linodego.EventFinished))
+	mu.Unlock()
+
+	waiter, err := watcher.NewEventWaiter(ctx, 2, linodego.EntityLinode, linodego.ActionLinodeBoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mu.Lock()
+	events = append(events, newTestEvent(21, 2, linodego.ActionLinodeBoot, linodego.EventFinished))
+	mu.Unlock()
+
+	event, err := waiter.WaitForFinished(ctx, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if event.ID != 21 {
+		t.Errorf("expected event 21, got %d", event.ID)
+	}
+}
+
+func TestEventWaiter_withoutEntity(t *testing.T) {
+	var mu sync.Mutex
+
+	events := []testEvent{}
+
+	client, _ := newTestEventServer(t, &events, &mu)
+	watcher := helper.NewEventWatcher(client, 10*time.Millisecond)
+
+	ctx := context.Background()
+
+	waiter, err := watcher.NewEventWaiterWithoutEntity(ctx, linodego.EntityLinode, linodego.ActionLinodeCreate)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := waiter.WaitForFinished(ctx, 1); err == nil {
+		t.Fatal("expected an error waiting without an entity ID")
+	}
+
+	mu.Lock()
+	events = append(events,
+		newTestEvent(1, 1, linodego.ActionLinodeCreate, linodego.EventFinished),
+		newTestEvent(2, 2, linodego.ActionLinodeCreate, linodego.EventFinished),
+	)
+	mu.Unlock()
+
+	waiter.EntityID = 2
+
+	event, err := waiter.WaitForFinished(ctx, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if event.ID != 2 {
+		t.Errorf("expected event 2, got %d", event.ID)
+	}
+}
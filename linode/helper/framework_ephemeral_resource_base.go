@@ -0,0 +1,116 @@
+package helper
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+)
+
+// NewBaseEphemeralResource returns a new instance of the BaseEphemeralResource
+// struct for cleaner initialization.
+func NewBaseEphemeralResource(cfg BaseEphemeralResourceConfig) BaseEphemeralResource {
+	return BaseEphemeralResource{
+		Config: cfg,
+	}
+}
+
+// BaseEphemeralResourceConfig contains all configurable base ephemeral resource fields.
+type BaseEphemeralResourceConfig struct {
+	Name string
+
+	// Optional
+	Schema        *schema.Schema
+	IsEarlyAccess bool
+}
+
+// BaseEphemeralResource contains various re-usable fields and methods
+// intended for use in ephemeral resource implementations by composition.
+type BaseEphemeralResource struct {
+	Config BaseEphemeralResourceConfig
+	Meta   *FrameworkProviderMeta
+}
+
+func (r *BaseEphemeralResource) Configure(
+	ctx context.Context,
+	req ephemeral.ConfigureRequest,
+	resp *ephemeral.ConfigureResponse,
+) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.Meta = GetEphemeralResourceMeta(req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.Config.IsEarlyAccess {
+		resp.Diagnostics.Append(
+			AttemptWarnEarlyAccessFramework(r.Meta.Config)...,
+		)
+	}
+}
+
+func (r *BaseEphemeralResource) Metadata(
+	ctx context.Context,
+	req ephemeral.MetadataRequest,
+	resp *ephemeral.MetadataResponse,
+) {
+	resp.TypeName = r.Config.Name
+}
+
+func (r *BaseEphemeralResource) Schema(
+	ctx context.Context,
+	req ephemeral.SchemaRequest,
+	resp *ephemeral.SchemaResponse,
+) {
+	if r.Config.Schema == nil {
+		resp.Diagnostics.AddError(
+			"Missing Schema",
+			"Base ephemeral resource was not provided a schema. "+
+				"Please provide a Schema config attribute or implement, the Schema(...) function.",
+		)
+		return
+	}
+
+	resp.Schema = *r.Config.Schema
+}
+
+// ephemeralPrivateIDKey is the private data key used to carry the ID of
+// an object created by an ephemeral resource from Open to Close.
+const ephemeralPrivateIDKey = "id"
+
+type ephemeralPrivateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+type ephemeralPrivateGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+// SetEphemeralPrivateID stores the ID of an object created in Open
+// so it can be cleaned up in Close.
+func SetEphemeralPrivateID(ctx context.Context, private ephemeralPrivateSetter, id int) diag.Diagnostics {
+	return private.SetKey(ctx, ephemeralPrivateIDKey, []byte(strconv.Itoa(id)))
+}
+
+// GetEphemeralPrivateID returns the ID stored by SetEphemeralPrivateID and
+// whether it was present.
+func GetEphemeralPrivateID(ctx context.Context, private ephemeralPrivateGetter) (int, bool, diag.Diagnostics) {
+	value, diags := private.GetKey(ctx, ephemeralPrivateIDKey)
+	if diags.HasError() || value == nil {
+		return 0, false, diags
+	}
+
+	id, err := strconv.Atoi(string(value))
+	if err != nil {
+		diags.AddError("Failed to parse ephemeral resource private ID", err.Error())
+		return 0, false, diags
+	}
+
+	return id, true, diags
+}
@@ -27,9 +27,106 @@ func GetFrameworkProviderModelFromSDKv2ProviderConfig(config *Config) *Framework
 		ObjSecretKey:                 types.StringValue(config.ObjSecretKey),
 		ObjUseTempKeys:               types.BoolValue(config.ObjUseTempKeys),
 		ObjBucketForceDelete:         types.BoolValue(config.ObjBucketForceDelete),
+		CassetteMode:                 types.StringValue(string(config.CassetteMode)),
+		CassettePath:                 types.StringValue(config.CassettePath),
+		Retry:                        getFrameworkRetryPolicyModels(config.RetryPolicies),
+		RateLimit:                    getFrameworkRateLimitModels(config.RateLimits),
+		ChildAccount:                 getFrameworkChildAccountModels(config.ChildAccountEUUID),
 	}
 }
 
+func getFrameworkChildAccountModels(euuid string) []FrameworkChildAccountModel {
+	if euuid == "" {
+		return nil
+	}
+
+	return []FrameworkChildAccountModel{{EUUID: types.StringValue(euuid)}}
+}
+
+func getFrameworkRateLimitModels(limits []RateLimit) []FrameworkRateLimitModel {
+	result := make([]FrameworkRateLimitModel, len(limits))
+
+	for i, limit := range limits {
+		result[i] = FrameworkRateLimitModel{
+			Endpoint:          types.StringValue(string(limit.Endpoint)),
+			Operation:         types.StringValue(string(limit.Operation)),
+			RequestsPerSecond: types.Float64Value(limit.RequestsPerSecond),
+			Burst:             types.Int64Value(int64(limit.Burst)),
+			MaxInFlight:       types.Int64Value(int64(limit.MaxInFlight)),
+		}
+	}
+
+	return result
+}
+
+func getFrameworkRetryPolicyModels(policies []RetryPolicy) []FrameworkRetryPolicyModel {
+	result := make([]FrameworkRetryPolicyModel, len(policies))
+
+	for i, policy := range policies {
+		result[i].StatusCodes = make([]types.Int64, len(policy.StatusCodes))
+		for j, statusCode := range policy.StatusCodes {
+			result[i].StatusCodes[j] = types.Int64Value(int64(statusCode))
+		}
+
+		result[i].PathPatterns = make([]types.String, len(policy.PathPatterns))
+		for j, pattern := range policy.PathPatterns {
+			result[i].PathPatterns[j] = types.StringValue(pattern)
+		}
+
+		result[i].Methods = make([]types.String, len(policy.Methods))
+		for j, method := range policy.Methods {
+			result[i].Methods[j] = types.StringValue(method)
+		}
+
+		result[i].MaxAttempts = types.Int64Value(int64(policy.MaxAttempts))
+	}
+
+	return result
+}
+
+// GetConfigFromFrameworkProviderModel converts from FrameworkModel to the SDKv2 provider config
+func GetConfigFromFrameworkProviderModel(model *FrameworkProviderModel) *Config {
+	config := &Config{
+		AccessToken:                  model.AccessToken.ValueString(),
+		APIURL:                       model.APIURL.ValueString(),
+		APIVersion:                   model.APIVersion.ValueString(),
+		UAPrefix:                     model.UAPrefix.ValueString(),
+		ConfigPath:                   model.ConfigPath.ValueString(),
+		ConfigProfile:                model.ConfigProfile.ValueString(),
+		SkipInstanceReadyPoll:        model.SkipInstanceReadyPoll.ValueBool(),
+		SkipInstanceDeletePoll:       model.SkipInstanceDeletePoll.ValueBool(),
+		SkipImplicitReboots:          model.SkipImplicitReboots.ValueBool(),
+		DisableInternalCache:         model.DisableInternalCache.ValueBool(),
+		MinRetryDelayMilliseconds:    int(model.MinRetryDelayMilliseconds.ValueInt64()),
+		MaxRetryDelayMilliseconds:    int(model.MaxRetryDelayMilliseconds.ValueInt64()),
+		EventPollMilliseconds:        int(model.EventPollMilliseconds.ValueInt64()),
+		LKEEventPollMilliseconds:     int(model.LKEEventPollMilliseconds.ValueInt64()),
+		LKENodeReadyPollMilliseconds: int(model.LKENodeReadyPollMilliseconds.ValueInt64()),
+		ObjAccessKey:                 model.ObjAccessKey.ValueString(),
+		ObjSecretKey:                 model.ObjSecretKey.ValueString(),
+		ObjUseTempKeys:               model.ObjUseTempKeys.ValueBool(),
+		ObjBucketForceDelete:         model.ObjBucketForceDelete.ValueBool(),
+		CassetteMode:                 CassetteMode(model.CassetteMode.ValueString()),
+		CassettePath:                 model.CassettePath.ValueString(),
+		RetryPolicies:                make([]RetryPolicy, len(model.Retry)),
+		RateLimits:                   make([]RateLimit, len(model.RateLimit)),
+	}
+
+	if len(model.ChildAccount) > 0 {
+		config.ChildAccountEUUID = model.ChildAccount[0].EUUID.ValueString()
+	}
+
+	for i, retry := range model.Retry {
+		config.RetryPolicies[i] = retry.RetryPolicy()
+	}
+
+	for i, rateLimit := range model.RateLimit {
+		config.RateLimits[i] = rateLimit.RateLimit()
+	}
+
+	return config
+}
+
 type FrameworkProviderModel struct {
 	AccessToken types.String `tfsdk:"token"`
 	APIURL      types.String `tfsdk:"url"`
@@ -58,9 +155,72 @@ type FrameworkProviderModel struct {
 	ObjSecretKey         types.String `tfsdk:"obj_secret_key"`
 	ObjUseTempKeys       types.Bool   `tfsdk:"obj_use_temp_keys"`
 	ObjBucketForceDelete types.Bool   `tfsdk:"obj_bucket_force_delete"`
+
+	CassetteMode types.String `tfsdk:"cassette_mode"`
+	CassettePath types.String `tfsdk:"cassette_path"`
+
+	Retry     []FrameworkRetryPolicyModel `tfsdk:"retry"`
+	RateLimit []FrameworkRateLimitModel   `tfsdk:"rate_limit"`
+
+	ChildAccount []FrameworkChildAccountModel `tfsdk:"child_account"`
+}
+
+type FrameworkChildAccountModel struct {
+	EUUID types.String `tfsdk:"euuid"`
+}
+
+type FrameworkRateLimitModel struct {
+	Endpoint          types.String  `tfsdk:"endpoint"`
+	Operation         types.String  `tfsdk:"operation"`
+	RequestsPerSecond types.Float64 `tfsdk:"requests_per_second"`
+	Burst             types.Int64   `tfsdk:"burst"`
+	MaxInFlight       types.Int64   `tfsdk:"max_in_flight"`
+}
+
+// RateLimit converts the model into a RateLimit.
+func (m *FrameworkRateLimitModel) RateLimit() RateLimit {
+	return RateLimit{
+		Endpoint:          RateLimitEndpoint(m.Endpoint.ValueString()),
+		Operation:         RateLimitOperation(m.Operation.ValueString()),
+		RequestsPerSecond: m.RequestsPerSecond.ValueFloat64(),
+		Burst:             int(m.Burst.ValueInt64()),
+		MaxInFlight:       int(m.MaxInFlight.ValueInt64()),
+	}
+}
+
+type FrameworkRetryPolicyModel struct {
+	StatusCodes  []types.Int64  `tfsdk:"status_codes"`
+	PathPatterns []types.String `tfsdk:"path_patterns"`
+	Methods      []types.String `tfsdk:"methods"`
+	MaxAttempts  types.Int64    `tfsdk:"max_attempts"`
+}
+
+// RetryPolicy converts the model into a RetryPolicy.
+func (m *FrameworkRetryPolicyModel) RetryPolicy() RetryPolicy {
+	result := RetryPolicy{
+		StatusCodes:  make([]int, len(m.StatusCodes)),
+		PathPatterns: make([]string, len(m.PathPatterns)),
+		Methods:      make([]string, len(m.Methods)),
+		MaxAttempts:  int(m.MaxAttempts.ValueInt64()),
+	}
+
+	for i, statusCode := range m.StatusCodes {
+		result.StatusCodes[i] = int(statusCode.ValueInt64())
+	}
+
+	for i, pattern := range m.PathPatterns {
+		result.PathPatterns[i] = pattern.ValueString()
+	}
+
+	for i, method := range m.Methods {
+		result.Methods[i] = method.ValueString()
+	}
+
+	return result
 }
 
 type FrameworkProviderMeta struct {
-	Client *linodego.Client
-	Config *FrameworkProviderModel
+	Client       *linodego.Client
+	Config       *FrameworkProviderModel
+	EventWatcher *EventWatcher
 }
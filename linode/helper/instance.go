@@ -28,18 +28,20 @@ func RebootInstance(ctx context.Context, d *schema.ResourceData, linodeID int,
 	meta interface{}, bootConfig int,
 ) diag.Diagnostics {
 	client := meta.(*ProviderMeta).Client
+	eventWatcher := meta.(*ProviderMeta).EventWatcher
 	ctx, cancel := context.WithTimeout(
 		ctx,
 		time.Duration(GetDeadlineSeconds(ctx, d))*time.Second,
 	)
 	defer cancel()
-	return diag.FromErr(rebootInstance(ctx, linodeID, &client, bootConfig))
+	return diag.FromErr(rebootInstance(ctx, linodeID, &client, eventWatcher, bootConfig))
 }
 
 func FrameworkRebootInstance(
 	ctx context.Context,
 	linodeID int,
 	client *linodego.Client,
+	eventWatcher *EventWatcher,
 	bootConfig int,
 ) fwdiag.Diagnostics {
 	var diags fwdiag.Diagnostics
@@ -51,7 +53,7 @@ func FrameworkRebootInstance(
 		)
 		defer cancel()
 	}
-	err := rebootInstance(ctx, linodeID, client, bootConfig)
+	err := rebootInstance(ctx, linodeID, client, eventWatcher, bootConfig)
 	if err != nil {
 		diags.AddError("Failed to Reboot Instance", err.Error())
 	}
@@ -62,6 +64,7 @@ func rebootInstance(
 	ctx context.Context,
 	entityID int,
 	client *linodego.Client,
+	eventWatcher *EventWatcher,
 	bootConfig int,
 ) error {
 	ctx = SetLogFieldBulk(ctx, map[string]any{
@@ -80,9 +83,9 @@ func rebootInstance(
 
 	tflog.Info(ctx, "Rebooting instance")
 
-	p, err := client.NewEventPoller(ctx, entityID, linodego.EntityLinode, linodego.ActionLinodeReboot)
+	p, err := eventWatcher.NewEventWaiter(ctx, entityID, linodego.EntityLinode, linodego.ActionLinodeReboot)
 	if err != nil {
-		return fmt.Errorf("failed to initialize event poller: %s", err)
+		return fmt.Errorf("failed to initialize event waiter: %s", err)
 	}
 
 	err = client.RebootInstance(ctx, instance.ID, bootConfig)
@@ -144,6 +147,54 @@ func GetCurrentBootedConfig(ctx context.Context, client *linodego.Client, instID
 		return 0, nil
 	}
 
+	event, err := getLatestBootEvent(ctx, client, instID)
+	if err != nil {
+		return 0, err
+	}
+
+	if event == nil {
+		// This is a valid exit case
+		return 0, nil
+	}
+
+	// Special case for instances booted into rescue mode
+	if event.SecondaryEntity == nil {
+		return 0, nil
+	}
+
+	return int(event.SecondaryEntity.ID.(float64)), nil
+}
+
+// IsInstanceBootedIntoRescue returns whether the latest boot of the given
+// instance was into Rescue Mode. Unlike for regular boots, the boot event
+// of an instance booted into Rescue Mode doesn't reference a config.
+func IsInstanceBootedIntoRescue(ctx context.Context, client *linodego.Client, instID int) (bool, error) {
+	eventID, err := GetRescueBootEventID(ctx, client, instID)
+	if err != nil {
+		return false, err
+	}
+
+	return eventID != 0, nil
+}
+
+// GetRescueBootEventID returns the ID of the event the given instance was
+// booted into Rescue Mode with, or 0 if its latest boot was not into Rescue Mode.
+func GetRescueBootEventID(ctx context.Context, client *linodego.Client, instID int) (int, error) {
+	event, err := getLatestBootEvent(ctx, client, instID)
+	if err != nil {
+		return 0, err
+	}
+
+	if event == nil || event.SecondaryEntity != nil {
+		return 0, nil
+	}
+
+	return event.ID, nil
+}
+
+// getLatestBootEvent returns the latest boot event of the given instance,
+// or nil if the instance has no boot events.
+func getLatestBootEvent(ctx context.Context, client *linodego.Client, instID int) (*linodego.Event, error) {
 	filter := map[string]any{
 		"entity.id":   instID,
 		"entity.type": linodego.EntityLinode,
@@ -158,27 +209,21 @@ func GetCurrentBootedConfig(ctx context.Context, client *linodego.Client, instID
 
 	filterBytes, err := json.Marshal(filter)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
 	events, err := client.ListEvents(ctx, &linodego.ListOptions{
 		Filter: string(filterBytes),
 	})
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
 	if len(events) < 1 {
-		// This is a valid exit case
-		return 0, nil
-	}
-
-	// Special case for instances booted into rescue mode
-	if events[0].SecondaryEntity == nil {
-		return 0, nil
+		return nil, nil
 	}
 
-	return int(events[0].SecondaryEntity.ID.(float64)), nil
+	return &events[0], nil
 }
 
 func FrameworkCreateRandomRootPassword(diags *fwdiag.Diagnostics) string {
@@ -3,6 +3,9 @@
 package helper
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/linode/linodego"
@@ -55,3 +58,56 @@ func TestFlattenConfigInterface(t *testing.T) {
 		}
 	}
 }
+
+func TestIsInstanceBootedIntoRescue(t *testing.T) {
+	testCases := map[string]struct {
+		events          string
+		expected        bool
+		expectedEventID int
+	}{
+		"no boot events": {
+			events:   `[]`,
+			expected: false,
+		},
+		"booted into rescue mode": {
+			events:          `[{"id": 2, "action": "linode_boot", "entity": {"id": 123}, "secondary_entity": null}]`,
+			expected:        true,
+			expectedEventID: 2,
+		},
+		"booted into config": {
+			events:   `[{"id": 2, "action": "linode_reboot", "entity": {"id": 123}, "secondary_entity": {"id": 456}}]`,
+			expected: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(`{"data": ` + tc.events + `, "page": 1, "pages": 1, "results": 1}`))
+			}))
+			t.Cleanup(server.Close)
+
+			client := linodego.NewClient(http.DefaultClient)
+			client.SetBaseURL(server.URL)
+
+			inRescue, err := IsInstanceBootedIntoRescue(context.Background(), &client, 123)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if inRescue != tc.expected {
+				t.Errorf("expected %t, got %t", tc.expected, inRescue)
+			}
+
+			eventID, err := GetRescueBootEventID(context.Background(), &client, 123)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if eventID != tc.expectedEventID {
+				t.Errorf("expected event %d, got %d", tc.expectedEventID, eventID)
+			}
+		})
+	}
+}
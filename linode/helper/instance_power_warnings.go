@@ -0,0 +1,134 @@
+package helper
+
+import (
+	"fmt"
+	"strings"
+
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// InstancePowerWarnings collects the reasons a planned change will shut down or
+// reboot a Linode so they can be surfaced as warnings before the change is applied.
+type InstancePowerWarnings struct {
+	linodeID            int
+	skipImplicitReboots bool
+
+	shutdown         []string
+	reboot           []string
+	implicitReboot   []string
+	requiredShutdown []string
+}
+
+// InstancePowerWarning is a single warning about a planned
+// shutdown or reboot of a Linode.
+type InstancePowerWarning struct {
+	Summary string
+	Detail  string
+}
+
+func NewInstancePowerWarnings(linodeID int, skipImplicitReboots bool) *InstancePowerWarnings {
+	return &InstancePowerWarnings{
+		linodeID:            linodeID,
+		skipImplicitReboots: skipImplicitReboots,
+	}
+}
+
+// Shutdown records a change that shuts down the Linode while it is applied.
+func (w *InstancePowerWarnings) Shutdown(format string, args ...any) {
+	w.shutdown = append(w.shutdown, fmt.Sprintf(format, args...))
+}
+
+// Reboot records a change that reboots the Linode regardless of skip_implicit_reboots.
+func (w *InstancePowerWarnings) Reboot(format string, args ...any) {
+	w.reboot = append(w.reboot, fmt.Sprintf(format, args...))
+}
+
+// ImplicitReboot records a change that implicitly reboots the Linode,
+// which is skipped when skip_implicit_reboots is enabled.
+func (w *InstancePowerWarnings) ImplicitReboot(format string, args ...any) {
+	if w.skipImplicitReboots {
+		w.implicitReboot = append(w.implicitReboot, fmt.Sprintf(format, args...))
+		return
+	}
+
+	w.reboot = append(w.reboot, fmt.Sprintf(format, args...))
+}
+
+// RequiredShutdown records a change that shuts down the Linode,
+// which fails when skip_implicit_reboots is enabled.
+func (w *InstancePowerWarnings) RequiredShutdown(format string, args ...any) {
+	if w.skipImplicitReboots {
+		w.requiredShutdown = append(w.requiredShutdown, fmt.Sprintf(format, args...))
+		return
+	}
+
+	w.shutdown = append(w.shutdown, fmt.Sprintf(format, args...))
+}
+
+// Warnings returns a warning for each kind of recorded change.
+func (w *InstancePowerWarnings) Warnings() []InstancePowerWarning {
+	var result []InstancePowerWarning
+
+	add := func(reasons []string, summary, detail string) {
+		if len(reasons) < 1 {
+			return
+		}
+
+		result = append(result, InstancePowerWarning{
+			Summary: fmt.Sprintf(summary, w.linodeID),
+			Detail:  fmt.Sprintf(detail, w.linodeID) + "\n\n- " + strings.Join(reasons, "\n- "),
+		})
+	}
+
+	add(
+		w.shutdown,
+		"Linode Instance %d Will Be Shut Down",
+		"Applying this plan will shut down Linode %d because:",
+	)
+	add(
+		w.reboot,
+		"Linode Instance %d Will Be Rebooted",
+		"Applying this plan will reboot Linode %d because:",
+	)
+	add(
+		w.implicitReboot,
+		"Linode Instance %d Requires a Reboot",
+		"The following changes will not take effect until Linode %d is rebooted "+
+			"as 'skip_implicit_reboots' is enabled in the provider config:",
+	)
+	add(
+		w.requiredShutdown,
+		"Linode Instance %d Must Be Shut Down",
+		"Applying this plan will fail as the following changes require Linode %d to be shut down, "+
+			"which is not allowed while 'skip_implicit_reboots' is enabled in the provider config:",
+	)
+
+	return result
+}
+
+// SDKv2Diagnostics returns the warnings as SDKv2 diagnostics.
+func (w *InstancePowerWarnings) SDKv2Diagnostics() diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, warning := range w.Warnings() {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  warning.Summary,
+			Detail:   warning.Detail,
+		})
+	}
+
+	return diags
+}
+
+// FrameworkDiagnostics returns the warnings as framework diagnostics.
+func (w *InstancePowerWarnings) FrameworkDiagnostics() fwdiag.Diagnostics {
+	var diags fwdiag.Diagnostics
+
+	for _, warning := range w.Warnings() {
+		diags.AddWarning(warning.Summary, warning.Detail)
+	}
+
+	return diags
+}
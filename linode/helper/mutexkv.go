@@ -0,0 +1,42 @@
+package helper
+
+import (
+	"sync"
+)
+
+// MutexKV is a set of mutexes by key, used to serialize
+// operations on the same remote object across resources.
+type MutexKV struct {
+	mu    sync.Mutex
+	store map[string]*sync.Mutex
+}
+
+// NewMutexKV returns a new, empty MutexKV.
+func NewMutexKV() *MutexKV {
+	return &MutexKV{
+		store: make(map[string]*sync.Mutex),
+	}
+}
+
+// Lock locks the mutex of the given key, creating it if necessary.
+func (m *MutexKV) Lock(key string) {
+	m.get(key).Lock()
+}
+
+// Unlock unlocks the mutex of the given key.
+func (m *MutexKV) Unlock(key string) {
+	m.get(key).Unlock()
+}
+
+func (m *MutexKV) get(key string) *sync.Mutex {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	mutex, ok := m.store[key]
+	if !ok {
+		mutex = &sync.Mutex{}
+		m.store[key] = mutex
+	}
+
+	return mutex
+}
@@ -0,0 +1,63 @@
+//go:build unit
+
+package helper_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+func TestMutexKV(t *testing.T) {
+	m := helper.NewMutexKV()
+
+	m.Lock("1")
+
+	// Different keys don't block each other
+	done := make(chan struct{})
+	go func() {
+		m.Lock("2")
+		m.Unlock("2")
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("expected a different key not to be blocked")
+	}
+
+	// The same key is blocked until it is unlocked
+	var mu sync.Mutex
+	locked := false
+
+	done = make(chan struct{})
+	go func() {
+		m.Lock("1")
+		defer m.Unlock("1")
+
+		mu.Lock()
+		locked = true
+		mu.Unlock()
+
+		close(done)
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+
+	mu.Lock()
+	if locked {
+		t.Fatal("expected the same key to be blocked")
+	}
+	mu.Unlock()
+
+	m.Unlock("1")
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("expected the key to be unlocked")
+	}
+}
@@ -0,0 +1,190 @@
+package helper
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"golang.org/x/sync/semaphore"
+	"golang.org/x/time/rate"
+)
+
+// RateLimitEndpoint is a class of API endpoints a rate limit applies to.
+type RateLimitEndpoint string
+
+const (
+	RateLimitEndpointAll           RateLimitEndpoint = ""
+	RateLimitEndpointCompute       RateLimitEndpoint = "compute"
+	RateLimitEndpointObjectStorage RateLimitEndpoint = "object_storage"
+)
+
+// RateLimitOperation is a class of API operations a rate limit applies to.
+type RateLimitOperation string
+
+const (
+	RateLimitOperationAll   RateLimitOperation = ""
+	RateLimitOperationRead  RateLimitOperation = "read"
+	RateLimitOperationWrite RateLimitOperation = "write"
+)
+
+// RateLimit declares the rate and concurrency limits
+// for API requests in a given endpoint and operation class.
+type RateLimit struct {
+	// Endpoint is the class of endpoints this limit applies to.
+	// If empty, the limit applies to all endpoints.
+	Endpoint RateLimitEndpoint
+
+	// Operation is the class of operations this limit applies to.
+	// If empty, the limit applies to all operations.
+	Operation RateLimitOperation
+
+	// RequestsPerSecond is the rate at which tokens are added to the bucket.
+	// If zero, requests are not rate limited.
+	RequestsPerSecond float64
+
+	// Burst is the size of the token bucket.
+	// If zero, the burst is the ceiling of RequestsPerSecond.
+	Burst int
+
+	// MaxInFlight is the maximum number of concurrent requests.
+	// If zero, the number of concurrent requests is not limited.
+	MaxInFlight int
+}
+
+// matches returns whether the given request falls within the limit's classes.
+func (l RateLimit) matches(r *http.Request) bool {
+	if l.Endpoint != RateLimitEndpointAll && l.Endpoint != rateLimitEndpointOf(r) {
+		return false
+	}
+
+	return l.Operation == RateLimitOperationAll || l.Operation == rateLimitOperationOf(r)
+}
+
+func rateLimitEndpointOf(r *http.Request) RateLimitEndpoint {
+	if strings.Contains(r.URL.Path, "/object-storage/") {
+		return RateLimitEndpointObjectStorage
+	}
+
+	return RateLimitEndpointCompute
+}
+
+func rateLimitOperationOf(r *http.Request) RateLimitOperation {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return RateLimitOperationRead
+	default:
+		return RateLimitOperationWrite
+	}
+}
+
+// rateLimiter holds the state for a single RateLimit.
+type rateLimiter struct {
+	limit    RateLimit
+	tokens   *rate.Limiter
+	inFlight *semaphore.Weighted
+}
+
+func newRateLimiter(limit RateLimit) *rateLimiter {
+	result := &rateLimiter{limit: limit}
+
+	if limit.RequestsPerSecond > 0 {
+		burst := limit.Burst
+		if burst <= 0 {
+			burst = int(limit.RequestsPerSecond)
+			if float64(burst) < limit.RequestsPerSecond {
+				burst++
+			}
+		}
+
+		result.tokens = rate.NewLimiter(rate.Limit(limit.RequestsPerSecond), burst)
+	}
+
+	if limit.MaxInFlight > 0 {
+		result.inFlight = semaphore.NewWeighted(int64(limit.MaxInFlight))
+	}
+
+	return result
+}
+
+// rateLimiters holds all limiter state by its configuration so that the
+// muxed SDKv2 and framework providers share the same token buckets.
+var (
+	rateLimiters   = make(map[string][]*rateLimiter)
+	rateLimitersMu sync.Mutex
+)
+
+func getRateLimiters(limits []RateLimit) []*rateLimiter {
+	key := fmt.Sprintf("%+v", limits)
+
+	rateLimitersMu.Lock()
+	defer rateLimitersMu.Unlock()
+
+	if limiters, ok := rateLimiters[key]; ok {
+		return limiters
+	}
+
+	limiters := make([]*rateLimiter, len(limits))
+	for i, limit := range limits {
+		limiters[i] = newRateLimiter(limit)
+	}
+
+	rateLimiters[key] = limiters
+
+	return limiters
+}
+
+// RateLimitTransport is a RoundTripper that limits the rate and
+// concurrency of API requests.
+type RateLimitTransport struct {
+	transport http.RoundTripper
+	limiters  []*rateLimiter
+}
+
+// NewRateLimitTransport wraps the given RoundTripper to apply the given limits.
+// A request must satisfy every limit whose classes it falls within.
+func NewRateLimitTransport(transport http.RoundTripper, limits []RateLimit) *RateLimitTransport {
+	return &RateLimitTransport{
+		transport: transport,
+		limiters:  getRateLimiters(limits),
+	}
+}
+
+// WrapRateLimitTransport wraps the given RoundTripper in a RateLimitTransport
+// if any limits are specified.
+func WrapRateLimitTransport(transport http.RoundTripper, limits []RateLimit) http.RoundTripper {
+	if len(limits) == 0 {
+		return transport
+	}
+
+	return NewRateLimitTransport(transport, limits)
+}
+
+// RoundTrip waits for the request to be allowed by all matching limits
+// before executing it.
+func (t *RateLimitTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	ctx := r.Context()
+
+	// Semaphores are always acquired in the order of the limits
+	// to prevent deadlocks between concurrent requests.
+	for _, limiter := range t.limiters {
+		if !limiter.limit.matches(r) {
+			continue
+		}
+
+		if limiter.inFlight != nil {
+			if err := limiter.inFlight.Acquire(ctx, 1); err != nil {
+				return nil, err
+			}
+			defer limiter.inFlight.Release(1)
+		}
+
+		if limiter.tokens != nil {
+			if err := limiter.tokens.Wait(ctx); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return t.transport.RoundTrip(r)
+}
@@ -0,0 +1,123 @@
+//go:build unit
+
+package helper_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+func TestRateLimitTransport_maxInFlight(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int64
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+
+		for {
+			previous := maxInFlight.Load()
+			if current <= previous || maxInFlight.CompareAndSwap(previous, current) {
+				break
+			}
+		}
+
+		time.Sleep(20 * time.Millisecond)
+	}))
+	defer server.Close()
+
+	transport := helper.NewRateLimitTransport(http.DefaultTransport, []helper.RateLimit{
+		{
+			Endpoint:    helper.RateLimitEndpointCompute,
+			Operation:   helper.RateLimitOperationWrite,
+			MaxInFlight: 2,
+		},
+	})
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			req, err := http.NewRequest(http.MethodPost, server.URL+"/v4/linode/instances", nil)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+
+			resp, err := transport.RoundTrip(req)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			resp.Body.Close()
+		}()
+	}
+
+	wg.Wait()
+
+	if observed := maxInFlight.Load(); observed > 2 {
+		t.Fatalf("expected at most 2 requests in flight, got %d", observed)
+	}
+}
+
+func TestRateLimitTransport_requestsPerSecond(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	transport := helper.NewRateLimitTransport(http.DefaultTransport, []helper.RateLimit{
+		{
+			Endpoint:          helper.RateLimitEndpointObjectStorage,
+			RequestsPerSecond: 20,
+			Burst:             1,
+		},
+	})
+
+	doRequest := func(path string) {
+		req, err := http.NewRequest(http.MethodGet, server.URL+path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		resp, err := transport.RoundTrip(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+
+	start := time.Now()
+
+	for i := 0; i < 5; i++ {
+		doRequest("/v4/object-storage/buckets")
+	}
+
+	// 5 requests at 20 requests per second with no burst should take at least 200ms
+	if elapsed := time.Since(start); elapsed < 190*time.Millisecond {
+		t.Fatalf("expected object storage requests to be rate limited, took %s", elapsed)
+	}
+
+	start = time.Now()
+
+	for i := 0; i < 5; i++ {
+		doRequest("/v4/linode/instances")
+	}
+
+	if elapsed := time.Since(start); elapsed > 150*time.Millisecond {
+		t.Fatalf("expected compute requests not to be rate limited, took %s", elapsed)
+	}
+}
+
+func TestWrapRateLimitTransport_noLimits(t *testing.T) {
+	if helper.WrapRateLimitTransport(http.DefaultTransport, nil) != http.DefaultTransport {
+		t.Fatal("expected transport to be unwrapped when no limits are specified")
+	}
+}
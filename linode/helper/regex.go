@@ -1,6 +1,7 @@
 package helper
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 
@@ -22,3 +23,37 @@ func RegexMatches(pattern string, errorMessage string) validator.String {
 	patternRegEx := StringToRegex(pattern)
 	return stringvalidator.RegexMatches(patternRegEx, errorMessage)
 }
+
+type validRegexValidator struct{}
+
+func (v validRegexValidator) Description(ctx context.Context) string {
+	return "value must be a valid regular expression"
+}
+
+func (v validRegexValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v validRegexValidator) ValidateString(
+	ctx context.Context,
+	req validator.StringRequest,
+	resp *validator.StringResponse,
+) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := regexp.Compile(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Regular Expression",
+			fmt.Sprintf("%q is not a valid regular expression: %s", req.ConfigValue.ValueString(), err),
+		)
+	}
+}
+
+// ValidRegex returns a validator that ensures a string is a valid regular expression,
+// equivalent to the SDKv2 validation.StringIsValidRegExp.
+func ValidRegex() validator.String {
+	return validRegexValidator{}
+}
@@ -101,3 +101,28 @@ func TestRegexValidator_success(t *testing.T) {
 		t.Fatalf("expected no error; got %s", response.Diagnostics[0].Detail())
 	}
 }
+
+func TestValidRegex(t *testing.T) {
+	v := helper.ValidRegex()
+
+	for value, expectError := range map[string]bool{
+		"lke/clusters/[0-9]+/pools": false,
+		".*":                        false,
+		"lke/clusters/[0-9+/pools":  true,
+		"(unclosed":                 true,
+	} {
+		var response validator.StringResponse
+
+		v.ValidateString(
+			context.Background(),
+			validator.StringRequest{
+				ConfigValue: types.StringValue(value),
+			},
+			&response,
+		)
+
+		if response.Diagnostics.HasError() != expectError {
+			t.Errorf("%q: expected error %t; got %v", value, expectError, response.Diagnostics)
+		}
+	}
+}
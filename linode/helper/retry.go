@@ -1,9 +1,12 @@
 package helper
 
 import (
+	"fmt"
 	"log"
 	"net/url"
 	"regexp"
+	"slices"
+	"strings"
 
 	"github.com/go-resty/resty/v2"
 	"github.com/linode/linodego"
@@ -57,3 +60,87 @@ func ApplyAllRetryConditions(client *linodego.Client) {
 	client.AddRetryCondition(LinodeInstance500Retry())
 	client.AddRetryCondition(ImageUpload500Retry())
 }
+
+// RetryPolicy declares an additional set of conditions
+// under which a failed API request should be retried.
+type RetryPolicy struct {
+	// StatusCodes are the response status codes to retry on.
+	StatusCodes []int
+
+	// PathPatterns are regular expressions matched against the request path.
+	// If empty, requests to all paths are retried.
+	PathPatterns []string
+
+	// Methods are the HTTP methods to retry.
+	// If empty, requests with any method are retried.
+	Methods []string
+
+	// MaxAttempts is the maximum number of attempts for a matching request.
+	// If zero, the client's retry count is used.
+	MaxAttempts int
+}
+
+// RetryPolicyCondition returns a retry condition for the given policy
+// composed of a GenericRetryCondition for each status code and path pattern.
+func RetryPolicyCondition(policy RetryPolicy) (func(response *resty.Response, err error) bool, error) {
+	patterns := make([]*regexp.Regexp, 0, len(policy.PathPatterns))
+
+	for _, pattern := range policy.PathPatterns {
+		compiled, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compile retry path pattern %q: %w", pattern, err)
+		}
+
+		patterns = append(patterns, compiled)
+	}
+
+	if len(patterns) == 0 {
+		patterns = append(patterns, regexp.MustCompile(".*"))
+	}
+
+	conditions := make([]func(response *resty.Response, err error) bool, 0, len(policy.StatusCodes)*len(patterns))
+
+	for _, statusCode := range policy.StatusCodes {
+		for _, pattern := range patterns {
+			conditions = append(conditions, GenericRetryCondition(statusCode, pattern))
+		}
+	}
+
+	return func(response *resty.Response, err error) bool {
+		if response == nil || response.Request == nil {
+			return false
+		}
+
+		if len(policy.Methods) > 0 && !slices.ContainsFunc(policy.Methods, func(method string) bool {
+			return strings.EqualFold(method, response.Request.Method)
+		}) {
+			return false
+		}
+
+		if policy.MaxAttempts > 0 && response.Request.Attempt >= policy.MaxAttempts {
+			return false
+		}
+
+		for _, condition := range conditions {
+			if condition(response, err) {
+				return true
+			}
+		}
+
+		return false
+	}, nil
+}
+
+// ApplyRetryPolicies adds a retry condition to the given client for each of the given policies.
+func ApplyRetryPolicies(client *linodego.Client, policies []RetryPolicy) error {
+	for _, policy := range policies {
+		condition, err := RetryPolicyCondition(policy)
+		if err != nil {
+			return err
+		}
+
+		client.AddRetryCondition(condition)
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+//go:build unit
+
+package helper_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+func TestRetryPolicyCondition(t *testing.T) {
+	condition, err := helper.RetryPolicyCondition(helper.RetryPolicy{
+		StatusCodes:  []int{502, 503},
+		PathPatterns: []string{"lke/clusters/[0-9]+/pools"},
+		Methods:      []string{"get"},
+		MaxAttempts:  3,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newResponse := func(method, url string, statusCode, attempt int) *resty.Response {
+		return &resty.Response{
+			Request: &resty.Request{
+				Method:  method,
+				URL:     url,
+				Attempt: attempt,
+			},
+			RawResponse: &http.Response{StatusCode: statusCode},
+		}
+	}
+
+	poolsURL := "https://api.linode.com/v4/lke/clusters/123/pools"
+
+	testCases := []struct {
+		name     string
+		response *resty.Response
+		expected bool
+	}{
+		{"matching", newResponse(http.MethodGet, poolsURL, 503, 1), true},
+		{"unmatched status", newResponse(http.MethodGet, poolsURL, 500, 1), false},
+		{"unmatched path", newResponse(http.MethodGet, "https://api.linode.com/v4/volumes", 503, 1), false},
+		{"unmatched method", newResponse(http.MethodPost, poolsURL, 503, 1), false},
+		{"attempts exhausted", newResponse(http.MethodGet, poolsURL, 502, 3), false},
+		{"nil response", nil, false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if result := condition(testCase.response, nil); result != testCase.expected {
+				t.Fatalf("expected %v, got %v", testCase.expected, result)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyCondition_allPaths(t *testing.T) {
+	condition, err := helper.RetryPolicyCondition(helper.RetryPolicy{
+		StatusCodes: []int{429},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	response := &resty.Response{
+		Request:     &resty.Request{Method: http.MethodPut, URL: "https://api.linode.com/v4/vpcs/1/subnets/2"},
+		RawResponse: &http.Response{StatusCode: 429},
+	}
+
+	if !condition(response, nil) {
+		t.Fatal("expected request to be retried")
+	}
+}
+
+func TestRetryPolicyCondition_invalidPattern(t *testing.T) {
+	if _, err := helper.RetryPolicyCondition(helper.RetryPolicy{
+		StatusCodes:  []int{500},
+		PathPatterns: []string{"["},
+	}); err == nil {
+		t.Fatal("expected error for invalid path pattern")
+	}
+}
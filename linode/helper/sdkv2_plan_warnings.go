@@ -0,0 +1,349 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// SDKv2PlanWarningFunc returns the warnings for a planned in-place update of an SDKv2 resource.
+type SDKv2PlanWarningFunc func(ctx context.Context, d *SDKv2PlannedChange, meta *ProviderMeta) diag.Diagnostics
+
+// SDKv2PlannedChange provides access to the prior and planned state of
+// an SDKv2 resource being changed, similar to a schema.ResourceDiff.
+//
+// Unknown planned values are represented as null values, which can be
+// told apart using NewValueKnown. The prior state of a resource being
+// created or replaced is empty.
+type SDKv2PlannedChange struct {
+	Prior   *schema.ResourceData
+	Planned *schema.ResourceData
+
+	config       cty.Value
+	plannedValue cty.Value
+}
+
+// NewSDKv2PlannedChange returns the planned change between the given prior and
+// planned state. plannedValue is the planned state including its unknown values.
+func NewSDKv2PlannedChange(
+	prior, planned *schema.ResourceData,
+	config, plannedValue cty.Value,
+) *SDKv2PlannedChange {
+	return &SDKv2PlannedChange{
+		Prior:        prior,
+		Planned:      planned,
+		config:       config,
+		plannedValue: plannedValue,
+	}
+}
+
+func (d *SDKv2PlannedChange) Id() string {
+	return d.Prior.Id()
+}
+
+func (d *SDKv2PlannedChange) Get(key string) any {
+	return d.Planned.Get(key)
+}
+
+func (d *SDKv2PlannedChange) GetChange(key string) (any, any) {
+	return d.Prior.Get(key), d.Planned.Get(key)
+}
+
+func (d *SDKv2PlannedChange) HasChange(key string) bool {
+	oldValue, newValue := d.GetChange(key)
+
+	if oldSet, ok := oldValue.(*schema.Set); ok {
+		if newSet, ok := newValue.(*schema.Set); ok {
+			return !oldSet.Equal(newSet)
+		}
+	}
+
+	return !reflect.DeepEqual(oldValue, newValue)
+}
+
+func (d *SDKv2PlannedChange) GetRawConfig() cty.Value {
+	return d.config
+}
+
+// NewValueKnown returns whether the planned value of the given key,
+// e.g. "disk.0.size", is wholly known, similar to schema.ResourceDiff.
+func (d *SDKv2PlannedChange) NewValueKnown(key string) bool {
+	value := d.plannedValue
+
+	for _, step := range strings.Split(key, ".") {
+		if !value.IsKnown() {
+			return false
+		}
+
+		if value.IsNull() {
+			return true
+		}
+
+		valueType := value.Type()
+
+		switch {
+		case valueType.IsObjectType():
+			if !valueType.HasAttribute(step) {
+				return true
+			}
+
+			value = value.GetAttr(step)
+		case valueType.IsListType() || valueType.IsTupleType():
+			index, err := strconv.Atoi(step)
+			if err != nil || index < 0 || index >= value.LengthInt() {
+				return true
+			}
+
+			value = value.Index(cty.NumberIntVal(int64(index)))
+		case valueType.IsMapType():
+			if !value.HasIndex(cty.StringVal(step)).True() {
+				return true
+			}
+
+			value = value.Index(cty.StringVal(step))
+		default:
+			return value.IsWhollyKnown()
+		}
+	}
+
+	return value.IsWhollyKnown()
+}
+
+type sdkv2PlanWarningsKey struct{}
+
+// WithSDKv2PlanWarnings returns a context that collects the warnings
+// added by AddSDKv2PlanWarning into the returned diagnostics.
+func WithSDKv2PlanWarnings(ctx context.Context) (context.Context, *diag.Diagnostics) {
+	var warnings diag.Diagnostics
+	return context.WithValue(ctx, sdkv2PlanWarningsKey{}, &warnings), &warnings
+}
+
+// AddSDKv2PlanWarning adds a warning to the plan of the SDKv2 resource whose
+// CustomizeDiff is run with the given context, as a CustomizeDiff can only
+// return errors. The warning is only logged if the provider server isn't
+// wrapped by an SDKv2PlanWarningProviderServer.
+func AddSDKv2PlanWarning(ctx context.Context, summary, detail string) {
+	warnings, ok := ctx.Value(sdkv2PlanWarningsKey{}).(*diag.Diagnostics)
+	if !ok {
+		tflog.Warn(ctx, summary+": "+detail)
+		return
+	}
+
+	*warnings = append(*warnings, diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  summary,
+		Detail:   detail,
+	})
+}
+
+// SDKv2PlanWarningProviderServer wraps the provider server of an SDKv2 provider to add
+// the warnings of AddSDKv2PlanWarning and SDKv2PlanWarningFuncs to the plans of its
+// resources, as an SDKv2 CustomizeDiff can only return errors.
+type SDKv2PlanWarningProviderServer struct {
+	tfprotov5.ProviderServer
+
+	provider     *schema.Provider
+	warningFuncs map[string]SDKv2PlanWarningFunc
+}
+
+// NewSDKv2PlanWarningProviderServer returns a factory of the provider server of the given
+// SDKv2 provider with the given SDKv2PlanWarningFuncs keyed by resource type.
+func NewSDKv2PlanWarningProviderServer(
+	provider *schema.Provider,
+	warningFuncs map[string]SDKv2PlanWarningFunc,
+) func() tfprotov5.ProviderServer {
+	return func() tfprotov5.ProviderServer {
+		return &SDKv2PlanWarningProviderServer{
+			ProviderServer: provider.GRPCProvider(),
+			provider:       provider,
+			warningFuncs:   warningFuncs,
+		}
+	}
+}
+
+func (s *SDKv2PlanWarningProviderServer) PlanResourceChange(
+	ctx context.Context,
+	req *tfprotov5.PlanResourceChangeRequest,
+) (*tfprotov5.PlanResourceChangeResponse, error) {
+	planCtx, customizeDiffWarnings := WithSDKv2PlanWarnings(ctx)
+
+	resp, err := s.ProviderServer.PlanResourceChange(planCtx, req)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+
+	resp.Diagnostics = append(resp.Diagnostics, sdkv2DiagnosticsToProto(*customizeDiffWarnings)...)
+
+	for _, d := range resp.Diagnostics {
+		if d != nil && d.Severity == tfprotov5.DiagnosticSeverityError {
+			return resp, err
+		}
+	}
+
+	// Warnings are only checked for in-place updates
+	warningFunc, ok := s.warningFuncs[req.TypeName]
+	if !ok || len(resp.RequiresReplace) > 0 {
+		return resp, err
+	}
+
+	meta, ok := s.provider.Meta().(*ProviderMeta)
+	if !ok {
+		return resp, err
+	}
+
+	change, err := s.plannedChange(req.TypeName, req.Config, req.PriorState, resp.PlannedState)
+	if err != nil {
+		tflog.Warn(ctx, "Failed to decode planned change for plan warnings", map[string]any{
+			"error": err.Error(),
+		})
+		return resp, nil
+	}
+
+	// Nothing is checked for resources being created or destroyed
+	if change == nil {
+		return resp, nil
+	}
+
+	resp.Diagnostics = append(resp.Diagnostics, sdkv2DiagnosticsToProto(warningFunc(ctx, change, meta))...)
+
+	return resp, nil
+}
+
+// plannedChange returns the planned change of the given resource,
+// or nil if the resource is being created or destroyed.
+func (s *SDKv2PlanWarningProviderServer) plannedChange(
+	typeName string,
+	config, priorState, plannedState *tfprotov5.DynamicValue,
+) (*SDKv2PlannedChange, error) {
+	res, ok := s.provider.ResourcesMap[typeName]
+	if !ok {
+		return nil, fmt.Errorf("unknown resource type: %s", typeName)
+	}
+
+	schemaType := res.CoreConfigSchema().ImpliedType()
+
+	configValue, err := decodeDynamicValue(config, schemaType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode config: %w", err)
+	}
+
+	priorValue, err := decodeDynamicValue(priorState, schemaType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode prior state: %w", err)
+	}
+
+	plannedValue, err := decodeDynamicValue(plannedState, schemaType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode planned state: %w", err)
+	}
+
+	if configValue.IsNull() || priorValue.IsNull() || plannedValue.IsNull() {
+		return nil, nil
+	}
+
+	prior, err := sdkv2ResourceDataFromValue(res, priorValue)
+	if err != nil {
+		return nil, fmt.Errorf("failed to shim prior state: %w", err)
+	}
+
+	planned, err := sdkv2ResourceDataFromValue(res, plannedValue)
+	if err != nil {
+		return nil, fmt.Errorf("failed to shim planned state: %w", err)
+	}
+
+	return NewSDKv2PlannedChange(prior, planned, configValue, plannedValue), nil
+}
+
+func decodeDynamicValue(value *tfprotov5.DynamicValue, schemaType cty.Type) (cty.Value, error) {
+	switch {
+	case value == nil:
+		return cty.NullVal(schemaType), nil
+	case len(value.MsgPack) > 0:
+		return msgpack.Unmarshal(value.MsgPack, schemaType)
+	case len(value.JSON) > 0:
+		return ctyjson.Unmarshal(value.JSON, schemaType)
+	default:
+		return cty.NullVal(schemaType), nil
+	}
+}
+
+func sdkv2ResourceDataFromValue(res *schema.Resource, value cty.Value) (*schema.ResourceData, error) {
+	// Unknown values can't be read from a ResourceData
+	value, err := cty.Transform(value, func(_ cty.Path, v cty.Value) (cty.Value, error) {
+		if !v.IsKnown() {
+			return cty.NullVal(v.Type()), nil
+		}
+
+		return v, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := res.ShimInstanceStateFromValue(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Data(state), nil
+}
+
+func sdkv2DiagnosticsToProto(diags diag.Diagnostics) []*tfprotov5.Diagnostic {
+	result := make([]*tfprotov5.Diagnostic, len(diags))
+
+	for i, d := range diags {
+		severity := tfprotov5.DiagnosticSeverityWarning
+		if d.Severity == diag.Error {
+			severity = tfprotov5.DiagnosticSeverityError
+		}
+
+		result[i] = &tfprotov5.Diagnostic{
+			Severity:  severity,
+			Summary:   d.Summary,
+			Detail:    d.Detail,
+			Attribute: ctyPathToAttributePath(d.AttributePath),
+		}
+	}
+
+	return result
+}
+
+// ctyPathToAttributePath converts the attribute path of an SDKv2
+// diagnostic to the attribute path of a protocol diagnostic.
+func ctyPathToAttributePath(path cty.Path) *tftypes.AttributePath {
+	if len(path) < 1 {
+		return nil
+	}
+
+	result := tftypes.NewAttributePath()
+
+	for _, step := range path {
+		switch step := step.(type) {
+		case cty.GetAttrStep:
+			result = result.WithAttributeName(step.Name)
+		case cty.IndexStep:
+			switch step.Key.Type() {
+			case cty.String:
+				result = result.WithElementKeyString(step.Key.AsString())
+			case cty.Number:
+				index, _ := step.Key.AsBigFloat().Int64()
+				result = result.WithElementKeyInt(int(index))
+			default:
+				return result
+			}
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,287 @@
+//go:build unit
+
+package helper_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+func TestSDKv2PlanWarningProviderServer(t *testing.T) {
+	res := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"size": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"label": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+			if !d.NewValueKnown("size") {
+				helper.AddSDKv2PlanWarning(ctx, "Not Validated", fmt.Sprintf("%q: unknown size", d.Id()))
+			} else if size := d.Get("size").(int); size > 10 {
+				return cty.GetAttrPath("size").NewErrorf("%q: %d", d.Id(), size)
+			}
+
+			if d.HasChange("size") {
+				return d.SetNewComputed("status")
+			}
+
+			return nil
+		},
+	}
+
+	provider := &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"test_resource": res,
+		},
+	}
+	provider.SetMeta(&helper.ProviderMeta{Config: &helper.Config{}})
+
+	server := helper.NewSDKv2PlanWarningProviderServer(provider, map[string]helper.SDKv2PlanWarningFunc{
+		"test_resource": func(
+			ctx context.Context, d *helper.SDKv2PlannedChange, meta *helper.ProviderMeta,
+		) diag.Diagnostics {
+			if !d.HasChange("size") {
+				return nil
+			}
+
+			oldSize, newSize := d.GetChange("size")
+
+			if !d.NewValueKnown("size") {
+				newSize = "unknown"
+			}
+
+			return diag.Diagnostics{
+				{
+					Severity: diag.Warning,
+					Summary:  "Resized",
+					Detail: fmt.Sprintf(
+						"%s resized from %d to %v (%s, %q, %t)",
+						d.Id(), oldSize, newSize, d.Prior.Get("status"), d.Get("status"), d.NewValueKnown("status"),
+					),
+				},
+			}
+		},
+	})()
+
+	schemaType := res.CoreConfigSchema().ImpliedType()
+
+	dynamicValue := func(attrs map[string]cty.Value) *tfprotov5.DynamicValue {
+		value := cty.NullVal(schemaType)
+
+		if attrs != nil {
+			values := map[string]cty.Value{
+				"id":     cty.NullVal(cty.String),
+				"size":   cty.NullVal(cty.Number),
+				"label":  cty.NullVal(cty.String),
+				"status": cty.NullVal(cty.String),
+			}
+
+			for k, v := range attrs {
+				values[k] = v
+			}
+
+			value = cty.ObjectVal(values)
+		}
+
+		result, err := msgpack.Marshal(value, schemaType)
+		require.NoError(t, err)
+
+		return &tfprotov5.DynamicValue{MsgPack: result}
+	}
+
+	prior := map[string]cty.Value{
+		"id":     cty.StringVal("123"),
+		"size":   cty.NumberIntVal(1),
+		"label":  cty.StringVal("foo"),
+		"status": cty.StringVal("ready"),
+	}
+
+	testCases := map[string]struct {
+		prior            map[string]cty.Value
+		config           map[string]cty.Value
+		expectedWarnings []string
+		expectedErrors   []string
+	}{
+		"update": {
+			prior: prior,
+			config: map[string]cty.Value{
+				"size":  cty.NumberIntVal(2),
+				"label": cty.StringVal("foo"),
+			},
+			expectedWarnings: []string{`123 resized from 1 to 2 (ready, "", false)`},
+		},
+		"no change": {
+			prior: prior,
+			config: map[string]cty.Value{
+				"size":  cty.NumberIntVal(1),
+				"label": cty.StringVal("foo"),
+			},
+		},
+		"unknown update": {
+			prior: prior,
+			config: map[string]cty.Value{
+				"size":  cty.UnknownVal(cty.Number),
+				"label": cty.StringVal("foo"),
+			},
+			expectedWarnings: []string{
+				`"123": unknown size`,
+				`123 resized from 1 to unknown (ready, "", false)`,
+			},
+		},
+		"unknown replace": {
+			prior: prior,
+			config: map[string]cty.Value{
+				"size":  cty.NumberIntVal(2),
+				"label": cty.UnknownVal(cty.String),
+			},
+		},
+		"unknown create": {
+			config: map[string]cty.Value{
+				"size": cty.UnknownVal(cty.Number),
+			},
+			expectedWarnings: []string{`"": unknown size`},
+		},
+		"destroy": {
+			prior: map[string]cty.Value{
+				"id":     cty.StringVal("123"),
+				"size":   cty.NumberIntVal(11),
+				"label":  cty.StringVal("foo"),
+				"status": cty.StringVal("ready"),
+			},
+		},
+		"replace": {
+			prior: prior,
+			config: map[string]cty.Value{
+				"size":  cty.NumberIntVal(2),
+				"label": cty.StringVal("bar"),
+			},
+		},
+		"create": {
+			config: map[string]cty.Value{
+				"size": cty.NumberIntVal(2),
+			},
+		},
+		"invalid update": {
+			prior: prior,
+			config: map[string]cty.Value{
+				"size":  cty.NumberIntVal(11),
+				"label": cty.StringVal("foo"),
+			},
+			expectedErrors: []string{`"123": 11`},
+		},
+		"invalid replace": {
+			prior: prior,
+			config: map[string]cty.Value{
+				"size":  cty.NumberIntVal(11),
+				"label": cty.StringVal("bar"),
+			},
+			expectedErrors: []string{`"123": 11`},
+		},
+		"invalid create": {
+			config: map[string]cty.Value{
+				"size": cty.NumberIntVal(11),
+			},
+			expectedErrors: []string{`"": 11`},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var proposed map[string]cty.Value
+
+			// The proposed new state of a resource being destroyed is null
+			if tc.config != nil {
+				proposed = map[string]cty.Value{}
+				for k, v := range tc.prior {
+					proposed[k] = v
+				}
+				for k, v := range tc.config {
+					proposed[k] = v
+				}
+			}
+
+			resp, err := server.PlanResourceChange(context.Background(), &tfprotov5.PlanResourceChangeRequest{
+				TypeName:         "test_resource",
+				PriorState:       dynamicValue(tc.prior),
+				ProposedNewState: dynamicValue(proposed),
+				Config:           dynamicValue(tc.config),
+			})
+			require.NoError(t, err)
+
+			var warnings, errors []string
+
+			for _, d := range resp.Diagnostics {
+				if d.Severity == tfprotov5.DiagnosticSeverityError {
+					require.Equal(t, tftypes.NewAttributePath().WithAttributeName("size"), d.Attribute)
+					errors = append(errors, d.Summary)
+					continue
+				}
+
+				warnings = append(warnings, d.Detail)
+			}
+
+			require.Equal(t, tc.expectedWarnings, warnings)
+			require.Equal(t, tc.expectedErrors, errors)
+		})
+	}
+}
+
+func TestSDKv2PlannedChange_NewValueKnown(t *testing.T) {
+	plannedValue := cty.ObjectVal(map[string]cty.Value{
+		"label": cty.StringVal("foo"),
+		"size":  cty.UnknownVal(cty.Number),
+		"null":  cty.NullVal(cty.String),
+		"disk": cty.ListVal([]cty.Value{
+			cty.ObjectVal(map[string]cty.Value{
+				"id":   cty.NumberIntVal(1),
+				"size": cty.UnknownVal(cty.Number),
+			}),
+		}),
+		"tags": cty.MapVal(map[string]cty.Value{
+			"foo": cty.UnknownVal(cty.String),
+		}),
+		"interface": cty.UnknownVal(cty.List(cty.String)),
+	})
+
+	d := helper.NewSDKv2PlannedChange(nil, nil, cty.NilVal, plannedValue)
+
+	testCases := map[string]bool{
+		"label":         true,
+		"size":          false,
+		"null":          true,
+		"disk":          false,
+		"disk.0.id":     true,
+		"disk.0.size":   false,
+		"disk.1.size":   true,
+		"tags.foo":      false,
+		"tags.bar":      true,
+		"interface":     false,
+		"interface.0":   false,
+		"missing.0.foo": true,
+	}
+
+	for key, expected := range testCases {
+		require.Equal(t, expected, d.NewValueKnown(key), key)
+	}
+}
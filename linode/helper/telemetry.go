@@ -0,0 +1,329 @@
+package helper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/linode/linodego"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdoutmetric"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	EnvTelemetryExporter = "LINODE_OTEL_EXPORTER"
+	EnvTelemetryFile     = "LINODE_OTEL_FILE"
+
+	telemetryInstrumentationName = "github.com/linode/terraform-provider-linode/v2"
+	telemetryServiceName         = "terraform-provider-linode"
+)
+
+// TelemetryExporter is the destination of the provider's
+// OpenTelemetry traces and metrics.
+type TelemetryExporter string
+
+const (
+	// TelemetryExporterNone disables telemetry.
+	TelemetryExporterNone TelemetryExporter = ""
+
+	// TelemetryExporterFile writes traces and metrics as JSON to a file.
+	TelemetryExporterFile TelemetryExporter = "file"
+
+	// TelemetryExporterOTLP sends traces and metrics to an OTLP/HTTP endpoint
+	// configured using the standard OTEL_EXPORTER_OTLP_* environment variables.
+	TelemetryExporterOTLP TelemetryExporter = "otlp"
+)
+
+// Attributes recorded on provider spans and metrics.
+const (
+	TelemetryResourceTypeKey = attribute.Key("terraform.resource.type")
+	TelemetryResourceModeKey = attribute.Key("terraform.resource.mode")
+	TelemetryOperationKey    = attribute.Key("terraform.operation")
+	TelemetryEntityIDKey     = attribute.Key("linode.entity.id")
+	TelemetryEndpointKey     = attribute.Key("linode.api.endpoint")
+)
+
+var telemetryIDSegmentRegex = regexp.MustCompile(`/\d+(/|$)`)
+
+// telemetry holds the instruments used to record provider telemetry.
+type telemetry struct {
+	tracer trace.Tracer
+
+	apiRequests        metric.Int64Counter
+	apiRequestDuration metric.Float64Histogram
+	operationDuration  metric.Float64Histogram
+}
+
+var currentTelemetry atomic.Pointer[telemetry]
+
+type telemetryAttemptKey struct{}
+
+// InitTelemetry configures the OpenTelemetry exporter selected by the
+// LINODE_OTEL_EXPORTER environment variable and enables telemetry for
+// the provider. The returned function flushes and stops the exporter.
+func InitTelemetry(ctx context.Context, providerVersion string) (func(context.Context) error, error) {
+	exporter := TelemetryExporter(strings.ToLower(strings.TrimSpace(os.Getenv(EnvTelemetryExporter))))
+
+	var spanProcessor sdktrace.SpanProcessor
+	var metricExporter sdkmetric.Exporter
+	var closer io.Closer
+
+	switch exporter {
+	case TelemetryExporterNone:
+		return func(context.Context) error { return nil }, nil
+	case TelemetryExporterFile:
+		path := os.Getenv(EnvTelemetryFile)
+		if path == "" {
+			return nil, fmt.Errorf("%s must be set when %s is %q", EnvTelemetryFile, EnvTelemetryExporter, exporter)
+		}
+
+		file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open telemetry file: %w", err)
+		}
+
+		spanExporter, err := stdouttrace.New(stdouttrace.WithWriter(file))
+		if err != nil {
+			return nil, errors.Join(err, file.Close())
+		}
+
+		metricExporter, err = stdoutmetric.New(stdoutmetric.WithWriter(file))
+		if err != nil {
+			return nil, errors.Join(err, file.Close())
+		}
+
+		// Spans are written as they end since the provider process
+		// may be killed before a batch is flushed.
+		spanProcessor = sdktrace.NewSimpleSpanProcessor(spanExporter)
+		closer = file
+	case TelemetryExporterOTLP:
+		spanExporter, err := otlptracehttp.New(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create OTLP trace exporter: %w", err)
+		}
+
+		metricExporter, err = otlpmetrichttp.New(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create OTLP metric exporter: %w", err)
+		}
+
+		spanProcessor = sdktrace.NewBatchSpanProcessor(spanExporter)
+	default:
+		return nil, fmt.Errorf(
+			"invalid %s %q, expected one of %q or %q",
+			EnvTelemetryExporter, exporter, TelemetryExporterFile, TelemetryExporterOTLP,
+		)
+	}
+
+	res, err := resource.New(
+		ctx,
+		resource.WithTelemetrySDK(),
+		resource.WithAttributes(
+			semconv.ServiceName(telemetryServiceName),
+			semconv.ServiceVersion(providerVersion),
+		),
+		resource.WithFromEnv(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create telemetry resource: %w", err)
+	}
+
+	tracerProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithSpanProcessor(spanProcessor),
+		sdktrace.WithResource(res),
+	)
+
+	meterProvider := sdkmetric.NewMeterProvider(
+		sdkmetric.WithReader(sdkmetric.NewPeriodicReader(metricExporter)),
+		sdkmetric.WithResource(res),
+	)
+
+	otel.SetTracerProvider(tracerProvider)
+	otel.SetMeterProvider(meterProvider)
+
+	if err := EnableTelemetry(tracerProvider, meterProvider); err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context) error {
+		DisableTelemetry()
+
+		err := errors.Join(tracerProvider.Shutdown(ctx), meterProvider.Shutdown(ctx))
+		if closer != nil {
+			err = errors.Join(err, closer.Close())
+		}
+
+		return err
+	}, nil
+}
+
+// EnableTelemetry enables provider telemetry using the given providers.
+// Clients and provider servers created afterwards are instrumented.
+func EnableTelemetry(tracerProvider trace.TracerProvider, meterProvider metric.MeterProvider) error {
+	meter := meterProvider.Meter(telemetryInstrumentationName)
+
+	apiRequests, err := meter.Int64Counter(
+		"linode.api.requests",
+		metric.WithDescription("The number of Linode API requests."),
+		metric.WithUnit("{request}"),
+	)
+	if err != nil {
+		return err
+	}
+
+	apiRequestDuration, err := meter.Float64Histogram(
+		"linode.api.request.duration",
+		metric.WithDescription("The duration of Linode API requests."),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return err
+	}
+
+	operationDuration, err := meter.Float64Histogram(
+		"terraform.operation.duration",
+		metric.WithDescription("The duration of Terraform resource and data source operations."),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return err
+	}
+
+	currentTelemetry.Store(&telemetry{
+		tracer:             tracerProvider.Tracer(telemetryInstrumentationName),
+		apiRequests:        apiRequests,
+		apiRequestDuration: apiRequestDuration,
+		operationDuration:  operationDuration,
+	})
+
+	return nil
+}
+
+// DisableTelemetry disables provider telemetry.
+func DisableTelemetry() {
+	currentTelemetry.Store(nil)
+}
+
+// TelemetryEnabled returns whether provider telemetry is enabled.
+func TelemetryEnabled() bool {
+	return currentTelemetry.Load() != nil
+}
+
+// ApplyTelemetryHooks records the attempt number of each request
+// made by the given client so retries can be traced.
+func ApplyTelemetryHooks(client *linodego.Client) {
+	if !TelemetryEnabled() {
+		return
+	}
+
+	client.OnBeforeRequest(func(request *linodego.Request) error {
+		request.SetContext(context.WithValue(request.Context(), telemetryAttemptKey{}, request.Attempt))
+		return nil
+	})
+}
+
+// TelemetryTransport records a span and metrics for each API request.
+type TelemetryTransport struct {
+	transport http.RoundTripper
+	telemetry *telemetry
+}
+
+// WrapTelemetryTransport wraps the given transport in a TelemetryTransport
+// if telemetry is enabled, otherwise the transport is returned as-is.
+func WrapTelemetryTransport(transport http.RoundTripper) http.RoundTripper {
+	t := currentTelemetry.Load()
+	if t == nil {
+		return transport
+	}
+
+	return &TelemetryTransport{
+		transport: transport,
+		telemetry: t,
+	}
+}
+
+func (t *TelemetryTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	endpoint := TelemetryEndpoint(r.URL.Path)
+
+	metricAttrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String(r.Method),
+		TelemetryEndpointKey.String(endpoint),
+	}
+
+	ctx, span := t.telemetry.tracer.Start(
+		r.Context(),
+		r.Method+" "+endpoint,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(metricAttrs...),
+		trace.WithAttributes(
+			semconv.URLFull(r.URL.Redacted()),
+			semconv.HTTPRequestResendCount(requestRetryCount(r.Context())),
+		),
+	)
+	defer span.End()
+
+	start := time.Now()
+
+	resp, err := t.transport.RoundTrip(r.WithContext(ctx))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	} else {
+		statusAttr := semconv.HTTPResponseStatusCode(resp.StatusCode)
+		metricAttrs = append(metricAttrs, statusAttr)
+		span.SetAttributes(statusAttr)
+
+		if resp.StatusCode >= http.StatusBadRequest {
+			span.SetStatus(codes.Error, http.StatusText(resp.StatusCode))
+		}
+	}
+
+	t.telemetry.apiRequests.Add(ctx, 1, metric.WithAttributes(metricAttrs...))
+	t.telemetry.apiRequestDuration.Record(ctx, time.Since(start).Seconds(), metric.WithAttributes(metricAttrs...))
+
+	return resp, err
+}
+
+// TelemetryEndpoint returns the given API path with numeric IDs
+// replaced by a placeholder to keep the cardinality of the
+// endpoint attribute low.
+func TelemetryEndpoint(path string) string {
+	// Consecutive IDs share a separator, so the replacement is repeated
+	for {
+		result := telemetryIDSegmentRegex.ReplaceAllString(path, "/{id}$1")
+		if result == path {
+			return result
+		}
+
+		path = result
+	}
+}
+
+// requestRetryCount returns the number of times the request
+// in the given context has been retried.
+func requestRetryCount(ctx context.Context) int {
+	attempt, ok := ctx.Value(telemetryAttemptKey{}).(int)
+	if !ok || attempt < 1 {
+		return 0
+	}
+
+	return attempt - 1
+}
@@ -0,0 +1,410 @@
+package helper
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	telemetryModeManaged   = "managed"
+	telemetryModeData      = "data"
+	telemetryModeEphemeral = "ephemeral"
+)
+
+var _ tfprotov5.ProviderServerWithEphemeralResources = &TelemetryProviderServer{}
+
+// TelemetryProviderServer wraps a provider server to record a span
+// and metrics for each resource and data source operation.
+//
+// Operations are traced at the protocol level so resources implemented
+// using both SDKv2 and the plugin framework are covered, and the spans
+// of API requests made during an operation are nested under it.
+type TelemetryProviderServer struct {
+	tfprotov5.ProviderServer
+
+	telemetry *telemetry
+
+	schemaOnce      sync.Once
+	resourceTypes   map[string]tftypes.Type
+	dataSourceTypes map[string]tftypes.Type
+}
+
+// NewTelemetryProviderServer wraps the given provider server factory
+// in a TelemetryProviderServer if telemetry is enabled, otherwise the
+// factory is returned as-is.
+func NewTelemetryProviderServer(server func() tfprotov5.ProviderServer) func() tfprotov5.ProviderServer {
+	t := currentTelemetry.Load()
+	if t == nil {
+		return server
+	}
+
+	return func() tfprotov5.ProviderServer {
+		return &TelemetryProviderServer{
+			ProviderServer: server(),
+			telemetry:      t,
+		}
+	}
+}
+
+func (s *TelemetryProviderServer) PlanResourceChange(
+	ctx context.Context,
+	req *tfprotov5.PlanResourceChangeRequest,
+) (*tfprotov5.PlanResourceChangeResponse, error) {
+	ctx, finish := s.startOperation(ctx, req.TypeName, telemetryModeManaged, "plan")
+
+	resp, err := s.ProviderServer.PlanResourceChange(ctx, req)
+
+	var diags []*tfprotov5.Diagnostic
+	if resp != nil {
+		diags = resp.Diagnostics
+	}
+
+	finish(s.entityID(ctx, req.TypeName, false, req.PriorState), diags, err)
+
+	return resp, err
+}
+
+func (s *TelemetryProviderServer) ApplyResourceChange(
+	ctx context.Context,
+	req *tfprotov5.ApplyResourceChangeRequest,
+) (*tfprotov5.ApplyResourceChangeResponse, error) {
+	operation := "update"
+
+	switch {
+	case s.isNull(ctx, req.TypeName, req.PriorState):
+		operation = "create"
+	case s.isNull(ctx, req.TypeName, req.PlannedState):
+		operation = "delete"
+	}
+
+	ctx, finish := s.startOperation(ctx, req.TypeName, telemetryModeManaged, operation)
+
+	resp, err := s.ProviderServer.ApplyResourceChange(ctx, req)
+
+	var newState *tfprotov5.DynamicValue
+	var diags []*tfprotov5.Diagnostic
+	if resp != nil {
+		newState, diags = resp.NewState, resp.Diagnostics
+	}
+
+	finish(s.entityID(ctx, req.TypeName, false, newState, req.PriorState), diags, err)
+
+	return resp, err
+}
+
+func (s *TelemetryProviderServer) ReadResource(
+	ctx context.Context,
+	req *tfprotov5.ReadResourceRequest,
+) (*tfprotov5.ReadResourceResponse, error) {
+	ctx, finish := s.startOperation(ctx, req.TypeName, telemetryModeManaged, "read")
+
+	resp, err := s.ProviderServer.ReadResource(ctx, req)
+
+	var diags []*tfprotov5.Diagnostic
+	if resp != nil {
+		diags = resp.Diagnostics
+	}
+
+	finish(s.entityID(ctx, req.TypeName, false, req.CurrentState), diags, err)
+
+	return resp, err
+}
+
+func (s *TelemetryProviderServer) ImportResourceState(
+	ctx context.Context,
+	req *tfprotov5.ImportResourceStateRequest,
+) (*tfprotov5.ImportResourceStateResponse, error) {
+	ctx, finish := s.startOperation(ctx, req.TypeName, telemetryModeManaged, "import")
+
+	resp, err := s.ProviderServer.ImportResourceState(ctx, req)
+
+	var diags []*tfprotov5.Diagnostic
+	if resp != nil {
+		diags = resp.Diagnostics
+	}
+
+	finish(req.ID, diags, err)
+
+	return resp, err
+}
+
+func (s *TelemetryProviderServer) ReadDataSource(
+	ctx context.Context,
+	req *tfprotov5.ReadDataSourceRequest,
+) (*tfprotov5.ReadDataSourceResponse, error) {
+	ctx, finish := s.startOperation(ctx, req.TypeName, telemetryModeData, "read")
+
+	resp, err := s.ProviderServer.ReadDataSource(ctx, req)
+
+	var state *tfprotov5.DynamicValue
+	var diags []*tfprotov5.Diagnostic
+	if resp != nil {
+		state, diags = resp.State, resp.Diagnostics
+	}
+
+	finish(s.entityID(ctx, req.TypeName, true, state), diags, err)
+
+	return resp, err
+}
+
+func (s *TelemetryProviderServer) ValidateEphemeralResourceConfig(
+	ctx context.Context,
+	req *tfprotov5.ValidateEphemeralResourceConfigRequest,
+) (*tfprotov5.ValidateEphemeralResourceConfigResponse, error) {
+	server, ok := s.ProviderServer.(tfprotov5.EphemeralResourceServer)
+	if !ok {
+		return &tfprotov5.ValidateEphemeralResourceConfigResponse{
+			Diagnostics: ephemeralResourcesNotImplemented("ValidateEphemeralResourceConfig"),
+		}, nil
+	}
+
+	return server.ValidateEphemeralResourceConfig(ctx, req)
+}
+
+func (s *TelemetryProviderServer) OpenEphemeralResource(
+	ctx context.Context,
+	req *tfprotov5.OpenEphemeralResourceRequest,
+) (*tfprotov5.OpenEphemeralResourceResponse, error) {
+	server, ok := s.ProviderServer.(tfprotov5.EphemeralResourceServer)
+	if !ok {
+		return &tfprotov5.OpenEphemeralResourceResponse{
+			Diagnostics: ephemeralResourcesNotImplemented("OpenEphemeralResource"),
+		}, nil
+	}
+
+	ctx, finish := s.startOperation(ctx, req.TypeName, telemetryModeEphemeral, "open")
+
+	resp, err := server.OpenEphemeralResource(ctx, req)
+
+	var diags []*tfprotov5.Diagnostic
+	if resp != nil {
+		diags = resp.Diagnostics
+	}
+
+	finish("", diags, err)
+
+	return resp, err
+}
+
+func (s *TelemetryProviderServer) RenewEphemeralResource(
+	ctx context.Context,
+	req *tfprotov5.RenewEphemeralResourceRequest,
+) (*tfprotov5.RenewEphemeralResourceResponse, error) {
+	server, ok := s.ProviderServer.(tfprotov5.EphemeralResourceServer)
+	if !ok {
+		return &tfprotov5.RenewEphemeralResourceResponse{
+			Diagnostics: ephemeralResourcesNotImplemented("RenewEphemeralResource"),
+		}, nil
+	}
+
+	ctx, finish := s.startOperation(ctx, req.TypeName, telemetryModeEphemeral, "renew")
+
+	resp, err := server.RenewEphemeralResource(ctx, req)
+
+	var diags []*tfprotov5.Diagnostic
+	if resp != nil {
+		diags = resp.Diagnostics
+	}
+
+	finish("", diags, err)
+
+	return resp, err
+}
+
+func (s *TelemetryProviderServer) CloseEphemeralResource(
+	ctx context.Context,
+	req *tfprotov5.CloseEphemeralResourceRequest,
+) (*tfprotov5.CloseEphemeralResourceResponse, error) {
+	server, ok := s.ProviderServer.(tfprotov5.EphemeralResourceServer)
+	if !ok {
+		return &tfprotov5.CloseEphemeralResourceResponse{
+			Diagnostics: ephemeralResourcesNotImplemented("CloseEphemeralResource"),
+		}, nil
+	}
+
+	ctx, finish := s.startOperation(ctx, req.TypeName, telemetryModeEphemeral, "close")
+
+	resp, err := server.CloseEphemeralResource(ctx, req)
+
+	var diags []*tfprotov5.Diagnostic
+	if resp != nil {
+		diags = resp.Diagnostics
+	}
+
+	finish("", diags, err)
+
+	return resp, err
+}
+
+func ephemeralResourcesNotImplemented(rpc string) []*tfprotov5.Diagnostic {
+	return []*tfprotov5.Diagnostic{
+		{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  rpc + " Not Implemented",
+			Detail:   "The provider server does not support ephemeral resources.",
+		},
+	}
+}
+
+// startOperation starts the span of an operation and returns a
+// function that ends it and records the operation's metrics.
+func (s *TelemetryProviderServer) startOperation(
+	ctx context.Context,
+	typeName, mode, operation string,
+) (context.Context, func(entityID string, diags []*tfprotov5.Diagnostic, err error)) {
+	attrs := []attribute.KeyValue{
+		TelemetryResourceTypeKey.String(typeName),
+		TelemetryResourceModeKey.String(mode),
+		TelemetryOperationKey.String(operation),
+	}
+
+	ctx, span := s.telemetry.tracer.Start(
+		ctx,
+		typeName+" "+operation,
+		trace.WithAttributes(attrs...),
+	)
+
+	start := time.Now()
+
+	return ctx, func(entityID string, diags []*tfprotov5.Diagnostic, err error) {
+		defer span.End()
+
+		if entityID != "" {
+			span.SetAttributes(TelemetryEntityIDKey.String(entityID))
+		}
+
+		failed := err != nil
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+
+		for _, diag := range diags {
+			if diag == nil || diag.Severity != tfprotov5.DiagnosticSeverityError {
+				continue
+			}
+
+			if !failed {
+				span.SetStatus(codes.Error, diag.Summary)
+			}
+
+			failed = true
+		}
+
+		attrs = append(attrs, attribute.Bool("error", failed))
+
+		s.telemetry.operationDuration.Record(ctx, time.Since(start).Seconds(), metric.WithAttributes(attrs...))
+	}
+}
+
+// stateType returns the type of the state of the given
+// resource or data source, or nil if it is not known.
+func (s *TelemetryProviderServer) stateType(ctx context.Context, typeName string, dataSource bool) tftypes.Type {
+	s.schemaOnce.Do(func() {
+		s.resourceTypes = make(map[string]tftypes.Type)
+		s.dataSourceTypes = make(map[string]tftypes.Type)
+
+		resp, err := s.ProviderServer.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+		if err != nil || resp == nil {
+			tflog.Warn(ctx, "Failed to get provider schema for telemetry", map[string]any{
+				"error": err,
+			})
+			return
+		}
+
+		for name, schema := range resp.ResourceSchemas {
+			s.resourceTypes[name] = schema.ValueType()
+		}
+
+		for name, schema := range resp.DataSourceSchemas {
+			s.dataSourceTypes[name] = schema.ValueType()
+		}
+	})
+
+	if dataSource {
+		return s.dataSourceTypes[typeName]
+	}
+
+	return s.resourceTypes[typeName]
+}
+
+// isNull returns whether the given state of a resource is null,
+// e.g. the prior state of a resource being created.
+func (s *TelemetryProviderServer) isNull(ctx context.Context, typeName string, state *tfprotov5.DynamicValue) bool {
+	if state == nil {
+		return true
+	}
+
+	stateType := s.stateType(ctx, typeName, false)
+	if stateType == nil {
+		return false
+	}
+
+	value, err := state.Unmarshal(stateType)
+	if err != nil {
+		return false
+	}
+
+	return value.IsNull()
+}
+
+// entityID returns the value of the id attribute
+// of the first of the given states that has one.
+func (s *TelemetryProviderServer) entityID(
+	ctx context.Context,
+	typeName string,
+	dataSource bool,
+	states ...*tfprotov5.DynamicValue,
+) string {
+	stateType := s.stateType(ctx, typeName, dataSource)
+	if stateType == nil {
+		return ""
+	}
+
+	for _, state := range states {
+		if state == nil {
+			continue
+		}
+
+		value, err := state.Unmarshal(stateType)
+		if err != nil || !value.IsKnown() || value.IsNull() {
+			continue
+		}
+
+		var attrs map[string]tftypes.Value
+		if err := value.As(&attrs); err != nil {
+			continue
+		}
+
+		id, ok := attrs["id"]
+		if !ok || !id.IsKnown() || id.IsNull() {
+			continue
+		}
+
+		switch {
+		case id.Type().Is(tftypes.String):
+			var result string
+			if err := id.As(&result); err == nil && result != "" {
+				return result
+			}
+		case id.Type().Is(tftypes.Number):
+			var result big.Float
+			if err := id.As(&result); err == nil {
+				return result.Text('f', -1)
+			}
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,244 @@
+//go:build unit
+
+package helper_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func enableTestTelemetry(t *testing.T) (*tracetest.SpanRecorder, *sdkmetric.ManualReader) {
+	t.Helper()
+
+	spanRecorder := tracetest.NewSpanRecorder()
+	metricReader := sdkmetric.NewManualReader()
+
+	err := helper.EnableTelemetry(
+		sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spanRecorder)),
+		sdkmetric.NewMeterProvider(sdkmetric.WithReader(metricReader)),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(helper.DisableTelemetry)
+
+	return spanRecorder, metricReader
+}
+
+func spanAttribute(span sdktrace.ReadOnlySpan, key attribute.Key) (attribute.Value, bool) {
+	for _, attr := range span.Attributes() {
+		if attr.Key == key {
+			return attr.Value, true
+		}
+	}
+
+	return attribute.Value{}, false
+}
+
+func TestTelemetryEndpoint(t *testing.T) {
+	testCases := map[string]string{
+		"/v4/linode/instances":                  "/v4/linode/instances",
+		"/v4/linode/instances/123":              "/v4/linode/instances/{id}",
+		"/v4/linode/instances/123/disks/456":    "/v4/linode/instances/{id}/disks/{id}",
+		"/v4/lke/clusters/1/pools/2/recycle":    "/v4/lke/clusters/{id}/pools/{id}/recycle",
+		"/v4/networking/ipv6/ranges/2001:db8::": "/v4/networking/ipv6/ranges/2001:db8::",
+		"/v4/linode/types/g6-standard-1":        "/v4/linode/types/g6-standard-1",
+	}
+
+	for path, expected := range testCases {
+		if result := helper.TelemetryEndpoint(path); result != expected {
+			t.Errorf("expected %q for %q, got %q", expected, path, result)
+		}
+	}
+}
+
+func TestTelemetryTransport(t *testing.T) {
+	spanRecorder, metricReader := enableTestTelemetry(t)
+
+	var requests atomic.Int64
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadGateway)
+			w.Write([]byte(`{"errors": [{"reason": "Bad Gateway"}]}`))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": 123}`))
+	}))
+	defer server.Close()
+
+	config := &helper.Config{
+		AccessToken:               "secret",
+		APIURL:                    server.URL,
+		ConfigPath:                filepath.Join(t.TempDir(), "linode"),
+		MinRetryDelayMilliseconds: 1,
+		MaxRetryDelayMilliseconds: 10,
+		RetryPolicies: []helper.RetryPolicy{
+			{StatusCodes: []int{http.StatusBadGateway}},
+		},
+	}
+
+	client, err := config.Client(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.GetInstance(context.Background(), 123); err != nil {
+		t.Fatal(err)
+	}
+
+	spans := spanRecorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("expected 2 spans, got %d", len(spans))
+	}
+
+	for i, span := range spans {
+		if span.Name() != "GET /v4/linode/instances/{id}" {
+			t.Errorf("unexpected span name %q", span.Name())
+		}
+
+		if value, _ := spanAttribute(span, "http.request.resend_count"); value.AsInt64() != int64(i) {
+			t.Errorf("expected resend count %d, got %d", i, value.AsInt64())
+		}
+	}
+
+	if value, _ := spanAttribute(spans[0], "http.response.status_code"); value.AsInt64() != http.StatusBadGateway {
+		t.Errorf("expected status code %d, got %d", http.StatusBadGateway, value.AsInt64())
+	}
+
+	if spans[0].Status().Code != codes.Error || spans[1].Status().Code == codes.Error {
+		t.Errorf("unexpected span statuses %v, %v", spans[0].Status(), spans[1].Status())
+	}
+
+	var metrics metricdata.ResourceMetrics
+	if err := metricReader.Collect(context.Background(), &metrics); err != nil {
+		t.Fatal(err)
+	}
+
+	var total int64
+
+	for _, scopeMetrics := range metrics.ScopeMetrics {
+		for _, m := range scopeMetrics.Metrics {
+			if m.Name != "linode.api.requests" {
+				continue
+			}
+
+			for _, point := range m.Data.(metricdata.Sum[int64]).DataPoints {
+				total += point.Value
+			}
+		}
+	}
+
+	if total != 2 {
+		t.Errorf("expected 2 recorded requests, got %d", total)
+	}
+}
+
+type testTelemetryProviderServer struct {
+	tfprotov5.ProviderServer
+
+	stateType tftypes.Type
+}
+
+func (s *testTelemetryProviderServer) GetProviderSchema(
+	ctx context.Context,
+	req *tfprotov5.GetProviderSchemaRequest,
+) (*tfprotov5.GetProviderSchemaResponse, error) {
+	return &tfprotov5.GetProviderSchemaResponse{
+		ResourceSchemas: map[string]*tfprotov5.Schema{
+			"linode_test": {
+				Block: &tfprotov5.SchemaBlock{
+					Attributes: []*tfprotov5.SchemaAttribute{
+						{Name: "id", Type: tftypes.String, Computed: true},
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+func (s *testTelemetryProviderServer) ApplyResourceChange(
+	ctx context.Context,
+	req *tfprotov5.ApplyResourceChangeRequest,
+) (*tfprotov5.ApplyResourceChangeResponse, error) {
+	newState, err := tfprotov5.NewDynamicValue(s.stateType, tftypes.NewValue(s.stateType, map[string]tftypes.Value{
+		"id": tftypes.NewValue(tftypes.String, "123"),
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	return &tfprotov5.ApplyResourceChangeResponse{
+		NewState: &newState,
+		Diagnostics: []*tfprotov5.Diagnostic{
+			{Severity: tfprotov5.DiagnosticSeverityError, Summary: "Failed to boot instance"},
+		},
+	}, nil
+}
+
+func TestTelemetryProviderServer(t *testing.T) {
+	spanRecorder, _ := enableTestTelemetry(t)
+
+	stateType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"id": tftypes.String}}
+
+	server := helper.NewTelemetryProviderServer(func() tfprotov5.ProviderServer {
+		return &testTelemetryProviderServer{stateType: stateType}
+	})()
+
+	priorState, err := tfprotov5.NewDynamicValue(stateType, tftypes.NewValue(stateType, nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	plannedState, err := tfprotov5.NewDynamicValue(stateType, tftypes.NewValue(stateType, map[string]tftypes.Value{
+		"id": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := server.ApplyResourceChange(context.Background(), &tfprotov5.ApplyResourceChangeRequest{
+		TypeName:     "linode_test",
+		PriorState:   &priorState,
+		PlannedState: &plannedState,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	spans := spanRecorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("expected 1 span, got %d", len(spans))
+	}
+
+	span := spans[0]
+
+	if span.Name() != "linode_test create" {
+		t.Errorf("unexpected span name %q", span.Name())
+	}
+
+	if value, _ := spanAttribute(span, helper.TelemetryEntityIDKey); value.AsString() != "123" {
+		t.Errorf("expected entity ID 123, got %q", value.AsString())
+	}
+
+	if span.Status().Code != codes.Error || span.Status().Description != "Failed to boot instance" {
+		t.Errorf("unexpected span status %v", span.Status())
+	}
+}
@@ -106,7 +106,12 @@ func createResourceFromUpload(
 }
 
 func createResourceFromLinode(
-	ctx context.Context, plan *ResourceModel, client *linodego.Client, resp *resource.CreateResponse, timeoutSeconds int,
+	ctx context.Context,
+	plan *ResourceModel,
+	client *linodego.Client,
+	eventWatcher *helper.EventWatcher,
+	resp *resource.CreateResponse,
+	timeoutSeconds int,
 ) *linodego.Image {
 	tflog.Debug(ctx, "Create linode_image from a Linode instance")
 
@@ -141,6 +146,12 @@ func createResourceFromLinode(
 		return nil
 	}
 
+	p, err := eventWatcher.NewEventWaiter(ctx, linodeID, linodego.EntityLinode, linodego.ActionDiskImagize)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Initialize Event Waiter", err.Error())
+		return nil
+	}
+
 	tflog.Trace(ctx, "client.CreateImage(...)", map[string]any{
 		"options": createOpts,
 	})
@@ -157,17 +168,11 @@ func createResourceFromLinode(
 	ctx = populateLogAttributes(ctx, image.ID)
 	tflog.Debug(ctx, "Waiting for a single image to be ready")
 
-	tflog.Trace(ctx, "client.WaitForInstanceDiskStatus(...)", map[string]any{
-		"status": "ready",
-	})
-
-	if _, err := client.WaitForInstanceDiskStatus(
-		ctx, linodeID, diskID, linodego.DiskReady, timeoutSeconds,
-	); err != nil {
+	if _, err := p.WaitForFinished(ctx, timeoutSeconds); err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf(
-				"Failed to wait for linode instance %d disk %d to become ready while taking an image",
-				linodeID, diskID,
+				"Failed to wait for image %s to be created from linode instance %d disk %d",
+				image.ID, linodeID, diskID,
 			),
 			err.Error(),
 		)
@@ -208,7 +213,7 @@ func (r *Resource) Create(
 
 	var image *linodego.Image
 	if !plan.LinodeID.IsNull() && plan.FilePath.IsNull() {
-		image = createResourceFromLinode(ctx, &plan, client, resp, timeoutSeconds)
+		image = createResourceFromLinode(ctx, &plan, client, r.Meta.EventWatcher, resp, timeoutSeconds)
 	} else {
 		image = createResourceFromUpload(ctx, &plan, client, resp, timeoutSeconds)
 	}
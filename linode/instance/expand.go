@@ -42,3 +42,23 @@ func expandInstanceConfigDevice(m map[string]interface{}) *linodego.InstanceConf
 	}
 	return dev
 }
+
+// expandInstanceBackupSchedule converts a terraform linode_instance backups.*.schedule list
+// to the backups of an InstanceUpdateOptions, or nil if no schedule is set.
+func expandInstanceBackupSchedule(schedule []interface{}) *linodego.InstanceBackup {
+	if len(schedule) < 1 || schedule[0] == nil {
+		return nil
+	}
+
+	m := schedule[0].(map[string]interface{})
+
+	backups := &linodego.InstanceBackup{}
+	backups.Schedule.Day, _ = m["day"].(string)
+	backups.Schedule.Window, _ = m["window"].(string)
+
+	if backups.Schedule.Day == "" && backups.Schedule.Window == "" {
+		return nil
+	}
+
+	return backups
+}
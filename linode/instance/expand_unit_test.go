@@ -3,6 +3,7 @@
 package instance
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/linode/linodego"
@@ -108,3 +109,50 @@ func TestExpandInstanceConfigDevice(t *testing.T) {
 		})
 	}
 }
+
+func TestExpandInstanceBackupSchedule(t *testing.T) {
+	testCases := map[string]struct {
+		schedule []interface{}
+		expected *linodego.InstanceBackup
+	}{
+		"empty": {
+			schedule: []interface{}{},
+			expected: nil,
+		},
+		"unset": {
+			schedule: []interface{}{
+				map[string]interface{}{"day": "", "window": ""},
+			},
+			expected: nil,
+		},
+		"day and window": {
+			schedule: []interface{}{
+				map[string]interface{}{"day": "Saturday", "window": "W10"},
+			},
+			expected: func() *linodego.InstanceBackup {
+				backups := &linodego.InstanceBackup{}
+				backups.Schedule.Day = "Saturday"
+				backups.Schedule.Window = "W10"
+				return backups
+			}(),
+		},
+		"window only": {
+			schedule: []interface{}{
+				map[string]interface{}{"day": "", "window": "W22"},
+			},
+			expected: func() *linodego.InstanceBackup {
+				backups := &linodego.InstanceBackup{}
+				backups.Schedule.Window = "W22"
+				return backups
+			}(),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if result := expandInstanceBackupSchedule(testCase.schedule); !reflect.DeepEqual(result, testCase.expected) {
+				t.Errorf("Expected %v, but got %v", testCase.expected, result)
+			}
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -414,6 +415,7 @@ func makeVolumeDetacherIgnoreAttached(client linodego.Client, d *schema.Resource
 func createInstanceDisk(
 	ctx context.Context,
 	client linodego.Client,
+	eventWatcher *helper.EventWatcher,
 	instance linodego.Instance,
 	disk diskSpec,
 	d *schema.ResourceData,
@@ -479,9 +481,9 @@ func createInstanceDisk(
 		"options": diskOpts,
 	})
 
-	p, err := client.NewEventPoller(ctx, instance.ID, linodego.EntityLinode, linodego.ActionDiskCreate)
+	p, err := eventWatcher.NewEventWaiter(ctx, instance.ID, linodego.EntityLinode, linodego.ActionDiskCreate)
 	if err != nil {
-		return nil, fmt.Errorf("failed to initialize event poller: %s", err)
+		return nil, fmt.Errorf("failed to initialize event waiter: %s", err)
 	}
 
 	tflog.Debug(ctx, "client.CreateInstanceDisk(...)", map[string]any{
@@ -600,7 +602,11 @@ func getInstanceDiskSpecDiffs(
 // This function will also warn when there are disks attached to an instance which are not managed by
 // terraform.
 func updateInstanceDisks(
-	ctx context.Context, client linodego.Client, d *schema.ResourceData, instance linodego.Instance,
+	ctx context.Context,
+	client linodego.Client,
+	eventWatcher *helper.EventWatcher,
+	d *schema.ResourceData,
+	instance linodego.Instance,
 ) (bool, error) {
 	oldDisk, newDisk := getInstanceDiskSpecChange(d)
 	added, removed, existing := getInstanceDiskSpecDiffs(oldDisk, newDisk)
@@ -632,9 +638,9 @@ func updateInstanceDisks(
 
 		tflog.Info(ctx, "Deleting unused disk")
 
-		p, err := client.NewEventPoller(ctx, instance.ID, linodego.EntityLinode, linodego.ActionDiskDelete)
+		p, err := eventWatcher.NewEventWaiter(ctx, instance.ID, linodego.EntityLinode, linodego.ActionDiskDelete)
 		if err != nil {
-			return false, fmt.Errorf("failed to initialize event poller: %s", err)
+			return false, fmt.Errorf("failed to initialize event waiter: %s", err)
 		}
 
 		tflog.Debug(ctx, "client.DeleteInstanceDisk(...)")
@@ -661,7 +667,7 @@ func updateInstanceDisks(
 		// The only non-destructive change supported is resize.
 		// Label renames are not supported because this TF provider relies on the label as an identifier.
 		if spec["size"].(int) != existingDisk.Size {
-			if err := changeInstanceDiskSize(ctx, &client, instance, existingDisk, spec["size"].(int), d); err != nil {
+			if err := changeInstanceDiskSize(ctx, &client, eventWatcher, instance, existingDisk, spec["size"].(int), d); err != nil {
 				return hasChanges, err
 			}
 			hasChanges = true
@@ -674,7 +680,7 @@ func updateInstanceDisks(
 
 	// create disks staged for creation
 	for _, spec := range added {
-		if _, err := createInstanceDisk(ctx, client, instance, spec, d); err != nil {
+		if _, err := createInstanceDisk(ctx, client, eventWatcher, instance, spec, d); err != nil {
 			return hasChanges, err
 		}
 	}
@@ -740,6 +746,7 @@ func ensureInstanceOffline(
 func changeInstanceType(
 	ctx context.Context,
 	client *linodego.Client,
+	eventWatcher *helper.EventWatcher,
 	instanceID int,
 	targetType string,
 	migrationType linodego.InstanceMigrationType,
@@ -773,9 +780,9 @@ func changeInstanceType(
 		"migration_type": migrationType,
 	})
 
-	p, err := client.NewEventPoller(ctx, instance.ID, linodego.EntityLinode, linodego.ActionLinodeResize)
+	p, err := eventWatcher.NewEventWaiter(ctx, instance.ID, linodego.EntityLinode, linodego.ActionLinodeResize)
 	if err != nil {
-		return nil, fmt.Errorf("failed to initialize event poller %d: %s", instance.ID, err)
+		return nil, fmt.Errorf("failed to initialize event waiter %d: %s", instance.ID, err)
 	}
 
 	if err := client.ResizeInstance(ctx, instance.ID, resizeOpts); err != nil {
@@ -828,6 +835,7 @@ func getDiskSizeChange(oldDisk interface{}, newDisk interface{}) (int, int) {
 func changeInstanceDiskSize(
 	ctx context.Context,
 	client *linodego.Client,
+	eventWatcher *helper.EventWatcher,
 	instance linodego.Instance,
 	disk linodego.InstanceDisk,
 	targetSize int,
@@ -866,9 +874,9 @@ func changeInstanceDiskSize(
 
 	tflog.Info(ctx, "Instance has reached offline status, resizing disk")
 
-	p, err := client.NewEventPoller(ctx, instance.ID, linodego.EntityLinode, linodego.ActionDiskResize)
+	p, err := eventWatcher.NewEventWaiter(ctx, instance.ID, linodego.EntityLinode, linodego.ActionDiskResize)
 	if err != nil {
-		return fmt.Errorf("failed to initialize event poller: %s", err)
+		return fmt.Errorf("failed to initialize event waiter: %s", err)
 	}
 
 	tflog.Debug(ctx, "client.ResizeInstanceDisk(...)", map[string]any{
@@ -970,6 +978,7 @@ func applyInstanceDiskSpec(
 	ctx context.Context,
 	d *schema.ResourceData,
 	client *linodego.Client,
+	eventWatcher *helper.EventWatcher,
 	instance *linodego.Instance,
 	typ *linodego.LinodeType,
 ) (bool, error) {
@@ -977,7 +986,7 @@ func applyInstanceDiskSpec(
 		return false, err
 	}
 
-	return updateInstanceDisks(ctx, *client, d, *instance)
+	return updateInstanceDisks(ctx, *client, eventWatcher, d, *instance)
 }
 
 // assertDiskConfigFitsInstanceType asserts that the cumulative disk space used by a given disk config fits a given
@@ -1001,6 +1010,7 @@ func applyInstanceTypeChange(
 	ctx context.Context,
 	d *schema.ResourceData,
 	client *linodego.Client,
+	eventWatcher *helper.EventWatcher,
 	instance *linodego.Instance,
 	typ *linodego.LinodeType,
 ) (*linodego.Instance, error) {
@@ -1033,7 +1043,7 @@ func applyInstanceTypeChange(
 		return nil, err
 	}
 
-	return changeInstanceType(ctx, client, instance.ID, typ.ID, migrationType, resizeDisk, d)
+	return changeInstanceType(ctx, client, eventWatcher, instance.ID, typ.ID, migrationType, resizeDisk, d)
 }
 
 // applyInstanceMigration synchronously migrates a Linode to a new region.
@@ -1041,6 +1051,7 @@ func applyInstanceMigration(
 	ctx context.Context,
 	d *schema.ResourceData,
 	client *linodego.Client,
+	eventWatcher *helper.EventWatcher,
 	instance *linodego.Instance,
 	targetRegion string,
 ) (*linodego.Instance, error) {
@@ -1055,9 +1066,9 @@ func applyInstanceMigration(
 
 	tflog.Debug(ctx, "Migrating instance to new region")
 
-	p, err := client.NewEventPoller(ctx, instance.ID, linodego.EntityLinode, linodego.ActionLinodeMigrateDatacenter)
+	p, err := eventWatcher.NewEventWaiter(ctx, instance.ID, linodego.EntityLinode, linodego.ActionLinodeMigrateDatacenter)
 	if err != nil {
-		return nil, fmt.Errorf("failed to initialize event poller %d: %s", instance.ID, err)
+		return nil, fmt.Errorf("failed to initialize event waiter %d: %s", instance.ID, err)
 	}
 
 	migrateOpts := linodego.InstanceMigrateOptions{
@@ -1090,6 +1101,230 @@ func applyInstanceMigration(
 	return result, nil
 }
 
+// instanceRebuildKeys are the keys that are applied by rebuilding the
+// instance in place when rebuild_on_image_change is enabled.
+var instanceRebuildKeys = []string{
+	"image",
+	"root_pass",
+	"authorized_keys",
+	"authorized_users",
+	"stackscript_id",
+	"stackscript_data",
+	"metadata.0.user_data",
+}
+
+// customDiffInstanceRebuild forces the replacement of the instance when any of
+// the rebuild keys change, unless the instance will be rebuilt in place.
+func customDiffInstanceRebuild(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || shouldRebuildInstance(d) {
+		return nil
+	}
+
+	for _, key := range instanceRebuildKeys {
+		if !d.HasChange(key) {
+			continue
+		}
+
+		if err := d.ForceNew(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// customDiffBackupSchedule rejects backup schedules for instances
+// that explicitly have the Backup service disabled.
+func customDiffBackupSchedule(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	return validateBackupScheduleConfig(d.GetRawConfig())
+}
+
+func validateBackupScheduleConfig(config cty.Value) error {
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+
+	backupsEnabled := config.GetAttr("backups_enabled")
+	if backupsEnabled.IsNull() || !backupsEnabled.IsKnown() || backupsEnabled.True() {
+		return nil
+	}
+
+	backups := config.GetAttr("backups")
+	if backups.IsNull() || !backups.IsKnown() || backups.LengthInt() < 1 {
+		return nil
+	}
+
+	schedule := backups.Index(cty.NumberIntVal(0)).GetAttr("schedule")
+	if schedule.IsNull() || !schedule.IsKnown() || schedule.LengthInt() < 1 {
+		return nil
+	}
+
+	return fmt.Errorf("backups.0.schedule can't be set when backups_enabled is false")
+}
+
+type instanceChangeGetter interface {
+	Get(key string) interface{}
+	GetChange(key string) (interface{}, interface{})
+	HasChange(key string) bool
+}
+
+// shouldRebuildInstance returns whether the instance should be
+// rebuilt in place to apply a change to its image.
+func shouldRebuildInstance(d instanceChangeGetter) bool {
+	if !d.Get("rebuild_on_image_change").(bool) || !d.HasChange("image") {
+		return false
+	}
+
+	// Instances without an image use explicit disks and configs,
+	// which would be removed by a rebuild.
+	oldImage, _ := d.GetChange("image")
+	return oldImage.(string) != ""
+}
+
+// applyInstanceRebuild rebuilds the instance using its new image, returning the ID
+// of the config created by the rebuild.
+func applyInstanceRebuild(
+	ctx context.Context,
+	d *schema.ResourceData,
+	client *linodego.Client,
+	eventWatcher *helper.EventWatcher,
+	instance *linodego.Instance,
+) (int, error) {
+	deadlineSeconds := getDeadlineSeconds(ctx, d)
+
+	rebuildOpts, err := expandInstanceRebuildOptions(d)
+	if err != nil {
+		return 0, err
+	}
+
+	ctx = tflog.SetField(ctx, "image", rebuildOpts.Image)
+
+	tflog.Info(ctx, "Rebuilding instance")
+
+	p, err := eventWatcher.NewEventWaiter(ctx, instance.ID, linodego.EntityLinode, linodego.ActionLinodeRebuild)
+	if err != nil {
+		return 0, fmt.Errorf("failed to initialize event waiter: %s", err)
+	}
+
+	tflog.Debug(ctx, "client.RebuildInstance(...)")
+
+	if _, err := client.RebuildInstance(ctx, instance.ID, rebuildOpts); err != nil {
+		return 0, fmt.Errorf("failed to rebuild instance %d: %w", instance.ID, err)
+	}
+
+	tflog.Debug(ctx, "Waiting for rebuild to finish")
+
+	if _, err := p.WaitForFinished(ctx, deadlineSeconds); err != nil {
+		return 0, fmt.Errorf("failed to wait for instance %d to finish rebuilding: %w", instance.ID, err)
+	}
+
+	tflog.Debug(ctx, "Instance rebuild has finished")
+
+	configs, err := client.ListInstanceConfigs(ctx, instance.ID, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list configs for instance %d: %w", instance.ID, err)
+	}
+
+	if len(configs) == 0 {
+		return 0, fmt.Errorf("no configs found for rebuilt instance %d", instance.ID)
+	}
+
+	configID := configs[0].ID
+
+	// The rebuild replaces the instance's configs, so any
+	// explicit interfaces need to be applied again.
+	if interfaces := d.Get("interface").([]interface{}); len(interfaces) > 0 {
+		configUpdateOpts := linodego.InstanceConfigUpdateOptions{
+			Interfaces: helper.ExpandConfigInterfaces(ctx, interfaces),
+		}
+
+		tflog.Debug(ctx, "client.UpdateInstanceConfig(...)", map[string]any{
+			"options":   configUpdateOpts,
+			"config_id": configID,
+		})
+
+		if _, err := client.UpdateInstanceConfig(ctx, instance.ID, configID, configUpdateOpts); err != nil {
+			return 0, fmt.Errorf("failed to set interfaces of rebuilt instance config: %w", err)
+		}
+	}
+
+	if !d.GetRawConfig().GetAttr("swap_size").IsNull() {
+		_, swapDisk, err := getInstanceDefaultDisks(ctx, instance.ID, client)
+		if err != nil {
+			return 0, err
+		}
+
+		if swapDisk != nil && swapDisk.Size != d.Get("swap_size").(int) {
+			if err := resizeInstanceSwapDisk(
+				ctx, d, client, eventWatcher, instance, swapDisk.Size, d.Get("swap_size").(int),
+			); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if !d.GetRawConfig().GetAttr("booted").IsNull() && !d.Get("booted").(bool) {
+		return configID, nil
+	}
+
+	if err := BootInstanceSync(ctx, client, eventWatcher, instance.ID, configID, deadlineSeconds); err != nil {
+		return 0, err
+	}
+
+	return configID, nil
+}
+
+func expandInstanceRebuildOptions(d *schema.ResourceData) (linodego.InstanceRebuildOptions, error) {
+	rebuildOpts := linodego.InstanceRebuildOptions{
+		Image:          d.Get("image").(string),
+		RootPass:       d.Get("root_pass").(string),
+		StackScriptID:  d.Get("stackscript_id").(int),
+		DiskEncryption: linodego.InstanceDiskEncryption(d.Get("disk_encryption").(string)),
+
+		// The instance is booted after its config has been updated
+		Booted: &boolFalse,
+	}
+
+	if rebuildOpts.RootPass == "" {
+		var err error
+		rebuildOpts.RootPass, err = helper.CreateRandomRootPassword()
+		if err != nil {
+			return rebuildOpts, err
+		}
+	}
+
+	for _, key := range d.Get("authorized_keys").([]interface{}) {
+		if key == nil {
+			return rebuildOpts, fmt.Errorf("invalid input for authorized_keys: keys cannot be empty or null")
+		}
+
+		rebuildOpts.AuthorizedKeys = append(rebuildOpts.AuthorizedKeys, key.(string))
+	}
+
+	for _, user := range d.Get("authorized_users").([]interface{}) {
+		if user == nil {
+			return rebuildOpts, fmt.Errorf("invalid input for authorized_users: users cannot be empty or null")
+		}
+
+		rebuildOpts.AuthorizedUsers = append(rebuildOpts.AuthorizedUsers, user.(string))
+	}
+
+	if stackscriptData, ok := d.Get("stackscript_data").(map[string]interface{}); ok && len(stackscriptData) > 0 {
+		rebuildOpts.StackScriptData = make(map[string]string, len(stackscriptData))
+		for name, value := range stackscriptData {
+			rebuildOpts.StackScriptData[name] = value.(string)
+		}
+	}
+
+	if _, ok := d.GetOk("metadata.0"); ok {
+		rebuildOpts.Metadata = &linodego.InstanceMetadataOptions{
+			UserData: d.Get("metadata.0.user_data").(string),
+		}
+	}
+
+	return rebuildOpts, nil
+}
+
 // detachConfigVolumes detaches any volumes associated with an InstanceConfig.Devices struct.
 func detachConfigVolumes(
 	ctx context.Context, dmap linodego.InstanceConfigDeviceMap, detacher volumeDetacher,
@@ -1158,14 +1393,44 @@ func validateBooted(ctx context.Context, d *schema.ResourceData) error {
 	_, imageOk := d.GetOk("image")
 	_, disksOk := d.GetOk("disk")
 	_, configsOk := d.GetOk("config")
+	_, cloneOk := d.GetOk("clone_source")
 
-	if !bootedNull && booted && !imageOk && !(disksOk && configsOk) {
+	if !bootedNull && booted && !imageOk && !cloneOk && !(disksOk && configsOk) {
 		return fmt.Errorf("booted requires an image or disk/config be defined")
 	}
 
 	return nil
 }
 
+// getCloneBootConfig returns the ID of the config to boot a cloned instance with,
+// defaulting to the first config cloned from the source Linode. Zero is returned
+// if no configs were cloned.
+func getCloneBootConfig(
+	ctx context.Context, client linodego.Client, d *schema.ResourceData, instanceID int,
+) (int, error) {
+	configs, err := client.ListInstanceConfigs(ctx, instanceID, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list configs for instance %d: %w", instanceID, err)
+	}
+
+	if len(configs) == 0 {
+		return 0, nil
+	}
+
+	bootConfigLabel := d.Get("boot_config_label").(string)
+	if bootConfigLabel == "" {
+		return configs[0].ID, nil
+	}
+
+	for _, config := range configs {
+		if config.Label == bootConfigLabel {
+			return config.ID, nil
+		}
+	}
+
+	return 0, fmt.Errorf("Error setting boot_config_label: Config label '%s' not found", bootConfigLabel)
+}
+
 func handleBootedUpdate(
 	ctx context.Context, d *schema.ResourceData, meta interface{}, instanceID, configID int,
 ) error {
@@ -1192,13 +1457,15 @@ func handleBootedUpdate(
 
 	// Boot or shutdown the instance if necessary
 	if instStatus != linodego.InstanceRunning && booted.(bool) {
-		if err := BootInstanceSync(ctx, &client, instanceID, configID, deadlineSeconds); err != nil {
+		if err := BootInstanceSync(ctx, &client, meta.(*helper.ProviderMeta).EventWatcher, instanceID, configID, deadlineSeconds); err != nil {
 			return err
 		}
 	}
 
 	if instStatus != linodego.InstanceOffline && !booted.(bool) {
-		if err := shutDownInstanceSync(ctx, client, instanceID, deadlineSeconds); err != nil {
+		if err := shutDownInstanceSync(
+			ctx, client, meta.(*helper.ProviderMeta).EventWatcher, instanceID, deadlineSeconds,
+		); err != nil {
 			return err
 		}
 	}
@@ -1250,12 +1517,17 @@ func waitForRunningOrOfflineState(
 	return instStatus, nil
 }
 
-func shutDownInstanceSync(ctx context.Context, client linodego.Client, instanceID, deadlineSeconds int) error {
+func shutDownInstanceSync(
+	ctx context.Context,
+	client linodego.Client,
+	eventWatcher *helper.EventWatcher,
+	instanceID, deadlineSeconds int,
+) error {
 	tflog.Info(ctx, "Shutting down instance")
 
-	p, err := client.NewEventPoller(ctx, instanceID, linodego.EntityLinode, linodego.ActionLinodeShutdown)
+	p, err := eventWatcher.NewEventWaiter(ctx, instanceID, linodego.EntityLinode, linodego.ActionLinodeShutdown)
 	if err != nil {
-		return fmt.Errorf("failed to initialize event poller: %s", err)
+		return fmt.Errorf("failed to initialize event waiter: %s", err)
 	}
 
 	tflog.Debug(ctx, "client.ShutdownInstance(...)")
@@ -1275,14 +1547,19 @@ func shutDownInstanceSync(ctx context.Context, client linodego.Client, instanceI
 	return nil
 }
 
-func BootInstanceSync(ctx context.Context, client *linodego.Client, instanceID, configID, deadlineSeconds int) error {
+func BootInstanceSync(
+	ctx context.Context,
+	client *linodego.Client,
+	eventWatcher *helper.EventWatcher,
+	instanceID, configID, deadlineSeconds int,
+) error {
 	ctx = tflog.SetField(ctx, "config_id", configID)
 
 	tflog.Info(ctx, "Booting instance")
 
-	p, err := client.NewEventPoller(ctx, instanceID, linodego.EntityLinode, linodego.ActionLinodeBoot)
+	p, err := eventWatcher.NewEventWaiter(ctx, instanceID, linodego.EntityLinode, linodego.ActionLinodeBoot)
 	if err != nil {
-		return fmt.Errorf("failed to initialize event poller: %s", err)
+		return fmt.Errorf("failed to initialize event waiter: %s", err)
 	}
 
 	tflog.Debug(ctx, "client.BootInstance(...)")
@@ -1309,12 +1586,16 @@ func getDiskSizeSum(ctx context.Context, d *schema.ResourceData,
 		return 0, fmt.Errorf("failed to get instance disks: %s", err)
 	}
 
+	return sumDiskSizes(disks), nil
+}
+
+func sumDiskSizes(disks []linodego.InstanceDisk) int {
 	sum := 0
 	for _, disk := range disks {
 		sum += disk.Size
 	}
 
-	return sum, nil
+	return sum
 }
 
 func getFirstDiskWithFilesystem(disks []linodego.InstanceDisk,
@@ -1331,6 +1612,135 @@ func getFirstDiskWithFilesystem(disks []linodego.InstanceDisk,
 	return nil
 }
 
+// customDiffInstanceDiskCapacity rejects plans whose disks don't fit into the
+// planned type of the instance, which would otherwise only fail during the
+// apply, possibly after the instance has already been shut down.
+func customDiffInstanceDiskCapacity(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("type") || d.Get("type").(string) == "" {
+		return nil
+	}
+
+	explicitDisks, known := hasExplicitDisks(d.GetRawConfig())
+	if !known {
+		return nil
+	}
+
+	if explicitDisks {
+		if d.Id() != "" && !d.HasChange("type") && !d.HasChange("disk") {
+			return nil
+		}
+	} else if d.Id() == "" || !d.HasChange("type") || shouldRebuildInstance(d) || requiresInstanceReplacement(d) {
+		// Implicit disks are only affected by type changes of existing instances
+		return nil
+	}
+
+	client := meta.(*helper.ProviderMeta).Client
+	typeID := d.Get("type").(string)
+
+	typ, err := client.GetType(ctx, typeID)
+	if err != nil {
+		addDiskCapacityWarning(ctx, fmt.Sprintf("Failed to get Linode type %s: %s", typeID, err))
+		return nil
+	}
+
+	if explicitDisks {
+		return validateExplicitDisksFitType(d.Get("disk").([]interface{}), typ)
+	}
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return nil
+	}
+
+	disks, err := client.ListInstanceDisks(ctx, id, nil)
+	if err != nil {
+		addDiskCapacityWarning(ctx, fmt.Sprintf("Failed to list the disks of Linode %d: %s", id, err))
+		return nil
+	}
+
+	return validateImplicitDisksFitType(disks, d.Get("resize_disk").(bool), typ)
+}
+
+func addDiskCapacityWarning(ctx context.Context, reason string) {
+	helper.AddSDKv2PlanWarning(
+		ctx,
+		"Disk Capacity Not Validated",
+		reason+"\n\nThe disks of this Linode will only be validated against its type when the plan is applied.",
+	)
+}
+
+// requiresInstanceReplacement returns whether the planned
+// changes will replace the instance rather than update it.
+func requiresInstanceReplacement(d *schema.ResourceDiff) bool {
+	for key, s := range resourceSchema {
+		if s.ForceNew && d.HasChange(key) {
+			return true
+		}
+	}
+
+	if shouldRebuildInstance(d) {
+		return false
+	}
+
+	for _, key := range instanceRebuildKeys {
+		if d.HasChange(key) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hasExplicitDisks returns whether the given config defines explicit disks,
+// and whether this is known during the plan.
+func hasExplicitDisks(config cty.Value) (explicit, known bool) {
+	if config.IsNull() || !config.IsKnown() {
+		return false, false
+	}
+
+	disks := config.GetAttr("disk")
+	if !disks.IsKnown() {
+		return false, false
+	}
+
+	return !disks.IsNull() && disks.LengthInt() > 0, true
+}
+
+func validateExplicitDisksFitType(disks []interface{}, typ *linodego.LinodeType) error {
+	// Unknown disk sizes are planned as zero, so the sum is a lower bound
+	_, size := getDiskSizeChange([]interface{}{}, disks)
+
+	if size <= typ.Disk {
+		return nil
+	}
+
+	return cty.GetAttrPath("disk").NewErrorf(
+		"Linode type %s has insufficient disk capacity for the config. Have %d MB; want %d MB.",
+		typ.ID, typ.Disk, size,
+	)
+}
+
+func validateImplicitDisksFitType(
+	disks []linodego.InstanceDisk,
+	resizeDisk bool,
+	typ *linodego.LinodeType,
+) error {
+	if resizeDisk {
+		if err := validateImplicitDiskList(disks); err != nil {
+			return cty.GetAttrPath("resize_disk").NewError(err)
+		}
+	}
+
+	if size := sumDiskSizes(disks); size > typ.Disk {
+		return cty.GetAttrPath("type").NewErrorf(
+			"Linode type %s has insufficient disk capacity for the existing disks. Have %d MB; want %d MB.%s",
+			typ.ID, typ.Disk, size, downsizeFailedMessage,
+		)
+	}
+
+	return nil
+}
+
 func validateImplicitDisks(ctx context.Context,
 	client *linodego.Client, instanceID int,
 ) error {
@@ -1339,6 +1749,12 @@ func validateImplicitDisks(ctx context.Context,
 		return fmt.Errorf("failed to get instance disks: %s", err)
 	}
 
+	return validateImplicitDiskList(disks)
+}
+
+// validateImplicitDiskList returns an error if the given disks
+// can't be resized along with the type of their instance.
+func validateImplicitDiskList(disks []linodego.InstanceDisk) error {
 	// No disks are an acceptable case
 	if len(disks) < 1 {
 		return nil
@@ -1432,14 +1848,20 @@ func VPCInterfaceIncluded(
 func BootInstanceAfterVPCInterfaceUpdate(ctx context.Context, meta *helper.ProviderMeta, instanceID, targetConfigID, deadlineSeconds int) diag.Diagnostics {
 	tflog.Debug(ctx, "Booting instance after VPC interface change applied")
 	if err := BootInstanceSync(
-		ctx, &meta.Client, instanceID, targetConfigID, deadlineSeconds,
+		ctx, &meta.Client, meta.EventWatcher, instanceID, targetConfigID, deadlineSeconds,
 	); err != nil {
 		return diag.Errorf("failed to boot instance after VPC interface change applied: %s", err)
 	}
 	return nil
 }
 
-func ShutdownInstanceForVPCInterfaceUpdate(ctx context.Context, client *linodego.Client, skipImplicitReboots bool, instanceID, deadlineSeconds int) error {
+func ShutdownInstanceForVPCInterfaceUpdate(
+	ctx context.Context,
+	client *linodego.Client,
+	eventWatcher *helper.EventWatcher,
+	skipImplicitReboots bool,
+	instanceID, deadlineSeconds int,
+) error {
 	if skipImplicitReboots {
 		return fmt.Errorf(
 			"Adding, removing, and reordering a Linode VPC interface requires the implicit " +
@@ -1448,10 +1870,15 @@ func ShutdownInstanceForVPCInterfaceUpdate(ctx context.Context, client *linodego
 		)
 	}
 
-	return SafeShutdownInstance(ctx, client, instanceID, deadlineSeconds)
+	return SafeShutdownInstance(ctx, client, eventWatcher, instanceID, deadlineSeconds)
 }
 
-func SafeShutdownInstance(ctx context.Context, client *linodego.Client, instanceID, deadlineSeconds int) error {
+func SafeShutdownInstance(
+	ctx context.Context,
+	client *linodego.Client,
+	eventWatcher *helper.EventWatcher,
+	instanceID, deadlineSeconds int,
+) error {
 	instance, err := client.GetInstance(ctx, instanceID)
 	if err != nil {
 		return fmt.Errorf("failed to get instance %d: %s", instanceID, err)
@@ -1468,7 +1895,7 @@ func SafeShutdownInstance(ctx context.Context, client *linodego.Client, instance
 	}
 	if instance.Status != linodego.InstanceOffline {
 		if err := shutDownInstanceSync(
-			ctx, *client, instance.ID, deadlineSeconds,
+			ctx, *client, eventWatcher, instance.ID, deadlineSeconds,
 		); err != nil {
 			return fmt.Errorf("failed to shutdown instance: %s", err)
 		}
@@ -0,0 +1,156 @@
+//go:build unit
+
+package instance
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHasExplicitDisks(t *testing.T) {
+	diskType := cty.Object(map[string]cty.Type{
+		"label": cty.String,
+		"size":  cty.Number,
+	})
+
+	testCases := map[string]struct {
+		disks            cty.Value
+		expectedExplicit bool
+		expectedKnown    bool
+	}{
+		"no disks": {
+			disks:            cty.NullVal(cty.List(diskType)),
+			expectedExplicit: false,
+			expectedKnown:    true,
+		},
+		"empty disks": {
+			disks:            cty.ListValEmpty(diskType),
+			expectedExplicit: false,
+			expectedKnown:    true,
+		},
+		"explicit disks": {
+			disks: cty.ListVal([]cty.Value{
+				cty.ObjectVal(map[string]cty.Value{
+					"label": cty.StringVal("boot"),
+					"size":  cty.NumberIntVal(1024),
+				}),
+			}),
+			expectedExplicit: true,
+			expectedKnown:    true,
+		},
+		"unknown disks": {
+			disks:            cty.UnknownVal(cty.List(diskType)),
+			expectedExplicit: false,
+			expectedKnown:    false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			explicit, known := hasExplicitDisks(cty.ObjectVal(map[string]cty.Value{
+				"disk": testCase.disks,
+			}))
+
+			assert.Equal(t, testCase.expectedExplicit, explicit)
+			assert.Equal(t, testCase.expectedKnown, known)
+		})
+	}
+}
+
+func TestValidateExplicitDisksFitType(t *testing.T) {
+	typ := &linodego.LinodeType{ID: "g6-nanode-1", Disk: 25600}
+
+	disks := func(sizes ...int) []interface{} {
+		result := make([]interface{}, len(sizes))
+		for i, size := range sizes {
+			result[i] = map[string]interface{}{"size": size}
+		}
+		return result
+	}
+
+	assert.NoError(t, validateExplicitDisksFitType(disks(25088, 512), typ))
+	assert.NoError(t, validateExplicitDisksFitType(disks(), typ))
+
+	err := validateExplicitDisksFitType(disks(25600, 512), typ)
+
+	var pathErr cty.PathError
+	require.ErrorAs(t, err, &pathErr)
+	assert.Equal(t, cty.GetAttrPath("disk"), pathErr.Path)
+	assert.Contains(t, err.Error(), "Have 25600 MB; want 26112 MB")
+}
+
+func TestValidateImplicitDisksFitType(t *testing.T) {
+	typ := &linodego.LinodeType{ID: "g6-nanode-1", Disk: 25600}
+
+	implicitDisks := []linodego.InstanceDisk{
+		{ID: 1, Size: 25088, Filesystem: linodego.FilesystemExt4},
+		{ID: 2, Size: 512, Filesystem: linodego.FilesystemSwap},
+	}
+
+	testCases := map[string]struct {
+		disks        []linodego.InstanceDisk
+		resizeDisk   bool
+		expectedPath cty.Path
+	}{
+		"fits": {
+			disks:      implicitDisks,
+			resizeDisk: true,
+		},
+		"no disks": {
+			resizeDisk: true,
+		},
+		"exceeds type": {
+			disks: []linodego.InstanceDisk{
+				{ID: 1, Size: 50688, Filesystem: linodego.FilesystemExt4},
+				{ID: 2, Size: 512, Filesystem: linodego.FilesystemSwap},
+			},
+			expectedPath: cty.GetAttrPath("type"),
+		},
+		"exceeds type with resize_disk": {
+			disks: []linodego.InstanceDisk{
+				{ID: 1, Size: 50688, Filesystem: linodego.FilesystemExt4},
+			},
+			resizeDisk:   true,
+			expectedPath: cty.GetAttrPath("type"),
+		},
+		"too many disks for resize_disk": {
+			disks: append([]linodego.InstanceDisk{
+				{ID: 3, Size: 0, Filesystem: linodego.FilesystemRaw},
+			}, implicitDisks...),
+			resizeDisk:   true,
+			expectedPath: cty.GetAttrPath("resize_disk"),
+		},
+		"too many disks without resize_disk": {
+			disks: append([]linodego.InstanceDisk{
+				{ID: 3, Size: 0, Filesystem: linodego.FilesystemRaw},
+			}, implicitDisks...),
+			resizeDisk: false,
+		},
+		"no ext disk for resize_disk": {
+			disks: []linodego.InstanceDisk{
+				{ID: 1, Size: 1024, Filesystem: linodego.FilesystemRaw},
+			},
+			resizeDisk:   true,
+			expectedPath: cty.GetAttrPath("resize_disk"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := validateImplicitDisksFitType(testCase.disks, testCase.resizeDisk, typ)
+
+			if testCase.expectedPath == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			var pathErr cty.PathError
+			require.ErrorAs(t, err, &pathErr)
+			assert.Equal(t, testCase.expectedPath, pathErr.Path)
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package instance
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+// PlanWarnings returns warnings for the changes of a planned
+// update that will shut down or reboot the instance.
+func PlanWarnings(ctx context.Context, d *helper.SDKv2PlannedChange, meta *helper.ProviderMeta) diag.Diagnostics {
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return nil
+	}
+
+	skipImplicitReboots := meta.Config.SkipImplicitReboots
+	warnings := helper.NewInstancePowerWarnings(id, skipImplicitReboots)
+
+	running := d.Prior.Get("status").(string) == string(linodego.InstanceRunning)
+
+	oldInterfaces, newInterfaces := d.GetChange("interface")
+	vpcInterfaceChange := d.HasChange("interface") &&
+		InterfacesIncludeVPC(oldInterfaces.([]any), newInterfaces.([]any))
+
+	// VPC interfaces are always updated through ShutdownInstanceForVPCInterfaceUpdate,
+	// which fails when implicit reboots are skipped even if the instance is offline.
+	if vpcInterfaceChange && (running || skipImplicitReboots) {
+		warnings.RequiredShutdown("the interfaces of its boot config will be updated, including a VPC interface")
+	}
+
+	if !running {
+		return warnings.SDKv2Diagnostics()
+	}
+
+	if d.HasChange("region") {
+		oldRegion, _ := d.GetChange("region")
+		warnings.Shutdown("it will be migrated from region %s to %s", oldRegion, plannedValue(d, "region"))
+	}
+
+	if d.HasChange("type") {
+		oldType, _ := d.GetChange("type")
+		warnings.Shutdown("its type will be changed from %s to %s", oldType, plannedValue(d, "type"))
+	}
+
+	if d.GetRawConfig().GetAttr("image").IsNull() && d.HasChange("disk") {
+		oldDisks, newDisks := d.GetChange("disk")
+
+		for _, resize := range getPlannedDiskResizes(oldDisks.([]any), newDisks.([]any)) {
+			warnings.Shutdown(
+				"disk %q will be resized from %d MB to %d MB", resize.label, resize.oldSize, resize.newSize,
+			)
+		}
+	}
+
+	rebuild := shouldRebuildInstance(d)
+
+	if rebuild {
+		warnings.Shutdown("it will be rebuilt from image %s", plannedValue(d, "image"))
+	} else if !d.GetRawConfig().GetAttr("image").IsNull() && d.HasChange("swap_size") {
+		oldSize, _ := d.GetChange("swap_size")
+		warnings.Shutdown("its swap disk will be resized from %d MB to %v MB", oldSize, plannedValue(d, "swap_size"))
+	}
+
+	shutDown := !d.GetRawConfig().GetAttr("booted").IsNull() && d.NewValueKnown("booted") &&
+		!d.Get("booted").(bool)
+
+	if shutDown {
+		warnings.Shutdown("booted is set to false")
+	}
+
+	// The instance isn't rebooted if it is rebuilt or should remain shut down
+	if !rebuild && !shutDown {
+		if d.HasChange("interface") && !vpcInterfaceChange {
+			warnings.ImplicitReboot("the interfaces of its boot config will be updated")
+		}
+
+		if d.HasChange("config") {
+			warnings.ImplicitReboot("its configs will be updated")
+		}
+
+		if d.HasChange("private_ip") && d.Get("private_ip").(bool) {
+			warnings.ImplicitReboot("private networking will be enabled")
+		}
+	}
+
+	return warnings.SDKv2Diagnostics()
+}
+
+// plannedValue returns the planned value of the given key,
+// which may not be known until the plan is applied.
+func plannedValue(d *helper.SDKv2PlannedChange, key string) any {
+	if !d.NewValueKnown(key) {
+		return "(known after apply)"
+	}
+
+	return d.Get(key)
+}
+
+// InterfacesIncludeVPC returns whether any of the
+// given flattened interfaces is a VPC interface.
+func InterfacesIncludeVPC(interfaces ...[]any) bool {
+	for _, list := range interfaces {
+		for _, iface := range list {
+			iface, ok := iface.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			if iface["purpose"] == string(linodego.InterfacePurposeVPC) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+type plannedDiskResize struct {
+	label            string
+	oldSize, newSize int
+}
+
+// getPlannedDiskResizes returns the explicit disks whose size
+// will be changed, matched by their labels.
+func getPlannedDiskResizes(oldDisks, newDisks []any) []plannedDiskResize {
+	oldSizes := make(map[string]int, len(oldDisks))
+
+	for _, disk := range oldDisks {
+		disk, ok := disk.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		oldSizes[disk["label"].(string)] = disk["size"].(int)
+	}
+
+	var result []plannedDiskResize
+
+	for _, disk := range newDisks {
+		disk, ok := disk.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		label, size := disk["label"].(string), disk["size"].(int)
+
+		oldSize, ok := oldSizes[label]
+		if !ok || size == 0 || size == oldSize {
+			continue
+		}
+
+		result = append(result, plannedDiskResize{
+			label:   label,
+			oldSize: oldSize,
+			newSize: size,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,198 @@
+//go:build unit
+
+package instance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanWarnings(t *testing.T) {
+	r := &schema.Resource{Schema: resourceSchema}
+
+	data := func(attrs map[string]string) *schema.ResourceData {
+		result := map[string]string{
+			"id":     "123",
+			"region": "us-east",
+			"type":   "g6-nanode-1",
+			"status": "running",
+			"booted": "true",
+		}
+
+		for k, v := range attrs {
+			result[k] = v
+		}
+
+		return r.Data(&terraform.InstanceState{ID: "123", Attributes: result})
+	}
+
+	config := func(attrs map[string]cty.Value) cty.Value {
+		result := map[string]cty.Value{
+			"image":  cty.NullVal(cty.String),
+			"booted": cty.NullVal(cty.Bool),
+		}
+
+		for k, v := range attrs {
+			result[k] = v
+		}
+
+		return cty.ObjectVal(result)
+	}
+
+	testCases := map[string]struct {
+		prior               map[string]string
+		planned             map[string]string
+		config              map[string]cty.Value
+		unknown             []string
+		skipImplicitReboots bool
+		expected            []diag.Diagnostic
+	}{
+		"no changes": {},
+		"type change": {
+			planned: map[string]string{"type": "g6-standard-1"},
+			expected: []diag.Diagnostic{
+				{
+					Summary: "Linode Instance 123 Will Be Shut Down",
+					Detail: "Applying this plan will shut down Linode 123 because:\n\n" +
+						"- its type will be changed from g6-nanode-1 to g6-standard-1",
+				},
+			},
+		},
+		"type change while offline": {
+			prior:   map[string]string{"status": "offline", "booted": "false"},
+			planned: map[string]string{"status": "offline", "booted": "false", "type": "g6-standard-1"},
+		},
+		"disk resize and config change": {
+			prior: map[string]string{
+				"disk.#":          "1",
+				"disk.0.label":    "boot",
+				"disk.0.size":     "1000",
+				"config.#":        "1",
+				"config.0.label":  "boot-config",
+				"config.0.kernel": "linode/grub2",
+			},
+			planned: map[string]string{
+				"disk.#":          "1",
+				"disk.0.label":    "boot",
+				"disk.0.size":     "2000",
+				"config.#":        "1",
+				"config.0.label":  "boot-config",
+				"config.0.kernel": "linode/latest-64bit",
+			},
+			expected: []diag.Diagnostic{
+				{
+					Summary: "Linode Instance 123 Will Be Shut Down",
+					Detail: "Applying this plan will shut down Linode 123 because:\n\n" +
+						"- disk \"boot\" will be resized from 1000 MB to 2000 MB",
+				},
+				{
+					Summary: "Linode Instance 123 Will Be Rebooted",
+					Detail: "Applying this plan will reboot Linode 123 because:\n\n" +
+						"- its configs will be updated",
+				},
+			},
+		},
+		"config change with implicit reboots skipped": {
+			prior: map[string]string{
+				"config.#":        "1",
+				"config.0.label":  "boot-config",
+				"config.0.kernel": "linode/grub2",
+			},
+			planned: map[string]string{
+				"config.#":        "1",
+				"config.0.label":  "boot-config",
+				"config.0.kernel": "linode/latest-64bit",
+			},
+			skipImplicitReboots: true,
+			expected: []diag.Diagnostic{
+				{
+					Summary: "Linode Instance 123 Requires a Reboot",
+					Detail: "The following changes will not take effect until Linode 123 is rebooted " +
+						"as 'skip_implicit_reboots' is enabled in the provider config:\n\n" +
+						"- its configs will be updated",
+				},
+			},
+		},
+		"vpc interface change with implicit reboots skipped": {
+			prior: map[string]string{"status": "offline", "booted": "false"},
+			planned: map[string]string{
+				"status":              "offline",
+				"booted":              "false",
+				"interface.#":         "1",
+				"interface.0.purpose": "vpc",
+			},
+			skipImplicitReboots: true,
+			expected: []diag.Diagnostic{
+				{
+					Summary: "Linode Instance 123 Must Be Shut Down",
+					Detail: "Applying this plan will fail as the following changes require Linode 123 to be shut " +
+						"down, which is not allowed while 'skip_implicit_reboots' is enabled in the provider config:" +
+						"\n\n- the interfaces of its boot config will be updated, including a VPC interface",
+				},
+			},
+		},
+		"unknown type change": {
+			planned: map[string]string{"type": ""},
+			unknown: []string{"type"},
+			expected: []diag.Diagnostic{
+				{
+					Summary: "Linode Instance 123 Will Be Shut Down",
+					Detail: "Applying this plan will shut down Linode 123 because:\n\n" +
+						"- its type will be changed from g6-nanode-1 to (known after apply)",
+				},
+			},
+		},
+		"unknown booted": {
+			planned: map[string]string{"booted": "false"},
+			config:  map[string]cty.Value{"booted": cty.UnknownVal(cty.Bool)},
+			unknown: []string{"booted"},
+		},
+		"booted set to false": {
+			planned: map[string]string{
+				"booted":     "false",
+				"private_ip": "true",
+			},
+			config: map[string]cty.Value{"booted": cty.False},
+			expected: []diag.Diagnostic{
+				{
+					Summary: "Linode Instance 123 Will Be Shut Down",
+					Detail: "Applying this plan will shut down Linode 123 because:\n\n" +
+						"- booted is set to false",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			plannedValue := map[string]cty.Value{}
+			for _, key := range tc.unknown {
+				plannedValue[key] = cty.UnknownVal(cty.DynamicPseudoType)
+			}
+
+			d := helper.NewSDKv2PlannedChange(
+				data(tc.prior), data(tc.planned), config(tc.config), cty.ObjectVal(plannedValue),
+			)
+			meta := &helper.ProviderMeta{
+				Config: &helper.Config{SkipImplicitReboots: tc.skipImplicitReboots},
+			}
+
+			diags := PlanWarnings(context.Background(), d, meta)
+
+			require.Len(t, diags, len(tc.expected))
+
+			for i, expected := range tc.expected {
+				require.Equal(t, diag.Warning, diags[i].Severity)
+				require.Equal(t, expected.Summary, diags[i].Summary)
+				require.Equal(t, expected.Detail, diags[i].Detail)
+			}
+		})
+	}
+}
@@ -21,6 +21,16 @@ const (
 	LinodeInstanceDeleteTimeout = 10 * time.Minute
 )
 
+// Resource returns the linode_instance resource.
+//
+// Unlike the linode_instances data source, this resource stays on SDKv2 for
+// now. Its `config`, `disk` and `alerts` blocks are optional and computed,
+// so they are populated from the API when they aren't configured and are
+// commonly referenced as e.g. `linode_instance.foo.disk.0.id`. The plugin
+// framework doesn't support computed blocks, and nested attributes, which
+// could replace them, require protocol version 6 and a different
+// configuration syntax. Migrating the resource would therefore break
+// existing configurations, which a state upgrader can't fix.
 func Resource() *schema.Resource {
 	return &schema.Resource{
 		Schema:        resourceSchema,
@@ -28,9 +38,15 @@ func Resource() *schema.Resource {
 		CreateContext: createResource,
 		UpdateContext: updateResource,
 		DeleteContext: deleteResource,
-		CustomizeDiff: customdiff.All(
-			linodediffs.ComputedWithDefault("tags", []string{}),
-			linodediffs.CaseInsensitiveSet("tags"),
+		CustomizeDiff: customdiff.Sequence(
+			customdiff.All(
+				linodediffs.ComputedWithDefault("tags", []string{}),
+				linodediffs.CaseInsensitiveSet("tags"),
+				customDiffInstanceRebuild,
+				customDiffBackupSchedule,
+			),
+			// Errors joined by customdiff.All lose their attribute paths
+			customDiffInstanceDiskCapacity,
 		),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -170,11 +186,16 @@ func createResource(ctx context.Context, d *schema.ResourceData, meta interface{
 	tflog.Debug(ctx, "Create linode_instance")
 
 	client := meta.(*helper.ProviderMeta).Client
+	eventWatcher := meta.(*helper.ProviderMeta).EventWatcher
 
 	if err := validateBooted(ctx, d); err != nil {
 		return diag.Errorf("failed to validate: %v", err)
 	}
 
+	if _, ok := d.GetOk("clone_source.0"); ok {
+		return createResourceFromClone(ctx, d, meta)
+	}
+
 	bootConfig := 0
 	createOpts := linodego.InstanceCreateOptions{
 		Region:         d.Get("region").(string),
@@ -279,9 +300,9 @@ func createResource(ctx context.Context, d *schema.ResourceData, meta interface{
 		createOpts.Booted = &boolFalse // necessary to prepare disks and configs
 	}
 
-	createPoller, err := client.NewEventPollerWithoutEntity(linodego.EntityLinode, linodego.ActionLinodeCreate)
+	createWaiter, err := eventWatcher.NewEventWaiterWithoutEntity(ctx, linodego.EntityLinode, linodego.ActionLinodeCreate)
 	if err != nil {
-		return diag.Errorf("failed to initialize event poller: %s", err)
+		return diag.Errorf("failed to initialize event waiter: %s", err)
 	}
 
 	tflog.Debug(ctx, "client.CreateInstance(...)", map[string]any{
@@ -296,7 +317,7 @@ func createResource(ctx context.Context, d *schema.ResourceData, meta interface{
 	ctx = tflog.SetField(ctx, "id", instance.ID)
 
 	d.SetId(fmt.Sprintf("%d", instance.ID))
-	createPoller.EntityID = instance.ID
+	createWaiter.EntityID = instance.ID
 
 	var ips []string
 	for _, ip := range instance.IPv4 {
@@ -335,6 +356,13 @@ func createResource(ctx context.Context, d *schema.ResourceData, meta interface{
 		updateOpts.Alerts.TransferQuota = d.Get("alerts.0.transfer_quota").(int)
 	}
 
+	// The backup schedule can't be set when creating the instance
+	if backups := expandInstanceBackupSchedule(d.Get("backups.0.schedule").([]interface{})); backups != nil &&
+		instance.Backups != nil && instance.Backups.Enabled {
+		doUpdate = true
+		updateOpts.Backups = backups
+	}
+
 	if doUpdate {
 		ctx = populateLogAttributes(ctx, d)
 		tflog.Debug(ctx, "client.UpdateInstance(...)", map[string]any{
@@ -356,7 +384,7 @@ func createResource(ctx context.Context, d *schema.ResourceData, meta interface{
 	if disksOk {
 		tflog.Debug(ctx, "Waiting for instance creation to complete before provisioning disks")
 
-		_, err = createPoller.WaitForFinished(ctx, getDeadlineSeconds(ctx, d))
+		_, err = createWaiter.WaitForFinished(ctx, getDeadlineSeconds(ctx, d))
 		if err != nil {
 			return diag.Errorf("Error waiting for Instance to finish creating: %s", err)
 		}
@@ -369,7 +397,7 @@ func createResource(ctx context.Context, d *schema.ResourceData, meta interface{
 		for _, diskSpec := range diskSpecs {
 			diskSpec := diskSpec.(map[string]interface{})
 
-			instanceDisk, err := createInstanceDisk(ctx, client, *instance, diskSpec, d)
+			instanceDisk, err := createInstanceDisk(ctx, client, eventWatcher, *instance, diskSpec, d)
 			if err != nil {
 				return diag.FromErr(err)
 			}
@@ -424,9 +452,11 @@ func createResource(ctx context.Context, d *schema.ResourceData, meta interface{
 
 	if createOpts.Booted == nil || !*createOpts.Booted {
 		if disksOk && configsOk && (bootedNull || booted) {
-			p, err := client.NewEventPoller(ctx, instance.ID, linodego.EntityLinode, linodego.ActionLinodeBoot)
+			p, err := eventWatcher.NewEventWaiter(
+				ctx, instance.ID, linodego.EntityLinode, linodego.ActionLinodeBoot,
+			)
 			if err != nil {
-				return diag.Errorf("failed to initialize event poller: %s", err)
+				return diag.Errorf("failed to initialize event waiter: %s", err)
 			}
 
 			tflog.Debug(ctx, "client.BootInstance(...)", map[string]any{
@@ -470,6 +500,173 @@ func createResource(ctx context.Context, d *schema.ResourceData, meta interface{
 	return readResource(ctx, d, meta)
 }
 
+// createResourceFromClone creates the instance by cloning the Linode
+// defined in the clone_source block, adopting the cloned disks and configs.
+func createResourceFromClone(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*helper.ProviderMeta).Client
+	eventWatcher := meta.(*helper.ProviderMeta).EventWatcher
+
+	sourceID := d.Get("clone_source.0.linode_id").(int)
+
+	ctx = tflog.SetField(ctx, "source_linode_id", sourceID)
+
+	cloneOpts := linodego.InstanceCloneOptions{
+		Region:         d.Get("region").(string),
+		Type:           d.Get("type").(string),
+		Label:          d.Get("label").(string),
+		Group:          d.Get("group").(string),
+		BackupsEnabled: d.Get("backups_enabled").(bool),
+		PrivateIP:      d.Get("private_ip").(bool),
+		Disks:          helper.ExpandIntList(d.Get("clone_source.0.disk_ids").([]interface{})),
+		Configs:        helper.ExpandIntList(d.Get("clone_source.0.config_ids").([]interface{})),
+		PlacementGroup: getPlacementGroupCreateOptions(ctx, d),
+	}
+
+	if _, metadataOk := d.GetOk("metadata.0"); metadataOk {
+		cloneOpts.Metadata = &linodego.InstanceMetadataOptions{
+			UserData: d.Get("metadata.0.user_data").(string),
+		}
+	}
+
+	// The clone event is emitted for the source Linode,
+	// with the new Linode as its secondary entity.
+	p, err := eventWatcher.NewEventWaiter(ctx, sourceID, linodego.EntityLinode, linodego.ActionLinodeClone)
+	if err != nil {
+		return diag.Errorf("failed to initialize event waiter: %s", err)
+	}
+
+	tflog.Debug(ctx, "client.CloneInstance(...)", map[string]any{
+		"options": cloneOpts,
+	})
+
+	instance, err := client.CloneInstance(ctx, sourceID, cloneOpts)
+	if err != nil {
+		return diag.Errorf("Error cloning Linode Instance %d: %s", sourceID, err)
+	}
+
+	ctx = tflog.SetField(ctx, "id", instance.ID)
+
+	d.SetId(strconv.Itoa(instance.ID))
+	p.SecondaryEntityID = instance.ID
+
+	tflog.Debug(ctx, "Waiting for instance clone to finish")
+
+	if _, err := p.WaitForFinished(ctx, getDeadlineSeconds(ctx, d)); err != nil {
+		return diag.Errorf("Error waiting for Linode Instance %d to finish cloning: %s", sourceID, err)
+	}
+
+	tflog.Debug(ctx, "Instance has finished cloning")
+
+	updateOpts := linodego.InstanceUpdateOptions{}
+	doUpdate := false
+
+	if tagsRaw, tagsOk := d.GetOk("tags"); tagsOk {
+		tags := helper.ExpandStringSet(tagsRaw.(*schema.Set))
+		updateOpts.Tags = &tags
+		doUpdate = true
+	}
+
+	if watchdogEnabled := d.Get("watchdog_enabled").(bool); !watchdogEnabled {
+		updateOpts.WatchdogEnabled = &watchdogEnabled
+		doUpdate = true
+	}
+
+	if _, alertsOk := d.GetOk("alerts.0"); alertsOk {
+		updateOpts.Alerts = &linodego.InstanceAlert{
+			CPU:           d.Get("alerts.0.cpu").(int),
+			IO:            d.Get("alerts.0.io").(int),
+			NetworkIn:     d.Get("alerts.0.network_in").(int),
+			NetworkOut:    d.Get("alerts.0.network_out").(int),
+			TransferQuota: d.Get("alerts.0.transfer_quota").(int),
+		}
+		doUpdate = true
+	}
+
+	if backups := expandInstanceBackupSchedule(d.Get("backups.0.schedule").([]interface{})); backups != nil &&
+		instance.Backups != nil && instance.Backups.Enabled {
+		updateOpts.Backups = backups
+		doUpdate = true
+	}
+
+	if doUpdate {
+		tflog.Debug(ctx, "client.UpdateInstance(...)", map[string]any{
+			"options": updateOpts,
+		})
+
+		if _, err := client.UpdateInstance(ctx, instance.ID, updateOpts); err != nil {
+			return diag.Errorf("Error updating Instance %d: %s", instance.ID, err)
+		}
+	}
+
+	if firewallID, ok := d.GetOk("firewall_id"); ok {
+		deviceOpts := linodego.FirewallDeviceCreateOptions{
+			ID:   instance.ID,
+			Type: linodego.FirewallDeviceLinode,
+		}
+
+		tflog.Debug(ctx, "client.CreateFirewallDevice(...)", map[string]any{
+			"options":     deviceOpts,
+			"firewall_id": firewallID,
+		})
+
+		if _, err := client.CreateFirewallDevice(ctx, firewallID.(int), deviceOpts); err != nil {
+			return diag.Errorf("failed to assign firewall %d to instance: %s", firewallID, err)
+		}
+	}
+
+	if ipv4Shared, ok := d.GetOk("shared_ipv4"); ok {
+		shareOpts := linodego.IPAddressesShareOptions{
+			IPs:      helper.ExpandStringSet(ipv4Shared.(*schema.Set)),
+			LinodeID: instance.ID,
+		}
+
+		tflog.Debug(ctx, "client.ShareIPAddresses(...)", map[string]any{
+			"options": shareOpts,
+		})
+
+		if err := client.ShareIPAddresses(ctx, shareOpts); err != nil {
+			return diag.Errorf("failed to share ipv4 addresses with instance: %s", err)
+		}
+	}
+
+	targetStatus := linodego.InstanceOffline
+	bootedNull := d.GetRawConfig().GetAttr("booted").IsNull()
+	bootConfig := 0
+
+	if bootedNull || d.Get("booted").(bool) {
+		bootConfig, err = getCloneBootConfig(ctx, client, d, instance.ID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if bootConfig == 0 && !bootedNull {
+			return diag.Errorf("failed to boot instance %d: no configs were cloned", instance.ID)
+		}
+	}
+
+	if bootConfig > 0 {
+		if err := BootInstanceSync(
+			ctx, &client, eventWatcher, instance.ID, bootConfig, getDeadlineSeconds(ctx, d),
+		); err != nil {
+			return diag.FromErr(err)
+		}
+
+		targetStatus = linodego.InstanceRunning
+	}
+
+	if !meta.(*helper.ProviderMeta).Config.SkipInstanceReadyPoll {
+		tflog.Debug(ctx, "Waiting for instance to reach target status", map[string]any{
+			"target_status": targetStatus,
+		})
+
+		if _, err = client.WaitForInstanceStatus(ctx, instance.ID, targetStatus, getDeadlineSeconds(ctx, d)); err != nil {
+			return diag.Errorf("timed-out waiting for Linode instance %d to reach status %s: %s", instance.ID, targetStatus, err)
+		}
+	}
+
+	return readResource(ctx, d, meta)
+}
+
 func findDiskByFS(disks []linodego.InstanceDisk, fs linodego.DiskFilesystem) *linodego.InstanceDisk {
 	for _, disk := range disks {
 		if disk.Filesystem == fs {
@@ -484,21 +681,39 @@ func findDiskByFS(disks []linodego.InstanceDisk, fs linodego.DiskFilesystem) *li
 //
 // returns bool describing whether the linode needs to be restarted.
 func adjustSwapSizeIfNeeded(
-	ctx context.Context, d *schema.ResourceData, client *linodego.Client, instance *linodego.Instance,
+	ctx context.Context,
+	d *schema.ResourceData,
+	client *linodego.Client,
+	eventWatcher *helper.EventWatcher,
+	instance *linodego.Instance,
 ) (bool, error) {
-	if !d.HasChange("swap_size") {
+	// The swap size of rebuilt instances is applied after the rebuild
+	if !d.HasChange("swap_size") || shouldRebuildInstance(d) {
 		return false, nil
 	}
 
+	oldSwap, newSwap := d.GetChange("swap_size")
+
+	return true, resizeInstanceSwapDisk(ctx, d, client, eventWatcher, instance, oldSwap.(int), newSwap.(int))
+}
+
+// resizeInstanceSwapDisk resizes the swap disk of an instance with default
+// disks, resizing the boot disk to keep the total size of the disks.
+func resizeInstanceSwapDisk(
+	ctx context.Context,
+	d *schema.ResourceData,
+	client *linodego.Client,
+	eventWatcher *helper.EventWatcher,
+	instance *linodego.Instance,
+	oldSwap, newSwap int,
+) error {
 	// If the swap_size attribute is set, there are two default disks attached to the instance (the main disk of type ext4
 	// and a swap disk), as custom disk configuration via "disk" nested attributes conflicts with the swap_size.
 	bootDisk, swapDisk, err := getInstanceDefaultDisks(ctx, instance.ID, client)
 	if err != nil {
-		return false, err
+		return err
 	}
 
-	oldSwapVal, newSwapVal := d.GetChange("swap_size")
-	oldSwap, newSwap := oldSwapVal.(int), newSwapVal.(int)
 	diff := newSwap - oldSwap
 	newBootDiskSize := bootDisk.Size - diff
 
@@ -522,11 +737,11 @@ func adjustSwapSizeIfNeeded(
 	}
 
 	for _, resizeOp := range toResize {
-		if err := changeInstanceDiskSize(ctx, client, *instance, *resizeOp.disk, resizeOp.size, d); err != nil {
-			return true, err
+		if err := changeInstanceDiskSize(ctx, client, eventWatcher, *instance, *resizeOp.disk, resizeOp.size, d); err != nil {
+			return err
 		}
 	}
-	return true, nil
+	return nil
 }
 
 func updateResource(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -534,6 +749,7 @@ func updateResource(ctx context.Context, d *schema.ResourceData, meta interface{
 	tflog.Debug(ctx, "Update linode_instance")
 
 	client := meta.(*helper.ProviderMeta).Client
+	eventWatcher := meta.(*helper.ProviderMeta).EventWatcher
 	skipImplicitReboots := meta.(*helper.ProviderMeta).Config.SkipImplicitReboots
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
@@ -634,6 +850,23 @@ func updateResource(ctx context.Context, d *schema.ResourceData, meta interface{
 		}
 	}
 
+	if d.HasChange("backups.0.schedule") && d.Get("backups_enabled").(bool) {
+		if backups := expandInstanceBackupSchedule(d.Get("backups.0.schedule").([]interface{})); backups != nil {
+			instanceID := instance.ID
+			backupsUpdateOpts := linodego.InstanceUpdateOptions{
+				Backups: backups,
+			}
+
+			tflog.Debug(ctx, "client.UpdateInstance(...)", map[string]any{
+				"options": backupsUpdateOpts,
+			})
+
+			if instance, err = client.UpdateInstance(ctx, instanceID, backupsUpdateOpts); err != nil {
+				return diag.Errorf("Error updating backup schedule of Instance %d: %s", instanceID, err)
+			}
+		}
+	}
+
 	rebootInstance := false
 
 	if d.HasChange("private_ip") {
@@ -661,6 +894,7 @@ func updateResource(ctx context.Context, d *schema.ResourceData, meta interface{
 			ctx,
 			d,
 			&client,
+			eventWatcher,
 			instance,
 			d.Get("region").(string),
 		)
@@ -677,7 +911,7 @@ func updateResource(ctx context.Context, d *schema.ResourceData, meta interface{
 
 	if upsized {
 		// The linode was upsized; apply before disk changes to allocate more disk
-		if instance, err = applyInstanceTypeChange(ctx, d, &client, instance, newSpec); err != nil {
+		if instance, err = applyInstanceTypeChange(ctx, d, &client, eventWatcher, instance, newSpec); err != nil {
 			return diag.Errorf("failed to change instance type: %s", err)
 		}
 		rebootInstance = true
@@ -685,7 +919,7 @@ func updateResource(ctx context.Context, d *schema.ResourceData, meta interface{
 
 	// We only need to do this if explicit disks are defined
 	if d.GetRawConfig().GetAttr("image").IsNull() {
-		if didChange, err := applyInstanceDiskSpec(ctx, d, &client, instance, newSpec); err == nil && didChange {
+		if didChange, err := applyInstanceDiskSpec(ctx, d, &client, eventWatcher, instance, newSpec); err == nil && didChange {
 			rebootInstance = true
 		} else if err != nil && newSpec.Disk < oldSpec.Disk && !d.HasChange("disk") {
 			// Linode was downsized but the pre-existing disk config does not fit new instance spec
@@ -698,12 +932,12 @@ func updateResource(ctx context.Context, d *schema.ResourceData, meta interface{
 
 	if oldSpec.ID != newSpec.ID && !upsized {
 		// linode was downsized or changed to a type with the same disk allocation
-		if instance, err = applyInstanceTypeChange(ctx, d, &client, instance, newSpec); err != nil {
+		if instance, err = applyInstanceTypeChange(ctx, d, &client, eventWatcher, instance, newSpec); err != nil {
 			return diag.Errorf("failed to change instance type: %s", err)
 		}
 	}
 
-	if didChange, err := adjustSwapSizeIfNeeded(ctx, d, &client, instance); err != nil {
+	if didChange, err := adjustSwapSizeIfNeeded(ctx, d, &client, eventWatcher, instance); err != nil {
 		return diag.FromErr(err)
 	} else if didChange {
 		rebootInstance = true
@@ -766,7 +1000,7 @@ func updateResource(ctx context.Context, d *schema.ResourceData, meta interface{
 
 		if powerOffRequired {
 			if err := ShutdownInstanceForVPCInterfaceUpdate(
-				ctx, &client, skipImplicitReboots, id, helper.GetDeadlineSeconds(ctx, d),
+				ctx, &client, eventWatcher, skipImplicitReboots, id, helper.GetDeadlineSeconds(ctx, d),
 			); err != nil {
 				return diag.FromErr(err)
 			}
@@ -818,6 +1052,16 @@ func updateResource(ctx context.Context, d *schema.ResourceData, meta interface{
 		}
 	}
 
+	// The instance is rebuilt last as the rebuild replaces its disks and configs
+	if shouldRebuildInstance(d) {
+		if bootConfig, err = applyInstanceRebuild(ctx, d, &client, eventWatcher, instance); err != nil {
+			return diag.Errorf("failed to rebuild instance: %s", err)
+		}
+
+		// The rebuilt instance has already been booted if necessary
+		rebootInstance = false
+	}
+
 	// Don't reboot if the Linode should be powered off
 	if !bootedNull && !booted {
 		rebootInstance = false
@@ -833,9 +1077,9 @@ func updateResource(ctx context.Context, d *schema.ResourceData, meta interface{
 
 		tflog.Info(ctx, "Implicitly rebooting instance")
 
-		p, err := client.NewEventPoller(ctx, id, linodego.EntityLinode, linodego.ActionLinodeReboot)
+		p, err := eventWatcher.NewEventWaiter(ctx, id, linodego.EntityLinode, linodego.ActionLinodeReboot)
 		if err != nil {
-			return diag.Errorf("failed to initialize event poller: %s", err)
+			return diag.Errorf("failed to initialize event waiter: %s", err)
 		}
 
 		tflog.Debug(ctx, "client.RebootInstance(...)")
@@ -873,14 +1117,15 @@ func deleteResource(ctx context.Context, d *schema.ResourceData, meta interface{
 	tflog.Debug(ctx, "Delete linode_instance")
 
 	client := meta.(*helper.ProviderMeta).Client
+	eventWatcher := meta.(*helper.ProviderMeta).EventWatcher
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
 		return diag.Errorf("Error parsing Linode Instance ID %s as int", d.Id())
 	}
 
-	p, err := client.NewEventPoller(ctx, id, linodego.EntityLinode, linodego.ActionLinodeDelete)
+	p, err := eventWatcher.NewEventWaiter(ctx, id, linodego.EntityLinode, linodego.ActionLinodeDelete)
 	if err != nil {
-		return diag.Errorf("failed to initialize event poller: %s", err)
+		return diag.Errorf("failed to initialize event waiter: %s", err)
 	}
 
 	tflog.Debug(ctx, "client.DeleteInstance(...)")
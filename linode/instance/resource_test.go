@@ -15,6 +15,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/linode/linodego"
 	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
@@ -130,6 +131,73 @@ func TestAccResourceInstance_watchdogDisabled(t *testing.T) {
 	})
 }
 
+func TestAccResourceInstance_backupSchedule(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_instance.foobar"
+	var instance linodego.Instance
+	instanceName := acctest.RandomWithPrefix("tf_test")
+	rootPass := acctest.RandString(64)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.PreCheck(t) },
+		ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+		CheckDestroy:             acceptance.CheckInstanceDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: tmpl.BackupSchedule(t, instanceName, testRegion, rootPass, "Saturday", "W10"),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.CheckInstanceExists(resName, &instance),
+					resource.TestCheckResourceAttr(resName, "backups_enabled", "true"),
+					resource.TestCheckResourceAttr(resName, "backups.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resName, "backups.0.schedule.0.day", "Saturday"),
+					resource.TestCheckResourceAttr(resName, "backups.0.schedule.0.window", "W10"),
+				),
+			},
+			{
+				Config: tmpl.BackupSchedule(t, instanceName, testRegion, rootPass, "Monday", "W22"),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.CheckInstanceExists(resName, &instance),
+					resource.TestCheckResourceAttr(resName, "backups.0.schedule.0.day", "Monday"),
+					resource.TestCheckResourceAttr(resName, "backups.0.schedule.0.window", "W22"),
+				),
+			},
+			{
+				PreConfig: func() {
+					client := acceptance.TestAccProvider.Meta().(*helper.ProviderMeta).Client
+
+					backups := &linodego.InstanceBackup{}
+					backups.Schedule.Day = "Sunday"
+					backups.Schedule.Window = "W4"
+
+					if _, err := client.UpdateInstance(
+						context.Background(), instance.ID, linodego.InstanceUpdateOptions{Backups: backups},
+					); err != nil {
+						t.Fatalf("failed to update backup schedule: %s", err)
+					}
+				},
+				Config:             tmpl.BackupSchedule(t, instanceName, testRegion, rootPass, "Monday", "W22"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: tmpl.BackupSchedule(t, instanceName, testRegion, rootPass, "Monday", "W22"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "backups.0.schedule.0.day", "Monday"),
+					resource.TestCheckResourceAttr(resName, "backups.0.schedule.0.window", "W22"),
+				),
+			},
+			{
+				ResourceName:            resName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"root_pass", "authorized_keys", "image", "resize_disk", "migration_type", "firewall_id"},
+			},
+		},
+	})
+}
+
 func TestAccResourceInstance_authorizedUsers(t *testing.T) {
 	t.Parallel()
 
@@ -2376,6 +2444,113 @@ func TestAccResourceInstance_migration(t *testing.T) {
 	})
 }
 
+func TestAccResourceInstance_rebuildOnImageChange(t *testing.T) {
+	t.Parallel()
+
+	rootPass := acctest.RandString(64)
+
+	resName := "linode_instance.foobar"
+	var instance linodego.Instance
+	var originalID int
+	instanceName := acctest.RandomWithPrefix("tf_test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.PreCheck(t) },
+		ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+		CheckDestroy:             acceptance.CheckInstanceDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: tmpl.RebuildOnImageChange(
+					t, instanceName, acceptance.PublicKeyMaterial, acceptance.TestImagePrevious, testRegion, rootPass,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.CheckInstanceExists(resName, &instance),
+					resource.TestCheckResourceAttr(resName, "image", acceptance.TestImagePrevious),
+					resource.TestCheckResourceAttr(resName, "swap_size", "256"),
+					resource.TestCheckResourceAttr(resName, "status", "running"),
+					func(*terraform.State) error {
+						originalID = instance.ID
+						return nil
+					},
+				),
+			},
+			{
+				Config: tmpl.RebuildOnImageChange(
+					t, instanceName, acceptance.PublicKeyMaterial, acceptance.TestImageLatest, testRegion, rootPass,
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.CheckInstanceExists(resName, &instance),
+					resource.TestCheckResourceAttr(resName, "image", acceptance.TestImageLatest),
+					resource.TestCheckResourceAttr(resName, "swap_size", "256"),
+					resource.TestCheckResourceAttr(resName, "status", "running"),
+					func(*terraform.State) error {
+						if instance.ID != originalID {
+							return fmt.Errorf("expected instance %d to be rebuilt in place, got %d", originalID, instance.ID)
+						}
+
+						if instance.Image != acceptance.TestImageLatest {
+							return fmt.Errorf("expected instance image %s, got %s", acceptance.TestImageLatest, instance.Image)
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceInstance_clone(t *testing.T) {
+	acceptance.LongRunningTest(t)
+
+	t.Parallel()
+
+	rootPass := acctest.RandString(64)
+
+	resName := "linode_instance.foobar"
+	var instance linodego.Instance
+	instanceName := acctest.RandomWithPrefix("tf_test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.PreCheck(t) },
+		ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+		CheckDestroy:             acceptance.CheckInstanceDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: tmpl.Clone(t, instanceName, acceptance.PublicKeyMaterial, testRegion, rootPass),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.CheckInstanceExists(resName, &instance),
+					resource.TestCheckResourceAttr(resName, "label", instanceName),
+					resource.TestCheckResourceAttr(resName, "type", "g6-standard-1"),
+					resource.TestCheckResourceAttr(resName, "region", testRegion),
+					resource.TestCheckResourceAttr(resName, "status", "running"),
+					resource.TestCheckResourceAttr(resName, "disk.#", "2"),
+					resource.TestCheckResourceAttr(resName, "config.#", "1"),
+					resource.TestCheckResourceAttr(resName, "swap_size", "256"),
+					resource.TestCheckResourceAttrPair(
+						resName, "clone_source.0.linode_id", "linode_instance.source", "id",
+					),
+				),
+			},
+			{
+				ResourceName:      resName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"clone_source", "resize_disk", "migration_type", "firewall_id",
+				},
+			},
+		},
+	})
+}
+
 func TestAccResourceInstance_withPG(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,330 @@
+//go:build unit
+
+package instance
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustomDiffInstanceRebuild(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "123",
+		Attributes: map[string]string{
+			"id":        "123",
+			"region":    "us-east",
+			"type":      "g6-nanode-1",
+			"image":     "linode/debian11",
+			"root_pass": rootPasswordState("password"),
+		},
+	}
+
+	r := &schema.Resource{
+		Schema:        resourceSchema,
+		CustomizeDiff: customDiffInstanceRebuild,
+	}
+
+	testCases := map[string]struct {
+		config      map[string]interface{}
+		requiresNew bool
+	}{
+		"image change replaces instance": {
+			config: map[string]interface{}{
+				"region":    "us-east",
+				"image":     "linode/debian12",
+				"root_pass": "password",
+			},
+			requiresNew: true,
+		},
+		"image change rebuilds instance": {
+			config: map[string]interface{}{
+				"region":                  "us-east",
+				"image":                   "linode/debian12",
+				"root_pass":               "new-password",
+				"rebuild_on_image_change": true,
+			},
+			requiresNew: false,
+		},
+		"root_pass change without image change replaces instance": {
+			config: map[string]interface{}{
+				"region":                  "us-east",
+				"image":                   "linode/debian11",
+				"root_pass":               "new-password",
+				"rebuild_on_image_change": true,
+			},
+			requiresNew: true,
+		},
+		"metadata change replaces instance": {
+			config: map[string]interface{}{
+				"region":    "us-east",
+				"image":     "linode/debian11",
+				"root_pass": "password",
+				"metadata": []interface{}{
+					map[string]interface{}{"user_data": "dGVzdA=="},
+				},
+			},
+			requiresNew: true,
+		},
+		"no change": {
+			config: map[string]interface{}{
+				"region":    "us-east",
+				"image":     "linode/debian11",
+				"root_pass": "password",
+			},
+			requiresNew: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			diff, err := r.SimpleDiff(
+				context.Background(), state, terraform.NewResourceConfigRaw(testCase.config), nil,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff.RequiresNew() != testCase.requiresNew {
+				t.Errorf("expected requires new to be %t, got %t", testCase.requiresNew, diff.RequiresNew())
+			}
+		})
+	}
+}
+
+func TestCustomDiffInstanceDiskCapacity(t *testing.T) {
+	const (
+		typeResponse = `{"id": "g6-nanode-1", "disk": 25600}`
+		diskResponse = `{"data": [
+			{"id": 1, "size": 25088, "filesystem": "ext4"},
+			{"id": 2, "size": 512, "filesystem": "swap"}
+		], "page": 1, "pages": 1, "results": 2}`
+		largeDiskResponse = `{"data": [
+			{"id": 1, "size": 50688, "filesystem": "ext4"},
+			{"id": 2, "size": 512, "filesystem": "swap"}
+		], "page": 1, "pages": 1, "results": 2}`
+	)
+
+	r := &schema.Resource{
+		Schema:        resourceSchema,
+		CustomizeDiff: customDiffInstanceDiskCapacity,
+	}
+
+	schemaBlock := r.CoreConfigSchema()
+
+	state := &terraform.InstanceState{
+		ID: "123",
+		Attributes: map[string]string{
+			"id":     "123",
+			"region": "us-east",
+			"type":   "g6-standard-2",
+		},
+	}
+
+	config := func(attrs map[string]cty.Value) cty.Value {
+		values := map[string]cty.Value{}
+		for name, attrType := range schemaBlock.ImpliedType().AttributeTypes() {
+			values[name] = cty.NullVal(attrType)
+		}
+
+		values["region"] = cty.StringVal("us-east")
+		values["type"] = cty.StringVal("g6-nanode-1")
+
+		for k, v := range attrs {
+			values[k] = v
+		}
+
+		return cty.ObjectVal(values)
+	}
+
+	diskType := schemaBlock.BlockTypes["disk"].ImpliedType()
+
+	disk := map[string]cty.Value{}
+	for name, attrType := range diskType.AttributeTypes() {
+		disk[name] = cty.NullVal(attrType)
+	}
+
+	disk["label"] = cty.StringVal("boot")
+	disk["size"] = cty.NumberIntVal(30000)
+
+	explicitDisks := cty.ListVal([]cty.Value{cty.ObjectVal(disk)})
+
+	testCases := map[string]struct {
+		state            *terraform.InstanceState
+		config           map[string]cty.Value
+		typeResponse     string
+		diskResponse     string
+		expectedPath     cty.Path
+		expectedWarnings int
+	}{
+		"implicit disks fit": {
+			state:        state,
+			typeResponse: typeResponse,
+			diskResponse: diskResponse,
+		},
+		"implicit disks exceed type": {
+			state:        state,
+			typeResponse: typeResponse,
+			diskResponse: largeDiskResponse,
+			expectedPath: cty.GetAttrPath("type"),
+		},
+		"type lookup failure": {
+			state:            state,
+			diskResponse:     diskResponse,
+			expectedWarnings: 1,
+		},
+		"disk lookup failure": {
+			state:            state,
+			typeResponse:     typeResponse,
+			expectedWarnings: 1,
+		},
+		"replaced instance": {
+			state:  state,
+			config: map[string]cty.Value{"firewall_id": cty.NumberIntVal(1)},
+		},
+		"unknown type": {
+			state:  state,
+			config: map[string]cty.Value{"type": cty.UnknownVal(cty.String)},
+		},
+		"explicit disks exceed type on create": {
+			config:       map[string]cty.Value{"disk": explicitDisks},
+			typeResponse: typeResponse,
+			expectedPath: cty.GetAttrPath("disk"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var response string
+
+				switch {
+				case strings.Contains(r.URL.Path, "/linode/types/"):
+					response = testCase.typeResponse
+				case strings.HasSuffix(r.URL.Path, "/linode/instances/123/disks"):
+					response = testCase.diskResponse
+				}
+
+				if response == "" {
+					w.WriteHeader(http.StatusForbidden)
+					response = `{"errors": [{"reason": "Unauthorized"}]}`
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(response))
+			}))
+			t.Cleanup(server.Close)
+
+			client := linodego.NewClient(http.DefaultClient)
+			client.SetBaseURL(server.URL)
+
+			ctx, warnings := helper.WithSDKv2PlanWarnings(context.Background())
+
+			// The gRPC server passes the raw config to CustomizeDiff through the prior state
+			state := &terraform.InstanceState{}
+			if testCase.state != nil {
+				state = testCase.state.DeepCopy()
+			}
+
+			state.RawConfig = config(testCase.config)
+
+			_, err := r.SimpleDiff(
+				ctx,
+				state,
+				terraform.NewResourceConfigShimmed(state.RawConfig, schemaBlock),
+				&helper.ProviderMeta{Client: client},
+			)
+
+			assert.Len(t, *warnings, testCase.expectedWarnings)
+
+			if testCase.expectedPath == nil {
+				require.NoError(t, err)
+				return
+			}
+
+			var pathErr cty.PathError
+			require.ErrorAs(t, err, &pathErr)
+			assert.Equal(t, testCase.expectedPath, pathErr.Path)
+		})
+	}
+}
+
+func TestValidateBackupScheduleConfig(t *testing.T) {
+	scheduleType := cty.Object(map[string]cty.Type{
+		"day":    cty.String,
+		"window": cty.String,
+	})
+	backupsType := cty.Object(map[string]cty.Type{
+		"schedule": cty.List(scheduleType),
+	})
+
+	schedule := cty.ListVal([]cty.Value{
+		cty.ObjectVal(map[string]cty.Value{
+			"schedule": cty.ListVal([]cty.Value{
+				cty.ObjectVal(map[string]cty.Value{
+					"day":    cty.StringVal("Saturday"),
+					"window": cty.StringVal("W10"),
+				}),
+			}),
+		}),
+	})
+
+	testCases := map[string]struct {
+		backupsEnabled cty.Value
+		backups        cty.Value
+		expectErr      bool
+	}{
+		"schedule with backups enabled": {
+			backupsEnabled: cty.True,
+			backups:        schedule,
+			expectErr:      false,
+		},
+		"schedule with backups unset": {
+			backupsEnabled: cty.NullVal(cty.Bool),
+			backups:        schedule,
+			expectErr:      false,
+		},
+		"schedule with backups disabled": {
+			backupsEnabled: cty.False,
+			backups:        schedule,
+			expectErr:      true,
+		},
+		"backups disabled without schedule": {
+			backupsEnabled: cty.False,
+			backups: cty.ListVal([]cty.Value{
+				cty.ObjectVal(map[string]cty.Value{
+					"schedule": cty.ListValEmpty(scheduleType),
+				}),
+			}),
+			expectErr: false,
+		},
+		"backups disabled without backups": {
+			backupsEnabled: cty.False,
+			backups:        cty.ListValEmpty(backupsType),
+			expectErr:      false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := validateBackupScheduleConfig(cty.ObjectVal(map[string]cty.Value{
+				"backups_enabled": testCase.backupsEnabled,
+				"backups":         testCase.backups,
+			}))
+
+			if (err != nil) != testCase.expectErr {
+				t.Errorf("expected error to be %t, got %v", testCase.expectErr, err)
+			}
+		})
+	}
+}
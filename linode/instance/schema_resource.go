@@ -10,6 +10,15 @@ import (
 	"github.com/linode/terraform-provider-linode/v2/linode/helper"
 )
 
+var (
+	backupScheduleDays = []string{
+		"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday",
+	}
+	backupScheduleWindows = []string{
+		"W0", "W2", "W4", "W6", "W8", "W10", "W12", "W14", "W16", "W18", "W20", "W22",
+	}
+)
+
 const deviceDescription = "Device can be either a Disk or Volume identified by disk_id or " +
 	"volume_id. Only one type per slot allowed."
 
@@ -72,6 +81,34 @@ func resourceMetadata() *schema.Resource {
 				Description: "The base64-encoded user-defined data exposed to this instance " +
 					"through the Linode Metadata service. Refer to the base64encode(...) function " +
 					"for information on encoding content for this field.",
+			},
+		},
+	}
+}
+
+func resourceCloneSource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"linode_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the Linode to clone.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"disk_ids": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{Type: schema.TypeInt},
+				Description: "The IDs of the source Linode's Disks to clone. " +
+					"If unspecified, all Disks will be cloned.",
+				Optional: true,
+				ForceNew: true,
+			},
+			"config_ids": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{Type: schema.TypeInt},
+				Description: "The IDs of the source Linode's Configs to clone. If unspecified, all Configs " +
+					"will be cloned. Disks attached to the cloned Configs will also be cloned.",
+				Optional: true,
 				ForceNew: true,
 			},
 		},
@@ -213,9 +250,15 @@ var resourceSchema = map[string]*schema.Schema{
 			"while your Images start with private/. See /images for more information on the Images available " +
 			"for you to use.",
 		Optional:      true,
-		ForceNew:      true,
 		ConflictsWith: []string{"disk", "config", "backup_id"},
 	},
+	"rebuild_on_image_change": {
+		Type: schema.TypeBool,
+		Description: "If true, changes to the image of this Linode will rebuild the instance in place " +
+			"rather than replacing it, preserving the instance's ID and IP addresses.",
+		Optional: true,
+		Default:  false,
+	},
 	"backup_id": {
 		Type: schema.TypeInt,
 		Description: "A Backup ID from another Linode's available backups. Your User must have read_write " +
@@ -226,12 +269,24 @@ var resourceSchema = map[string]*schema.Schema{
 		ForceNew:      true,
 		ConflictsWith: []string{"image", "disk", "config"},
 	},
+	"clone_source": {
+		Type: schema.TypeList,
+		Elem: resourceCloneSource(),
+		Description: "The Linode to clone when creating this Linode. The Disks and Configs of the source " +
+			"Linode are cloned to this Linode, which is deployed to the configured region and type.",
+		Optional: true,
+		ForceNew: true,
+		MaxItems: 1,
+		ConflictsWith: []string{
+			"image", "backup_id", "disk", "config", "stackscript_id", "stackscript_data",
+			"authorized_keys", "authorized_users", "root_pass", "swap_size", "interface",
+		},
+	},
 	"stackscript_id": {
 		Type: schema.TypeInt,
 		Description: "The StackScript to deploy to the newly created Linode. If provided, 'image' must also be " +
 			"provided, and must be an Image that is compatible with this StackScript.",
 		Optional:      true,
-		ForceNew:      true,
 		RequiredWith:  []string{"image"},
 		ConflictsWith: []string{"disk", "config"},
 	},
@@ -241,7 +296,6 @@ var resourceSchema = map[string]*schema.Schema{
 			"being deployed to this Linode. Only accepted if 'stackscript_id' is given. The required values depend " +
 			"on the StackScript being deployed.",
 		Optional:      true,
-		ForceNew:      true,
 		Sensitive:     true,
 		RequiredWith:  []string{"image"},
 		ConflictsWith: []string{"disk", "config"},
@@ -348,7 +402,6 @@ var resourceSchema = map[string]*schema.Schema{
 		Description: "A list of SSH public keys to deploy for the root user on the newly created Linode. " +
 			"Only accepted if 'image' is provided.",
 		Optional:      true,
-		ForceNew:      true,
 		StateFunc:     sshKeyState,
 		RequiredWith:  []string{"image"},
 		ConflictsWith: []string{"disk", "config"},
@@ -360,7 +413,6 @@ var resourceSchema = map[string]*schema.Schema{
 			"be appended to the `root` user's `~/.ssh/authorized_keys` file automatically. Only accepted if " +
 			"'image' is provided.",
 		Optional:      true,
-		ForceNew:      true,
 		StateFunc:     sshKeyState,
 		RequiredWith:  []string{"image"},
 		ConflictsWith: []string{"disk", "config"},
@@ -370,7 +422,6 @@ var resourceSchema = map[string]*schema.Schema{
 		Description: "The password that will be initially assigned to the 'root' user account.",
 		Sensitive:   true,
 		Optional:    true,
-		ForceNew:    true,
 		StateFunc:   rootPasswordState,
 		ValidateFunc: validation.StringLenBetween(
 			helper.RootPassMinimumCharacters,
@@ -581,7 +632,9 @@ var resourceSchema = map[string]*schema.Schema{
 	"backups": {
 		Type:        schema.TypeList,
 		Description: "Information about this Linode's backups status.",
+		Optional:    true,
 		Computed:    true,
+		MaxItems:    1,
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"available": {
@@ -595,10 +648,12 @@ var resourceSchema = map[string]*schema.Schema{
 					Description: "If this Linode has the Backup service enabled.",
 				},
 				"schedule": {
-					Type:     schema.TypeList,
-					Computed: true,
+					Type:        schema.TypeList,
+					Description: "The schedule of this Linode's backups. This is only applied while backups are enabled.",
+					Optional:    true,
+					Computed:    true,
+					MaxItems:    1,
 					Elem: &schema.Resource{
-						// TODO(displague) these fields are updatable via PUT to instance
 						Schema: map[string]*schema.Schema{
 							"day": {
 								Type: schema.TypeString,
@@ -607,7 +662,9 @@ var resourceSchema = map[string]*schema.Schema{
 									"but backups taken on this day are preferred when selecting backups to retain for a " +
 									"longer period.  If not set manually, then when backups are initially enabled, this " +
 									"may come back as 'Scheduling' until the day is automatically selected.",
-								Computed: true,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.StringInSlice(backupScheduleDays, false),
 							},
 							"window": {
 								Type: schema.TypeString,
@@ -617,7 +674,9 @@ var resourceSchema = map[string]*schema.Schema{
 									"not choose a backup window, one will be selected for you automatically.  If not set " +
 									"manually, when backups are initially enabled this may come back as Scheduling until " +
 									"the window is automatically selected.",
-								Computed: true,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.StringInSlice(backupScheduleWindows, false),
 							},
 						},
 					},
@@ -28,6 +28,9 @@ type TemplateData struct {
 	AssignedGroup   string
 
 	DiskEncryption *linodego.InstanceDiskEncryption
+
+	BackupDay    string
+	BackupWindow string
 }
 
 func Basic(t *testing.T, label, pubKey, region string, rootPass string) string {
@@ -41,6 +44,28 @@ func Basic(t *testing.T, label, pubKey, region string, rootPass string) string {
 		})
 }
 
+func RebuildOnImageChange(t *testing.T, label, pubKey, image, region string, rootPass string) string {
+	return acceptance.ExecuteTemplate(t,
+		"instance_rebuild_on_image_change", TemplateData{
+			Label:    label,
+			PubKey:   pubKey,
+			Image:    image,
+			Region:   region,
+			RootPass: rootPass,
+		})
+}
+
+func Clone(t *testing.T, label, pubKey, region string, rootPass string) string {
+	return acceptance.ExecuteTemplate(t,
+		"instance_clone", TemplateData{
+			Label:    label,
+			PubKey:   pubKey,
+			Image:    acceptance.TestImageLatest,
+			Region:   region,
+			RootPass: rootPass,
+		})
+}
+
 func Updates(t *testing.T, label, region string) string {
 	return acceptance.ExecuteTemplate(t,
 		"instance_updates", TemplateData{
@@ -613,70 +638,6 @@ func DiskEncryption(
 		})
 }
 
-func DataBasic(t *testing.T, label, region string, rootPass string) string {
-	return acceptance.ExecuteTemplate(t,
-		"instance_data_basic", TemplateData{
-			Label:    label,
-			Image:    acceptance.TestImageLatest,
-			Region:   region,
-			RootPass: rootPass,
-		})
-}
-
-func DataWithPG(t *testing.T, label, region, assignedGroup string, groups []string) string {
-	return acceptance.ExecuteTemplate(t,
-		"instance_data_with_pg", TemplateData{
-			Label:           label,
-			Region:          region,
-			PlacementGroups: groups,
-			AssignedGroup:   assignedGroup,
-		})
-}
-
-func DataMultiple(t *testing.T, label, tag, region string, rootPass string) string {
-	return acceptance.ExecuteTemplate(t,
-		"instance_data_multiple", TemplateData{
-			Label:    label,
-			Tag:      tag,
-			Region:   region,
-			Image:    acceptance.TestImageLatest,
-			RootPass: rootPass,
-		})
-}
-
-func DataMultipleOrder(t *testing.T, label, tag, region string, rootPass string) string {
-	return acceptance.ExecuteTemplate(t,
-		"instance_data_multiple_order", TemplateData{
-			Label:    label,
-			Tag:      tag,
-			Image:    acceptance.TestImageLatest,
-			Region:   region,
-			RootPass: rootPass,
-		})
-}
-
-func DataMultipleRegex(t *testing.T, label, tag, region string, rootPass string) string {
-	return acceptance.ExecuteTemplate(t,
-		"instance_data_multiple_regex", TemplateData{
-			Label:    label,
-			Tag:      tag,
-			Image:    acceptance.TestImageLatest,
-			Region:   region,
-			RootPass: rootPass,
-		})
-}
-
-func DataClientFilter(t *testing.T, label, tag, region string, rootPass string) string {
-	return acceptance.ExecuteTemplate(t,
-		"instance_data_clientfilter", TemplateData{
-			Label:    label,
-			Tag:      tag,
-			Image:    acceptance.TestImageLatest,
-			Region:   region,
-			RootPass: rootPass,
-		})
-}
-
 func FirewallOnCreation(t *testing.T, label, region string, rootPass string) string {
 	return acceptance.ExecuteTemplate(t,
 		"instance_firewall_on_creation", TemplateData{
@@ -732,3 +693,15 @@ func WithPG(t *testing.T, label, region, assignedGroup string, groups []string)
 			AssignedGroup:   assignedGroup,
 		})
 }
+
+func BackupSchedule(t *testing.T, label, region, rootPass, day, window string) string {
+	return acceptance.ExecuteTemplate(t,
+		"instance_backup_schedule", TemplateData{
+			Label:        label,
+			Image:        acceptance.TestImageLatest,
+			Region:       region,
+			RootPass:     rootPass,
+			BackupDay:    day,
+			BackupWindow: window,
+		})
+}
@@ -0,0 +1,30 @@
+package instancebackuprestore
+
+import (
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+type ResourceModel struct {
+	ID             types.String      `tfsdk:"id"`
+	LinodeID       types.Int64       `tfsdk:"linode_id"`
+	BackupID       types.Int64       `tfsdk:"backup_id"`
+	TargetLinodeID types.Int64       `tfsdk:"target_linode_id"`
+	Overwrite      types.Bool        `tfsdk:"overwrite"`
+	Status         types.String      `tfsdk:"status"`
+	Created        timetypes.RFC3339 `tfsdk:"created"`
+	Timeouts       timeouts.Value    `tfsdk:"timeouts"`
+}
+
+func (data *ResourceModel) FlattenRestoreEvent(event *linodego.Event, preserveKnown bool) {
+	data.ID = helper.KeepOrUpdateString(data.ID, strconv.Itoa(event.ID), preserveKnown)
+	data.Status = helper.KeepOrUpdateString(data.Status, string(event.Status), preserveKnown)
+	data.Created = helper.KeepOrUpdateValue(
+		data.Created, timetypes.NewRFC3339TimePointerValue(event.Created), preserveKnown,
+	)
+}
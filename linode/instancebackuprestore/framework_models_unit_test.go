@@ -0,0 +1,44 @@
+//go:build unit
+
+package instancebackuprestore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlattenRestoreEvent(t *testing.T) {
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	event := &linodego.Event{
+		ID:      123,
+		Status:  linodego.EventFinished,
+		Action:  linodego.ActionBackupsRestore,
+		Created: &created,
+	}
+
+	data := ResourceModel{
+		ID:             types.StringUnknown(),
+		LinodeID:       types.Int64Value(456),
+		BackupID:       types.Int64Value(789),
+		TargetLinodeID: types.Int64Value(101),
+		Overwrite:      types.BoolValue(true),
+		Status:         types.StringUnknown(),
+		Created:        timetypes.NewRFC3339Unknown(),
+	}
+
+	data.FlattenRestoreEvent(event, true)
+
+	assert.Equal(t, types.StringValue("123"), data.ID)
+	assert.Equal(t, types.StringValue("finished"), data.Status)
+	assert.Equal(t, timetypes.NewRFC3339TimeValue(created), data.Created)
+	assert.Equal(t, types.Int64Value(456), data.LinodeID)
+	assert.Equal(t, types.Int64Value(789), data.BackupID)
+	assert.Equal(t, types.Int64Value(101), data.TargetLinodeID)
+	assert.Equal(t, types.BoolValue(true), data.Overwrite)
+}
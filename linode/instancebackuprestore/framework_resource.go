@@ -0,0 +1,225 @@
+package instancebackuprestore
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+const (
+	DefaultCreateTimeout = 30 * time.Minute
+)
+
+func NewResource() resource.Resource {
+	return &Resource{
+		BaseResource: helper.NewBaseResource(
+			helper.BaseResourceConfig{
+				Name:   "linode_instance_backup_restore",
+				IDType: types.StringType,
+				Schema: &frameworkResourceSchema,
+				TimeoutOpts: &timeouts.Opts{
+					Create: true,
+				},
+			},
+		),
+	}
+}
+
+type Resource struct {
+	helper.BaseResource
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	tflog.Debug(ctx, "Create "+r.Config.Name)
+
+	var plan ResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, plan)
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	timeoutSeconds := helper.FrameworkSafeFloat64ToInt(createTimeout.Seconds(), &resp.Diagnostics)
+	linodeID := helper.FrameworkSafeInt64ToInt(plan.LinodeID.ValueInt64(), &resp.Diagnostics)
+	backupID := helper.FrameworkSafeInt64ToInt(plan.BackupID.ValueInt64(), &resp.Diagnostics)
+	targetLinodeID := helper.FrameworkSafeInt64ToInt(plan.TargetLinodeID.ValueInt64(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	restoreOpts := linodego.RestoreInstanceOptions{
+		LinodeID:  targetLinodeID,
+		Overwrite: plan.Overwrite.ValueBool(),
+	}
+
+	p, err := r.Meta.EventWatcher.NewEventWaiter(
+		ctx, targetLinodeID, linodego.EntityLinode, linodego.ActionBackupsRestore,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Initialize Event Waiter", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "client.RestoreInstanceBackup(...)", map[string]any{
+		"options": restoreOpts,
+	})
+
+	if err := r.Meta.Client.RestoreInstanceBackup(ctx, linodeID, backupID, restoreOpts); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Restore Backup %d of Linode Instance %d", backupID, linodeID),
+			err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Waiting for backup restore to finish")
+
+	event, err := p.WaitForFinished(ctx, timeoutSeconds)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf(
+				"Failed to Wait for Backup %d to be Restored to Linode Instance %d",
+				backupID, targetLinodeID,
+			),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.FlattenRestoreEvent(event, true)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	tflog.Debug(ctx, "Read "+r.Config.Name)
+
+	var state ResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if helper.FrameworkAttemptRemoveResourceForEmptyID(ctx, state.ID, resp) {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, state)
+
+	targetLinodeID := helper.FrameworkSafeInt64ToInt(state.TargetLinodeID.ValueInt64(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The restore is a one-time operation, so the only thing that
+	// can change is whether the target Linode still exists.
+	if _, err := r.Meta.Client.GetInstance(ctx, targetLinodeID); err != nil {
+		if linodego.IsNotFound(err) {
+			resp.Diagnostics.AddWarning(
+				"Linode Instance Not Found",
+				fmt.Sprintf(
+					"Removing backup restore %s from state because Linode %d no longer exists",
+					state.ID.ValueString(), targetLinodeID,
+				),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Get Linode Instance %d", targetLinodeID),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	tflog.Debug(ctx, "Update "+r.Config.Name)
+
+	var plan, state ResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// All other attributes require replacement, so only the timeouts can be updated
+	state.Timeouts = plan.Timeouts
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	tflog.Debug(ctx, "Delete "+r.Config.Name)
+
+	var state ResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, state)
+
+	// A restore can't be undone, so it is only removed from state
+	tflog.Info(ctx, "Removing backup restore from state")
+}
+
+func (r *Resource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resp.Diagnostics.AddError(
+		"Import Not Supported",
+		fmt.Sprintf("%s represents a one-time restore operation and can't be imported.", r.Config.Name),
+	)
+}
+
+func populateLogAttributes(ctx context.Context, model ResourceModel) context.Context {
+	return helper.SetLogFieldBulk(ctx, map[string]any{
+		"linode_id":        model.LinodeID.ValueInt64(),
+		"backup_id":        model.BackupID.ValueInt64(),
+		"target_linode_id": model.TargetLinodeID.ValueInt64(),
+		"id":               model.ID.ValueString(),
+	})
+}
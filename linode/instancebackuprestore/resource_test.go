@@ -0,0 +1,90 @@
+//go:build integration || instancebackuprestore
+
+package instancebackuprestore_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	"github.com/linode/terraform-provider-linode/v2/linode/instancebackuprestore/tmpl"
+)
+
+var testRegion string
+
+func init() {
+	region, err := acceptance.GetRandomRegionWithCaps([]string{"Linodes"}, "core")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	testRegion = region
+}
+
+func TestAccResourceInstanceBackupRestore_basic(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_instance_backup_restore.foobar"
+	label := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.PreCheck(t) },
+		ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+		CheckDestroy:             acceptance.CheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: tmpl.Basic(t, label, testRegion),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resName, "id"),
+					resource.TestCheckResourceAttrPair(resName, "linode_id", "linode_instance.source", "id"),
+					resource.TestCheckResourceAttrPair(resName, "backup_id", "linode_instance_snapshot.foobar", "id"),
+					resource.TestCheckResourceAttrPair(resName, "target_linode_id", "linode_instance.target", "id"),
+					resource.TestCheckResourceAttr(resName, "overwrite", "true"),
+					resource.TestCheckResourceAttr(resName, "status", string(linodego.EventFinished)),
+					resource.TestCheckResourceAttrSet(resName, "created"),
+					checkTargetRestored("linode_instance.target", "linode_instance_snapshot.foobar"),
+				),
+			},
+		},
+	})
+}
+
+func checkTargetRestored(targetName, snapshotName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.TestAccProvider.Meta().(*helper.ProviderMeta).Client
+
+		target, ok := s.RootModule().Resources[targetName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", targetName)
+		}
+
+		snapshot, ok := s.RootModule().Resources[snapshotName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", snapshotName)
+		}
+
+		targetID, err := strconv.Atoi(target.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		disks, err := client.ListInstanceDisks(context.Background(), targetID, nil)
+		if err != nil {
+			return fmt.Errorf("failed to list disks of Linode %d: %s", targetID, err)
+		}
+
+		if expected := snapshot.Primary.Attributes["disks.#"]; strconv.Itoa(len(disks)) != expected {
+			return fmt.Errorf("expected Linode %d to have %s disks, got %d", targetID, expected, len(disks))
+		}
+
+		return nil
+	}
+}
@@ -97,9 +97,14 @@ func createDevice(deviceMap map[string]any) linodego.InstanceConfigDevice {
 }
 
 func expandDevicesBlock(devicesBlock any) *linodego.InstanceConfigDeviceMap {
-	var result linodego.InstanceConfigDeviceMap
+	return ExpandDeviceMap(devicesBlock.(*schema.Set).List())
+}
 
-	devices := devicesBlock.(*schema.Set).List()
+// ExpandDeviceMap expands the given `device` blocks, each a map with a
+// device_name and an optional disk_id or volume_id, into a device map.
+// It returns nil if no devices are given.
+func ExpandDeviceMap(devices []any) *linodego.InstanceConfigDeviceMap {
+	var result linodego.InstanceConfigDeviceMap
 
 	if len(devices) <= 0 {
 		return nil
@@ -177,8 +182,8 @@ func expandHelpers(helpersRaw any) *linodego.InstanceConfigHelpers {
 	}
 }
 
-func applyBootStatus(ctx context.Context, client *linodego.Client, linodeID int, configID int,
-	timeoutSeconds int, booted bool, reboot bool,
+func applyBootStatus(ctx context.Context, client *linodego.Client, eventWatcher *helper.EventWatcher,
+	linodeID int, configID int, timeoutSeconds int, booted bool, reboot bool,
 ) error {
 	instance, err := client.GetInstance(ctx, linodeID)
 	if err != nil {
@@ -204,7 +209,7 @@ func applyBootStatus(ctx context.Context, client *linodego.Client, linodeID int,
 				return fmt.Errorf("failed to wait for instance running: %s", err)
 			}
 
-			p, err := client.NewEventPoller(ctx, instance.ID, linodego.EntityLinode, linodego.ActionLinodeReboot)
+			p, err := eventWatcher.NewEventWaiter(ctx, instance.ID, linodego.EntityLinode, linodego.ActionLinodeReboot)
 			if err != nil {
 				return fmt.Errorf("failed to poll for events: %s", err)
 			}
@@ -242,9 +247,9 @@ func applyBootStatus(ctx context.Context, client *linodego.Client, linodeID int,
 		if !isBooted {
 			tflog.Info(ctx, "Instance is not booted; booting into config")
 
-			p, err := client.NewEventPoller(ctx, instance.ID, linodego.EntityLinode, linodego.ActionLinodeBoot)
+			p, err := eventWatcher.NewEventWaiter(ctx, instance.ID, linodego.EntityLinode, linodego.ActionLinodeBoot)
 			if err != nil {
-				return fmt.Errorf("failed to poll for events: %s", err)
+				return fmt.Errorf("failed to wait for events: %s", err)
 			}
 
 			if err := client.BootInstance(ctx, instance.ID, configID); err != nil {
@@ -278,7 +283,7 @@ func applyBootStatus(ctx context.Context, client *linodego.Client, linodeID int,
 			return fmt.Errorf("failed to wait for instance running: %s", err)
 		}
 
-		p, err := client.NewEventPoller(ctx, instance.ID, linodego.EntityLinode, linodego.ActionLinodeShutdown)
+		p, err := eventWatcher.NewEventWaiter(ctx, instance.ID, linodego.EntityLinode, linodego.ActionLinodeShutdown)
 		if err != nil {
 			return fmt.Errorf("failed to poll for events: %s", err)
 		}
@@ -0,0 +1,77 @@
+package instanceconfig
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	instancehelpers "github.com/linode/terraform-provider-linode/v2/linode/instance"
+)
+
+// updateKeys are the keys that update the config when changed.
+var updateKeys = []string{
+	"comments",
+	"device",
+	"devices",
+	"helpers",
+	"kernel",
+	"label",
+	"memory_limit",
+	"root_device",
+	"run_level",
+	"virt_mode",
+	"interface",
+}
+
+// PlanWarnings returns warnings for the changes of a planned update
+// that will shut down or reboot the Linode of the config.
+func PlanWarnings(ctx context.Context, d *helper.SDKv2PlannedChange, meta *helper.ProviderMeta) diag.Diagnostics {
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return nil
+	}
+
+	warnings := helper.NewInstancePowerWarnings(d.Get("linode_id").(int), meta.Config.SkipImplicitReboots)
+
+	// The prior state is booted if this config is booted and the Linode is running
+	isBootedConfig := d.Prior.Get("booted").(bool)
+
+	shouldUpdate := false
+	for _, key := range updateKeys {
+		if d.HasChange(key) {
+			shouldUpdate = true
+			break
+		}
+	}
+
+	oldInterfaces, newInterfaces := d.GetChange("interface")
+	powerOffRequired := isBootedConfig && d.HasChange("interface") &&
+		instancehelpers.InterfacesIncludeVPC(oldInterfaces.([]any), newInterfaces.([]any))
+
+	if powerOffRequired {
+		warnings.RequiredShutdown("the interfaces of config %d will be updated, including a VPC interface", id)
+	}
+
+	// The boot status is only applied if it is explicitly defined,
+	// and can't be compared before it is known
+	if d.GetRawConfig().GetAttr("booted").IsNull() || !d.NewValueKnown("booted") {
+		return warnings.SDKv2Diagnostics()
+	}
+
+	booted := d.Get("booted").(bool)
+
+	switch {
+	case isBootedConfig && !booted:
+		warnings.Shutdown("config %d will no longer be booted as booted is set to false", id)
+	case !isBootedConfig && booted:
+		warnings.Reboot(
+			"config %d will be booted as booted is set to true, which reboots the Linode "+
+				"if it is running another config", id,
+		)
+	case isBootedConfig && shouldUpdate && !powerOffRequired:
+		warnings.ImplicitReboot("config %d is booted and will be updated", id)
+	}
+
+	return warnings.SDKv2Diagnostics()
+}
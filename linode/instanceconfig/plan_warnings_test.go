@@ -0,0 +1,115 @@
+//go:build unit
+
+package instanceconfig
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanWarnings(t *testing.T) {
+	r := &schema.Resource{Schema: resourceSchema}
+
+	data := func(attrs map[string]string) *schema.ResourceData {
+		result := map[string]string{
+			"id":        "456",
+			"linode_id": "123",
+			"label":     "my-config",
+			"booted":    "true",
+		}
+
+		for k, v := range attrs {
+			result[k] = v
+		}
+
+		return r.Data(&terraform.InstanceState{ID: "456", Attributes: result})
+	}
+
+	testCases := map[string]struct {
+		prior               map[string]string
+		planned             map[string]string
+		booted              cty.Value
+		skipImplicitReboots bool
+		expectedSummaries   []string
+		expectedReason      string
+	}{
+		"unmanaged boot": {
+			planned: map[string]string{"label": "my-new-config"},
+			booted:  cty.NullVal(cty.Bool),
+		},
+		"booted config updated": {
+			planned:           map[string]string{"label": "my-new-config"},
+			booted:            cty.True,
+			expectedSummaries: []string{"Linode Instance 123 Will Be Rebooted"},
+			expectedReason:    "- config 456 is booted and will be updated",
+		},
+		"booted config updated with implicit reboots skipped": {
+			planned:             map[string]string{"label": "my-new-config"},
+			booted:              cty.True,
+			skipImplicitReboots: true,
+			expectedSummaries:   []string{"Linode Instance 123 Requires a Reboot"},
+			expectedReason:      "- config 456 is booted and will be updated",
+		},
+		"booted set to false": {
+			planned:           map[string]string{"booted": "false"},
+			booted:            cty.False,
+			expectedSummaries: []string{"Linode Instance 123 Will Be Shut Down"},
+			expectedReason:    "- config 456 will no longer be booted as booted is set to false",
+		},
+		"booted set to true": {
+			prior:             map[string]string{"booted": "false"},
+			booted:            cty.True,
+			expectedSummaries: []string{"Linode Instance 123 Will Be Rebooted"},
+			expectedReason: "- config 456 will be booted as booted is set to true, " +
+				"which reboots the Linode if it is running another config",
+		},
+		"unknown booted": {
+			planned: map[string]string{"label": "my-new-config", "booted": "false"},
+			booted:  cty.UnknownVal(cty.Bool),
+		},
+		"vpc interface added to booted config": {
+			planned: map[string]string{
+				"interface.#":         "1",
+				"interface.0.purpose": "vpc",
+			},
+			booted:            cty.NullVal(cty.Bool),
+			expectedSummaries: []string{"Linode Instance 123 Will Be Shut Down"},
+			expectedReason:    "- the interfaces of config 456 will be updated, including a VPC interface",
+		},
+		"vpc interface added to unbooted config": {
+			prior: map[string]string{"booted": "false"},
+			planned: map[string]string{
+				"booted":              "false",
+				"interface.#":         "1",
+				"interface.0.purpose": "vpc",
+			},
+			booted: cty.NullVal(cty.Bool),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			config := cty.ObjectVal(map[string]cty.Value{"booted": tc.booted})
+
+			d := helper.NewSDKv2PlannedChange(data(tc.prior), data(tc.planned), config, config)
+			meta := &helper.ProviderMeta{
+				Config: &helper.Config{SkipImplicitReboots: tc.skipImplicitReboots},
+			}
+
+			diags := PlanWarnings(context.Background(), d, meta)
+
+			require.Len(t, diags, len(tc.expectedSummaries))
+
+			for i, summary := range tc.expectedSummaries {
+				require.Equal(t, summary, diags[i].Summary)
+				require.Contains(t, diags[i].Detail, tc.expectedReason)
+			}
+		})
+	}
+}
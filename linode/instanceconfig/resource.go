@@ -177,7 +177,7 @@ func createResource(ctx context.Context, d *schema.ResourceData, meta any) diag.
 	d.SetId(strconv.Itoa(cfg.ID))
 
 	if !d.GetRawConfig().GetAttr("booted").IsNull() {
-		if err := applyBootStatus(ctx, &client, linodeID, cfg.ID, helper.GetDeadlineSeconds(ctx, d),
+		if err := applyBootStatus(ctx, &client, meta.(*helper.ProviderMeta).EventWatcher, linodeID, cfg.ID, helper.GetDeadlineSeconds(ctx, d),
 			d.Get("booted").(bool), false); err != nil {
 			return diag.Errorf("failed to update boot status: %s", err)
 		}
@@ -294,7 +294,12 @@ func updateResource(ctx context.Context, d *schema.ResourceData, meta any) diag.
 	if shouldUpdate {
 		if powerOffRequired {
 			if err := instancehelpers.ShutdownInstanceForVPCInterfaceUpdate(
-				ctx, &client, meta.(*helper.ProviderMeta).Config.SkipImplicitReboots, linodeID, helper.GetDeadlineSeconds(ctx, d),
+				ctx,
+				&client,
+				meta.(*helper.ProviderMeta).EventWatcher,
+				meta.(*helper.ProviderMeta).Config.SkipImplicitReboots,
+				linodeID,
+				helper.GetDeadlineSeconds(ctx, d),
 			); err != nil {
 				return diag.Errorf("failed to shutdown linode instance for VPC interface update: %s", err)
 			}
@@ -316,7 +321,7 @@ func updateResource(ctx context.Context, d *schema.ResourceData, meta any) diag.
 
 	shouldReboot := isBootedConfig && shouldUpdate && !powerOffRequired && !meta.(*helper.ProviderMeta).Config.SkipImplicitReboots
 	if managedBoot {
-		if err := applyBootStatus(ctx, &client, linodeID, id,
+		if err := applyBootStatus(ctx, &client, meta.(*helper.ProviderMeta).EventWatcher, linodeID, id,
 			helper.GetDeadlineSeconds(ctx, d),
 			d.Get("booted").(bool),
 			shouldReboot); err != nil {
@@ -351,7 +356,9 @@ func deleteResource(ctx context.Context, d *schema.ResourceData, meta any) diag.
 	} else if booted {
 		tflog.Info(ctx, "Shutting down instance for config deletion")
 
-		p, err := client.NewEventPoller(ctx, inst.ID, linodego.EntityLinode, linodego.ActionLinodeShutdown)
+		p, err := meta.(*helper.ProviderMeta).EventWatcher.NewEventWaiter(
+			ctx, inst.ID, linodego.EntityLinode, linodego.ActionLinodeShutdown,
+		)
 		if err != nil {
 			return diag.Errorf("failed to poll for events: %s", err)
 		}
@@ -10,6 +10,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/linode/linodego"
@@ -103,9 +105,9 @@ func (r *Resource) Create(
 		createOpts.RootPass = plan.RootPass.ValueString()
 	}
 
-	p, err := client.NewEventPoller(ctx, linodeID, linodego.EntityLinode, linodego.ActionDiskCreate)
+	p, err := r.Meta.EventWatcher.NewEventWaiter(ctx, linodeID, linodego.EntityLinode, linodego.ActionDiskCreate)
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to Poll for Events", err.Error())
+		resp.Diagnostics.AddError("Failed to Initialize Event Waiter", err.Error())
 		return
 	}
 
@@ -125,6 +127,9 @@ func (r *Resource) Create(
 
 	ctx = tflog.SetField(ctx, "disk_id", disk.ID)
 
+	// Only wait on the creation of this disk
+	p.SecondaryEntityID = disk.ID
+
 	_, err = p.WaitForFinished(ctx, timeoutSeconds)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -246,7 +251,7 @@ func (r *Resource) Update(
 
 	if !state.Size.Equal(plan.Size) {
 		if err := handleDiskResize(
-			ctx, client, linodeID, id, size, timeoutSeconds,
+			ctx, client, r.Meta.EventWatcher, linodeID, id, size, timeoutSeconds,
 		); err != nil {
 			resp.Diagnostics.AddError(
 				fmt.Sprintf("Failed to Resize Disk %d", id), err.Error(),
@@ -300,6 +305,170 @@ func (r *Resource) Update(
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
+func (r *Resource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if r.Meta == nil {
+		return
+	}
+
+	destroy := req.Plan.Raw.IsNull()
+
+	var plan ResourceModel
+
+	if !destroy {
+		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if plan.Size.IsUnknown() {
+			return
+		}
+	}
+
+	// New disks are only validated as only resizing or
+	// deleting an existing disk affects the instance
+	if req.State.Raw.IsNull() {
+		r.validateDiskSize(ctx, plan, 0, &resp.Diagnostics)
+		return
+	}
+
+	var state ResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !destroy {
+		if plan.Size.Equal(state.Size) {
+			return
+		}
+
+		id := helper.FrameworkSafeStringToInt(state.ID.ValueString(), &resp.Diagnostics)
+
+		r.validateDiskSize(ctx, plan, id, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	ctx = populateLogAttributes(ctx, state)
+
+	linodeID, id := getLinodeIDAndDiskID(state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configID, err := helper.GetCurrentBootedConfig(ctx, r.Meta.Client, linodeID)
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("failed to get current booted config of Linode %d", linodeID))
+		return
+	}
+
+	// The instance is only shut down if it is currently booted
+	if configID == 0 {
+		return
+	}
+
+	warnings := helper.NewInstancePowerWarnings(linodeID, r.Meta.Config.SkipImplicitReboots.ValueBool())
+
+	if destroy {
+		warnings.Shutdown("disk %d will be deleted", id)
+	} else {
+		warnings.Shutdown(
+			"disk %d will be resized from %d MB to %d MB",
+			id, state.Size.ValueInt64(), plan.Size.ValueInt64(),
+		)
+	}
+
+	resp.Diagnostics.Append(warnings.FrameworkDiagnostics()...)
+}
+
+// validateDiskSize checks whether the planned size of the disk with the given ID
+// fits into the remaining disk space of its Linode. Unknown values are skipped and
+// API errors are raised as warnings as the size is validated by the API when the
+// plan is applied.
+func (r *Resource) validateDiskSize(
+	ctx context.Context,
+	plan ResourceModel,
+	diskID int,
+	diags *diag.Diagnostics,
+) {
+	if plan.LinodeID.IsUnknown() || plan.LinodeID.IsNull() || diags.HasError() {
+		return
+	}
+
+	linodeID := helper.FrameworkSafeInt64ToInt(plan.LinodeID.ValueInt64(), diags)
+	size := helper.FrameworkSafeInt64ToInt(plan.Size.ValueInt64(), diags)
+	if diags.HasError() {
+		return
+	}
+
+	client := r.Meta.Client
+
+	inst, err := client.GetInstance(ctx, linodeID)
+	if err != nil {
+		addDiskSizeWarning(diags, fmt.Sprintf("Failed to get Linode %d: %s", linodeID, err))
+		return
+	}
+
+	typ, err := client.GetType(ctx, inst.Type)
+	if err != nil {
+		addDiskSizeWarning(diags, fmt.Sprintf("Failed to get Linode type %s: %s", inst.Type, err))
+		return
+	}
+
+	disks, err := client.ListInstanceDisks(ctx, linodeID, nil)
+	if err != nil {
+		addDiskSizeWarning(diags, fmt.Sprintf("Failed to list the disks of Linode %d: %s", linodeID, err))
+		return
+	}
+
+	diags.Append(validateDiskFitsType(disks, diskID, size, typ)...)
+}
+
+func addDiskSizeWarning(diags *diag.Diagnostics, reason string) {
+	diags.AddAttributeWarning(
+		path.Root("size"),
+		"Disk Size Not Validated",
+		reason+"\n\nThe size of this disk will only be validated against its Linode when the plan is applied.",
+	)
+}
+
+func validateDiskFitsType(
+	disks []linodego.InstanceDisk,
+	diskID, size int,
+	typ *linodego.LinodeType,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	used := 0
+
+	for _, disk := range disks {
+		if disk.ID != diskID {
+			used += disk.Size
+		}
+	}
+
+	if used+size > typ.Disk {
+		diags.AddAttributeError(
+			path.Root("size"),
+			"Insufficient Disk Capacity",
+			fmt.Sprintf(
+				"A disk of %d MB doesn't fit into the %d MB of unallocated disk space of the Linode. "+
+					"Its type %s has %d MB of disk space, of which %d MB are allocated to other disks.",
+				size, max(typ.Disk-used, 0), typ.ID, typ.Disk, used,
+			),
+		)
+	}
+
+	return diags
+}
+
 func (r *Resource) Delete(
 	ctx context.Context,
 	req resource.DeleteRequest,
@@ -373,7 +542,7 @@ func (r *Resource) Delete(
 			return
 		}
 		if err := instance.SafeShutdownInstance(
-			ctx, client, linodeID, timeoutSeconds,
+			ctx, client, r.Meta.EventWatcher, linodeID, timeoutSeconds,
 		); err != nil {
 			resp.Diagnostics.AddError(
 				fmt.Sprintf("Failed to Shutdown Linode Instance %d", linodeID),
@@ -383,7 +552,7 @@ func (r *Resource) Delete(
 	}
 
 	tflog.Info(ctx, "Deleting instance disk")
-	p, err := client.NewEventPollerWithSecondary(
+	p, err := r.Meta.EventWatcher.NewEventWaiterWithSecondary(
 		ctx,
 		linodeID,
 		linodego.EntityLinode,
@@ -391,7 +560,7 @@ func (r *Resource) Delete(
 		linodego.ActionDiskDelete,
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to Initialize Event Poller", err.Error())
+		resp.Diagnostics.AddError("Failed to Initialize Event Waiter", err.Error())
 		return
 	}
 
@@ -413,7 +582,7 @@ func (r *Resource) Delete(
 	// Reboot the instance if necessary
 	if shouldShutdown && !diskInConfig {
 		if err := instance.BootInstanceSync(
-			ctx, client, linodeID, configID, timeoutSeconds,
+			ctx, client, r.Meta.EventWatcher, linodeID, configID, timeoutSeconds,
 		); err != nil {
 			resp.Diagnostics.AddError(
 				fmt.Sprintf("Failed to Boot Instance %d", linodeID), err.Error(),
@@ -0,0 +1,72 @@
+//go:build unit
+
+package instancedisk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateDiskFitsType(t *testing.T) {
+	typ := &linodego.LinodeType{ID: "g6-nanode-1", Disk: 25600}
+
+	disks := []linodego.InstanceDisk{
+		{ID: 1, Size: 20480},
+		{ID: 2, Size: 512},
+	}
+
+	// A new disk fits into the unallocated space
+	assert.False(t, validateDiskFitsType(disks, 0, 4608, typ).HasError())
+
+	// A resized disk replaces its own size
+	assert.False(t, validateDiskFitsType(disks, 1, 25088, typ).HasError())
+
+	diags := validateDiskFitsType(disks, 0, 4609, typ)
+	require.Len(t, diags, 1)
+	assert.True(t, diags.HasError())
+	assert.Equal(t, "Insufficient Disk Capacity", diags[0].Summary())
+
+	attrDiag, ok := diags[0].(interface{ Path() path.Path })
+	require.True(t, ok)
+	assert.Equal(t, path.Root("size"), attrDiag.Path())
+
+	assert.True(t, validateDiskFitsType(disks, 1, 25089, typ).HasError())
+}
+
+func TestValidateDiskSize_LookupFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"errors": [{"reason": "Unauthorized"}]}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client := linodego.NewClient(http.DefaultClient)
+	client.SetBaseURL(server.URL)
+
+	r := &Resource{}
+	r.Meta = &helper.FrameworkProviderMeta{Client: &client}
+
+	var diags diag.Diagnostics
+
+	r.validateDiskSize(
+		context.Background(),
+		ResourceModel{LinodeID: types.Int64Value(123), Size: types.Int64Value(4096)},
+		0,
+		&diags,
+	)
+
+	require.Len(t, diags, 1)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "Disk Size Not Validated", diags[0].Summary())
+}
@@ -26,7 +26,10 @@ func getLinodeIDAndDiskID(data ResourceModel, diags *diag.Diagnostics) (int, int
 }
 
 func handleDiskResize(
-	ctx context.Context, client *linodego.Client, instID, diskID, newSize, timeoutSeconds int,
+	ctx context.Context,
+	client *linodego.Client,
+	eventWatcher *helper.EventWatcher,
+	instID, diskID, newSize, timeoutSeconds int,
 ) error {
 	configID, err := helper.GetCurrentBootedConfig(ctx, client, instID)
 	if err != nil {
@@ -38,7 +41,7 @@ func handleDiskResize(
 	if shouldShutdown {
 		tflog.Info(ctx, "Shutting down Instance for disk resize")
 
-		p, err := client.NewEventPoller(ctx, instID, linodego.EntityLinode, linodego.ActionLinodeShutdown)
+		p, err := eventWatcher.NewEventWaiter(ctx, instID, linodego.EntityLinode, linodego.ActionLinodeShutdown)
 		if err != nil {
 			return fmt.Errorf("failed to poll for events: %s", err)
 		}
@@ -68,14 +71,14 @@ func handleDiskResize(
 		"new_size": newSize,
 	})
 
-	p, err := client.NewEventPollerWithSecondary(
+	p, err := eventWatcher.NewEventWaiterWithSecondary(
 		ctx,
 		instID,
 		linodego.EntityLinode,
 		diskID,
 		linodego.ActionDiskResize)
 	if err != nil {
-		return fmt.Errorf("failed to poll for events: %s", err)
+		return fmt.Errorf("failed to wait for events: %s", err)
 	}
 
 	tflog.Debug(ctx, "client.ResizeInstanceDisk(...)", map[string]any{
@@ -105,9 +108,9 @@ func handleDiskResize(
 	if shouldShutdown {
 		tflog.Info(ctx, "Rebooting instance to previously booted config")
 
-		p, err := client.NewEventPoller(ctx, instID, linodego.EntityLinode, linodego.ActionLinodeBoot)
+		p, err := eventWatcher.NewEventWaiter(ctx, instID, linodego.EntityLinode, linodego.ActionLinodeBoot)
 		if err != nil {
-			return fmt.Errorf("failed to poll for events: %s", err)
+			return fmt.Errorf("failed to wait for events: %s", err)
 		}
 
 		tflog.Debug(ctx, "client.BootInstance(...)", map[string]any{
@@ -0,0 +1,162 @@
+package instanceinterface
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+type ResourceModel struct {
+	ID          types.String   `tfsdk:"id"`
+	LinodeID    types.Int64    `tfsdk:"linode_id"`
+	ConfigID    types.Int64    `tfsdk:"config_id"`
+	Purpose     types.String   `tfsdk:"purpose"`
+	Label       types.String   `tfsdk:"label"`
+	IPAMAddress types.String   `tfsdk:"ipam_address"`
+	SubnetID    types.Int64    `tfsdk:"subnet_id"`
+	VPCID       types.Int64    `tfsdk:"vpc_id"`
+	Primary     types.Bool     `tfsdk:"primary"`
+	Active      types.Bool     `tfsdk:"active"`
+	Position    types.Int64    `tfsdk:"position"`
+	IPRanges    types.List     `tfsdk:"ip_ranges"`
+	IPv4        types.Object   `tfsdk:"ipv4"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+type IPv4Model struct {
+	VPC     types.String `tfsdk:"vpc"`
+	NAT1To1 types.String `tfsdk:"nat_1_1"`
+}
+
+func (data *ResourceModel) FlattenInterface(
+	ctx context.Context,
+	iface *linodego.InstanceConfigInterface,
+	position int,
+	preserveKnown bool,
+) diag.Diagnostics {
+	data.ID = helper.KeepOrUpdateString(data.ID, strconv.Itoa(iface.ID), preserveKnown)
+	data.Purpose = helper.KeepOrUpdateString(data.Purpose, string(iface.Purpose), preserveKnown)
+	data.Label = helper.KeepOrUpdateValue(data.Label, helper.GetValueIfNotNull(iface.Label), preserveKnown)
+	data.IPAMAddress = helper.KeepOrUpdateValue(
+		data.IPAMAddress, helper.GetValueIfNotNull(iface.IPAMAddress), preserveKnown,
+	)
+	data.SubnetID = helper.KeepOrUpdateIntPointer(data.SubnetID, iface.SubnetID, preserveKnown)
+	data.VPCID = helper.KeepOrUpdateIntPointer(data.VPCID, iface.VPCID, preserveKnown)
+	data.Primary = helper.KeepOrUpdateBool(data.Primary, iface.Primary, preserveKnown)
+	data.Active = helper.KeepOrUpdateBool(data.Active, iface.Active, preserveKnown)
+
+	// The position is only tracked when it is managed by the user
+	if !data.Position.IsNull() {
+		data.Position = helper.KeepOrUpdateInt64(data.Position, int64(position), preserveKnown)
+	}
+
+	ipRanges, diags := types.ListValueFrom(ctx, types.StringType, iface.IPRanges)
+	if diags.HasError() {
+		return diags
+	}
+	data.IPRanges = helper.KeepOrUpdateValue(data.IPRanges, ipRanges, preserveKnown)
+
+	// The IPv4 configuration is always refreshed as parts of it
+	// may be assigned by the API, e.g. when nat_1_1 is `any`.
+	data.IPv4 = types.ObjectNull(ipv4ObjectType.AttrTypes)
+
+	if iface.IPv4 != nil {
+		ipv4, diags := types.ObjectValue(ipv4ObjectType.AttrTypes, map[string]attr.Value{
+			"vpc":     types.StringValue(iface.IPv4.VPC),
+			"nat_1_1": types.StringValue(helper.StringValue(iface.IPv4.NAT1To1)),
+		})
+		if diags.HasError() {
+			return diags
+		}
+
+		data.IPv4 = ipv4
+	}
+
+	return nil
+}
+
+// GetCreateOptions returns the options used to create the interface described
+// by this model. The IPv4 configuration is taken from the given config as its
+// computed values are unknown in the plan.
+func (data *ResourceModel) GetCreateOptions(
+	ctx context.Context,
+	config ResourceModel,
+	diags *diag.Diagnostics,
+) linodego.InstanceConfigInterfaceCreateOptions {
+	opts := linodego.InstanceConfigInterfaceCreateOptions{
+		Purpose:     linodego.ConfigInterfacePurpose(data.Purpose.ValueString()),
+		Label:       data.Label.ValueString(),
+		IPAMAddress: data.IPAMAddress.ValueString(),
+		Primary:     data.Primary.ValueBool(),
+	}
+
+	if !data.SubnetID.IsNull() {
+		subnetID := helper.FrameworkSafeInt64ToInt(data.SubnetID.ValueInt64(), diags)
+		opts.SubnetID = &subnetID
+	}
+
+	if !data.IPRanges.IsNull() && !data.IPRanges.IsUnknown() {
+		diags.Append(data.IPRanges.ElementsAs(ctx, &opts.IPRanges, false)...)
+	}
+
+	opts.IPv4 = config.getIPv4Options(ctx, diags)
+
+	return opts
+}
+
+// GetUpdateOptions returns the options used to update the interface described
+// by this model from the given state, and whether an update is required.
+func (data *ResourceModel) GetUpdateOptions(
+	ctx context.Context,
+	config, state ResourceModel,
+	diags *diag.Diagnostics,
+) (linodego.InstanceConfigInterfaceUpdateOptions, bool) {
+	// The primary flag is sent with every update so it isn't implicitly unset
+	opts := linodego.InstanceConfigInterfaceUpdateOptions{
+		Primary: data.Primary.ValueBool(),
+	}
+
+	shouldUpdate := !data.Primary.Equal(state.Primary)
+
+	if !data.IPRanges.IsUnknown() && !data.IPRanges.Equal(state.IPRanges) {
+		ipRanges := make([]string, 0)
+		diags.Append(data.IPRanges.ElementsAs(ctx, &ipRanges, false)...)
+
+		opts.IPRanges = &ipRanges
+		shouldUpdate = true
+	}
+
+	if !data.IPv4.Equal(state.IPv4) {
+		if opts.IPv4 = config.getIPv4Options(ctx, diags); opts.IPv4 != nil {
+			shouldUpdate = true
+		}
+	}
+
+	return opts, shouldUpdate
+}
+
+func (data *ResourceModel) getIPv4Options(ctx context.Context, diags *diag.Diagnostics) *linodego.VPCIPv4 {
+	if data.IPv4.IsNull() || data.IPv4.IsUnknown() {
+		return nil
+	}
+
+	var ipv4 IPv4Model
+	diags.Append(data.IPv4.As(ctx, &ipv4, basetypes.ObjectAsOptions{})...)
+
+	result := linodego.VPCIPv4{
+		VPC: ipv4.VPC.ValueString(),
+	}
+
+	if !ipv4.NAT1To1.IsNull() && !ipv4.NAT1To1.IsUnknown() {
+		result.NAT1To1 = ipv4.NAT1To1.ValueStringPointer()
+	}
+
+	return &result
+}
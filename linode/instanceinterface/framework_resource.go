@@ -0,0 +1,436 @@
+package instanceinterface
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+const (
+	DefaultCreateTimeout = 15 * time.Minute
+	DefaultUpdateTimeout = 15 * time.Minute
+	DefaultDeleteTimeout = 15 * time.Minute
+)
+
+func NewResource() resource.Resource {
+	return &Resource{
+		BaseResource: helper.NewBaseResource(
+			helper.BaseResourceConfig{
+				Name:   "linode_instance_interface",
+				IDType: types.StringType,
+				Schema: &frameworkResourceSchema,
+				TimeoutOpts: &timeouts.Opts{
+					Create: true,
+					Update: true,
+					Delete: true,
+				},
+			},
+		),
+	}
+}
+
+type Resource struct {
+	helper.BaseResource
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	tflog.Debug(ctx, "Create "+r.Config.Name)
+
+	var plan, config ResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, plan)
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	client := r.Meta.Client
+
+	timeoutSeconds := helper.FrameworkSafeFloat64ToInt(createTimeout.Seconds(), &resp.Diagnostics)
+	linodeID := helper.FrameworkSafeInt64ToInt(plan.LinodeID.ValueInt64(), &resp.Diagnostics)
+	configID := helper.FrameworkSafeInt64ToInt(plan.ConfigID.ValueInt64(), &resp.Diagnostics)
+	position := helper.FrameworkSafeInt64ToInt(plan.Position.ValueInt64(), &resp.Diagnostics)
+
+	createOpts := plan.GetCreateOptions(ctx, config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if createOpts.Primary {
+		if err := checkPrimaryAvailable(ctx, client, linodeID, configID, 0); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("primary"), "Failed to Set Primary Interface", err.Error())
+			return
+		}
+	}
+
+	var iface *linodego.InstanceConfigInterface
+
+	resp.Diagnostics.Append(applyInterfaceChange(
+		ctx, r.Meta, linodeID, configID, createOpts.Purpose, timeoutSeconds,
+		func() error {
+			tflog.Debug(ctx, "client.AppendInstanceConfigInterface(...)", map[string]any{
+				"options": createOpts,
+			})
+
+			var err error
+
+			iface, err = client.AppendInstanceConfigInterface(ctx, linodeID, configID, createOpts)
+			if err != nil {
+				return err
+			}
+
+			if plan.Position.IsNull() {
+				return nil
+			}
+
+			return reorderInterface(ctx, client, linodeID, configID, iface.ID, position)
+		},
+	)...)
+
+	if iface == nil {
+		return
+	}
+
+	// Add resource to TF states earlier to prevent
+	// dangling resources (resources created but not managed by TF)
+	resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(strconv.Itoa(iface.ID)))
+	resp.State.SetAttribute(ctx, path.Root("linode_id"), plan.LinodeID)
+	resp.State.SetAttribute(ctx, path.Root("config_id"), plan.ConfigID)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	iface, position, err := getInterface(ctx, client, linodeID, configID, iface.ID)
+	if err != nil || iface == nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Get Interface of Config %d", configID),
+			fmt.Sprintf("interface not found: %v", err),
+		)
+		return
+	}
+
+	// IDs should always be overridden during creation (see #1085)
+	// TODO: Remove when Crossplane empty string ID issue is resolved
+	plan.ID = types.StringValue(strconv.Itoa(iface.ID))
+
+	resp.Diagnostics.Append(plan.FlattenInterface(ctx, iface, position, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	tflog.Debug(ctx, "Read "+r.Config.Name)
+
+	var state ResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if helper.FrameworkAttemptRemoveResourceForEmptyID(ctx, state.ID, resp) {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, state)
+
+	linodeID, configID, id := getIDs(state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	iface, position, err := getInterface(ctx, r.Meta.Client, linodeID, configID, id)
+	if err != nil && !linodego.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Get Interface %d of Config %d", id, configID),
+			err.Error(),
+		)
+		return
+	}
+
+	if iface == nil {
+		resp.Diagnostics.AddWarning(
+			"Interface Not Found",
+			fmt.Sprintf(
+				"Removing interface %d of config %d of Linode %d from state because it no longer exists",
+				id, configID, linodeID,
+			),
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(state.FlattenInterface(ctx, iface, position, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	tflog.Debug(ctx, "Update "+r.Config.Name)
+
+	var plan, state, config ResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, state)
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, DefaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	client := r.Meta.Client
+
+	timeoutSeconds := helper.FrameworkSafeFloat64ToInt(updateTimeout.Seconds(), &resp.Diagnostics)
+	linodeID, configID, id := getIDs(state, &resp.Diagnostics)
+	position := helper.FrameworkSafeInt64ToInt(plan.Position.ValueInt64(), &resp.Diagnostics)
+
+	updateOpts, shouldUpdate := plan.GetUpdateOptions(ctx, config, state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	shouldReorder := !plan.Position.IsNull() && !plan.Position.Equal(state.Position)
+
+	if updateOpts.Primary && !state.Primary.ValueBool() {
+		if err := checkPrimaryAvailable(ctx, client, linodeID, configID, id); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("primary"), "Failed to Set Primary Interface", err.Error())
+			return
+		}
+	}
+
+	if shouldUpdate || shouldReorder {
+		resp.Diagnostics.Append(applyInterfaceChange(
+			ctx, r.Meta, linodeID, configID,
+			linodego.ConfigInterfacePurpose(state.Purpose.ValueString()), timeoutSeconds,
+			func() error {
+				if shouldUpdate {
+					tflog.Debug(ctx, "client.UpdateInstanceConfigInterface(...)", map[string]any{
+						"options": updateOpts,
+					})
+
+					if _, err := client.UpdateInstanceConfigInterface(
+						ctx, linodeID, configID, id, updateOpts,
+					); err != nil {
+						return err
+					}
+				}
+
+				if shouldReorder {
+					return reorderInterface(ctx, client, linodeID, configID, id, position)
+				}
+
+				return nil
+			},
+		)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	iface, position, err := getInterface(ctx, client, linodeID, configID, id)
+	if err != nil || iface == nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Get Interface %d of Config %d", id, configID),
+			fmt.Sprintf("interface not found: %v", err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(plan.FlattenInterface(ctx, iface, position, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Workaround for Crossplane issue where ID is not
+	// properly populated in plan
+	// See TPT-2865 for more details
+	if plan.ID.ValueString() == "" {
+		plan.ID = state.ID
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	tflog.Debug(ctx, "Delete "+r.Config.Name)
+
+	var state ResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, state)
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	client := r.Meta.Client
+
+	timeoutSeconds := helper.FrameworkSafeFloat64ToInt(deleteTimeout.Seconds(), &resp.Diagnostics)
+	linodeID, configID, id := getIDs(state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(applyInterfaceChange(
+		ctx, r.Meta, linodeID, configID,
+		linodego.ConfigInterfacePurpose(state.Purpose.ValueString()), timeoutSeconds,
+		func() error {
+			tflog.Debug(ctx, "client.DeleteInstanceConfigInterface(...)")
+
+			if err := client.DeleteInstanceConfigInterface(ctx, linodeID, configID, id); err != nil {
+				if linodego.IsNotFound(err) {
+					return nil
+				}
+
+				return err
+			}
+
+			return nil
+		},
+	)...)
+}
+
+func (r *Resource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	tflog.Debug(ctx, "Import "+r.Config.Name)
+
+	helper.ImportStateWithMultipleIDs(
+		ctx, req, resp,
+		[]helper.ImportableID{
+			{
+				Name:          "linode_id",
+				TypeConverter: helper.IDTypeConverterInt64,
+			},
+			{
+				Name:          "config_id",
+				TypeConverter: helper.IDTypeConverterInt64,
+			},
+			{
+				Name:          "id",
+				TypeConverter: helper.IDTypeConverterString,
+			},
+		},
+	)
+}
+
+func (r *Resource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var data ResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Purpose.IsUnknown() {
+		return
+	}
+
+	purpose := linodego.ConfigInterfacePurpose(data.Purpose.ValueString())
+
+	checkAttribute := func(name string, value interface{ IsNull() bool }, allowed, required bool) {
+		switch {
+		case allowed && required && value.IsNull():
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Missing Required Attribute",
+				fmt.Sprintf("%s is required for %s interfaces.", name, purpose),
+			)
+		case !allowed && !value.IsNull():
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Invalid Attribute Combination",
+				fmt.Sprintf("%s is not allowed for %s interfaces.", name, purpose),
+			)
+		}
+	}
+
+	isVLAN := purpose == linodego.InterfacePurposeVLAN
+	isVPC := purpose == linodego.InterfacePurposeVPC
+
+	checkAttribute("label", data.Label, isVLAN, true)
+	checkAttribute("ipam_address", data.IPAMAddress, isVLAN, false)
+	checkAttribute("subnet_id", data.SubnetID, isVPC, true)
+	checkAttribute("ip_ranges", data.IPRanges, isVPC, false)
+	checkAttribute("ipv4", data.IPv4, isVPC, false)
+
+	if isVLAN && data.Primary.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("primary"),
+			"Invalid Attribute Value",
+			"VLAN interfaces can not be the primary interface.",
+		)
+	}
+}
+
+func populateLogAttributes(ctx context.Context, model ResourceModel) context.Context {
+	return helper.SetLogFieldBulk(ctx, map[string]any{
+		"linode_id":    model.LinodeID.ValueInt64(),
+		"config_id":    model.ConfigID.ValueInt64(),
+		"interface_id": model.ID.ValueString(),
+	})
+}
@@ -0,0 +1,162 @@
+package instanceinterface
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+)
+
+var ipv4ObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"vpc":     types.StringType,
+		"nat_1_1": types.StringType,
+	},
+}
+
+var frameworkResourceSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The ID of the interface.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"linode_id": schema.Int64Attribute{
+			Description: "The ID of the Linode to assign this interface to.",
+			Required:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"config_id": schema.Int64Attribute{
+			Description: "The ID of the Linode Config to assign this interface to.",
+			Required:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"purpose": schema.StringAttribute{
+			Description: "The type of interface.",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				stringvalidator.OneOf(
+					string(linodego.InterfacePurposePublic),
+					string(linodego.InterfacePurposeVLAN),
+					string(linodego.InterfacePurposeVPC),
+				),
+			},
+		},
+		"label": schema.StringAttribute{
+			Description: "The name of the VLAN. Required for and only allowed for VLAN interfaces.",
+			Optional:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"ipam_address": schema.StringAttribute{
+			Description: "This Network Interface's private IP address in Classless Inter-Domain Routing " +
+				"(CIDR) notation. Only allowed for VLAN interfaces.",
+			Optional: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"subnet_id": schema.Int64Attribute{
+			Description: "The ID of the subnet which the VPC interface is connected to. " +
+				"Required for and only allowed for VPC interfaces.",
+			Optional: true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"vpc_id": schema.Int64Attribute{
+			Description: "The ID of VPC of the subnet which the VPC interface is connected to.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"primary": schema.BoolAttribute{
+			Description: "Whether the interface is the primary interface that should have the default " +
+				"route for this Linode. Unsetting this on an existing interface recreates it.",
+			Optional: true,
+			Computed: true,
+			Default:  booldefault.StaticBool(false),
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.RequiresReplaceIf(
+					requiresReplaceIfUnsetPrimary,
+					"Unsetting the primary flag of an interface requires it to be recreated.",
+					"Unsetting the primary flag of an interface requires it to be recreated.",
+				),
+			},
+		},
+		"active": schema.BoolAttribute{
+			Description: "Whether this interface is currently booted and active.",
+			Computed:    true,
+		},
+		"position": schema.Int64Attribute{
+			Description: "The zero-based position of this interface within the Config's interfaces. " +
+				"If not set, the interface is appended to the end of the list.",
+			Optional: true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(0),
+			},
+		},
+		"ip_ranges": schema.ListAttribute{
+			Description: "List of VPC IPs or IP ranges inside the VPC subnet. Only allowed for VPC interfaces.",
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.UseStateForUnknown(),
+			},
+			Validators: []validator.List{
+				listvalidator.UniqueValues(),
+			},
+		},
+		"ipv4": schema.SingleNestedAttribute{
+			Description: "The IPv4 configuration of the VPC interface. Only allowed for VPC interfaces.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.Object{
+				objectplanmodifier.UseStateForUnknown(),
+			},
+			Attributes: map[string]schema.Attribute{
+				"vpc": schema.StringAttribute{
+					Description: "The IP from the VPC subnet to use for this interface.",
+					Optional:    true,
+					Computed:    true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+				"nat_1_1": schema.StringAttribute{
+					Description: "The public IP that will be used for the one-to-one NAT purpose. " +
+						"If set to `any`, a public IP will be assigned automatically.",
+					Optional: true,
+					Computed: true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+						natAnyPlanModifier{},
+					},
+				},
+			},
+		},
+	},
+}
@@ -0,0 +1,247 @@
+package instanceinterface
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	"github.com/linode/terraform-provider-linode/v2/linode/instance"
+)
+
+// natAnyPlanModifier suppresses the diff between a nat_1_1 value of `any`
+// and the address assigned by the API, and otherwise marks the value as
+// unknown until the address has been assigned.
+type natAnyPlanModifier struct{}
+
+func (m natAnyPlanModifier) Description(ctx context.Context) string {
+	return "Uses the assigned address when the value is `any`."
+}
+
+func (m natAnyPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m natAnyPlanModifier) PlanModifyString(
+	ctx context.Context,
+	req planmodifier.StringRequest,
+	resp *planmodifier.StringResponse,
+) {
+	if req.ConfigValue.ValueString() != "any" {
+		return
+	}
+
+	if req.StateValue.IsNull() || req.StateValue.ValueString() == "" {
+		resp.PlanValue = types.StringUnknown()
+		return
+	}
+
+	resp.PlanValue = req.StateValue
+}
+
+// requiresReplaceIfUnsetPrimary requires the interface to be replaced when its
+// primary flag is unset, as the flag can only be set on an existing interface.
+func requiresReplaceIfUnsetPrimary(
+	ctx context.Context,
+	req planmodifier.BoolRequest,
+	resp *boolplanmodifier.RequiresReplaceIfFuncResponse,
+) {
+	resp.RequiresReplace = req.StateValue.ValueBool() && !req.PlanValue.IsUnknown() && !req.PlanValue.ValueBool()
+}
+
+func getIDs(data ResourceModel, diags *diag.Diagnostics) (int, int, int) {
+	linodeID := helper.FrameworkSafeInt64ToInt(data.LinodeID.ValueInt64(), diags)
+	configID := helper.FrameworkSafeInt64ToInt(data.ConfigID.ValueInt64(), diags)
+	id := helper.FrameworkSafeStringToInt(data.ID.ValueString(), diags)
+	return linodeID, configID, id
+}
+
+// linodeLocks serializes interface changes on the same Linode, as each change
+// may shut down, boot or reboot the Linode.
+var linodeLocks = helper.NewMutexKV()
+
+// applyInterfaceChange applies the given change to the interfaces of a config. If the
+// config is booted, the Linode is shut down beforehand when a VPC interface is involved,
+// otherwise it is rebooted afterwards unless implicit reboots are skipped.
+func applyInterfaceChange(
+	ctx context.Context,
+	meta *helper.FrameworkProviderMeta,
+	linodeID, configID int,
+	purpose linodego.ConfigInterfacePurpose,
+	timeoutSeconds int,
+	change func() error,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client := meta.Client
+	skipImplicitReboots := meta.Config.SkipImplicitReboots.ValueBool()
+
+	// Hold the lock for the whole shutdown, change and boot or reboot sequence
+	// so that concurrent changes don't boot the Linode in between.
+	lockKey := strconv.Itoa(linodeID)
+	linodeLocks.Lock(lockKey)
+	defer linodeLocks.Unlock(lockKey)
+
+	inst, err := client.GetInstance(ctx, linodeID)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Failed to Get Linode Instance %d", linodeID), err.Error())
+		return diags
+	}
+
+	bootedConfigID, err := helper.GetCurrentBootedConfig(ctx, client, linodeID)
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("failed to get current booted config of Linode %d", linodeID))
+	}
+
+	isBootedConfig := bootedConfigID == configID && inst.Status == linodego.InstanceRunning
+
+	config, err := client.GetInstanceConfig(ctx, linodeID, configID)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Failed to Get Config %d", configID), err.Error())
+		return diags
+	}
+
+	powerOffRequired := isBootedConfig && instance.VPCInterfaceIncluded(
+		config.Interfaces,
+		[]linodego.InstanceConfigInterfaceCreateOptions{{Purpose: purpose}},
+	)
+
+	if powerOffRequired {
+		if err := instance.ShutdownInstanceForVPCInterfaceUpdate(
+			ctx, client, meta.EventWatcher, skipImplicitReboots, linodeID, timeoutSeconds,
+		); err != nil {
+			diags.AddError("Failed to Shutdown Linode Instance for VPC Interface Update", err.Error())
+			return diags
+		}
+	}
+
+	if err := change(); err != nil {
+		diags.AddError(fmt.Sprintf("Failed to Update Interfaces of Config %d", configID), err.Error())
+		return diags
+	}
+
+	switch {
+	case powerOffRequired:
+		tflog.Debug(ctx, "Booting instance after VPC interface change applied")
+
+		if err := instance.BootInstanceSync(
+			ctx, client, meta.EventWatcher, linodeID, configID, timeoutSeconds,
+		); err != nil {
+			diags.AddError("Failed to Boot Instance After VPC Interface Change Applied", err.Error())
+		}
+	case isBootedConfig && !skipImplicitReboots:
+		diags.Append(helper.FrameworkRebootInstance(ctx, linodeID, client, meta.EventWatcher, configID)...)
+	case isBootedConfig:
+		diags.AddWarning(
+			"Linode Instance Reboot Required",
+			fmt.Sprintf(
+				"The interfaces of config %d will not take effect until Linode %d is rebooted "+
+					"as 'skip_implicit_reboots' is enabled.",
+				configID, linodeID,
+			),
+		)
+	}
+
+	return diags
+}
+
+// checkPrimaryAvailable returns an error if an interface other than
+// the given one is already the primary interface of the config.
+func checkPrimaryAvailable(
+	ctx context.Context,
+	client *linodego.Client,
+	linodeID, configID, id int,
+) error {
+	interfaces, err := client.ListInstanceConfigInterfaces(ctx, linodeID, configID)
+	if err != nil {
+		return fmt.Errorf("failed to list interfaces of config %d: %w", configID, err)
+	}
+
+	for _, iface := range interfaces {
+		if iface.ID != id && iface.Primary {
+			return fmt.Errorf(
+				"interface %d is already the primary interface of config %d; "+
+					"it must be unset before another interface can be made primary",
+				iface.ID, configID,
+			)
+		}
+	}
+
+	return nil
+}
+
+// reorderInterface moves the given interface to the given position
+// among the interfaces of the config.
+func reorderInterface(
+	ctx context.Context,
+	client *linodego.Client,
+	linodeID, configID, id, position int,
+) error {
+	interfaces, err := client.ListInstanceConfigInterfaces(ctx, linodeID, configID)
+	if err != nil {
+		return fmt.Errorf("failed to list interfaces of config %d: %w", configID, err)
+	}
+
+	currentIDs := make([]int, 0, len(interfaces))
+	ids := make([]int, 0, len(interfaces))
+
+	for _, iface := range interfaces {
+		currentIDs = append(currentIDs, iface.ID)
+
+		if iface.ID != id {
+			ids = append(ids, iface.ID)
+		}
+	}
+
+	if position > len(ids) {
+		return fmt.Errorf(
+			"position %d is out of range for config %d with %d interfaces", position, configID, len(ids)+1,
+		)
+	}
+
+	ids = slices.Insert(ids, position, id)
+
+	if slices.Equal(ids, currentIDs) {
+		return nil
+	}
+
+	tflog.Debug(ctx, "client.ReorderInstanceConfigInterfaces(...)", map[string]any{
+		"ids": ids,
+	})
+
+	if err := client.ReorderInstanceConfigInterfaces(
+		ctx, linodeID, configID, linodego.InstanceConfigInterfacesReorderOptions{IDs: ids},
+	); err != nil {
+		return fmt.Errorf("failed to reorder interfaces of config %d: %w", configID, err)
+	}
+
+	return nil
+}
+
+// getInterface returns the given interface along with its position among the
+// interfaces of the config, or nil if the interface does not exist.
+func getInterface(
+	ctx context.Context,
+	client *linodego.Client,
+	linodeID, configID, id int,
+) (*linodego.InstanceConfigInterface, int, error) {
+	interfaces, err := client.ListInstanceConfigInterfaces(ctx, linodeID, configID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for i, iface := range interfaces {
+		if iface.ID == id {
+			return &interfaces[i], i, nil
+		}
+	}
+
+	return nil, 0, nil
+}
@@ -0,0 +1,210 @@
+//go:build integration || instanceinterface
+
+package instanceinterface_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	"github.com/linode/terraform-provider-linode/v2/linode/instanceinterface/tmpl"
+)
+
+var testRegion string
+
+func init() {
+	region, err := acceptance.GetRandomRegionWithCaps([]string{"vlans", "VPCs"}, "core")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	testRegion = region
+}
+
+func TestAccResourceInstanceInterface_basic(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_instance_interface.foobar"
+	publicResName := "linode_instance_interface.public"
+	label := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.PreCheck(t) },
+		ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+		CheckDestroy:             checkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: tmpl.Basic(t, label, testRegion),
+				Check: resource.ComposeTestCheckFunc(
+					checkExists(publicResName, nil),
+					resource.TestCheckResourceAttr(publicResName, "purpose", "public"),
+					resource.TestCheckResourceAttr(publicResName, "primary", "true"),
+
+					checkExists(resName, nil),
+					resource.TestCheckResourceAttr(resName, "purpose", "vlan"),
+					resource.TestCheckResourceAttr(resName, "label", label),
+					resource.TestCheckResourceAttr(resName, "ipam_address", "10.0.0.1/24"),
+					resource.TestCheckResourceAttr(resName, "primary", "false"),
+					resource.TestCheckResourceAttrSet(resName, "linode_id"),
+					resource.TestCheckResourceAttrSet(resName, "config_id"),
+				),
+			},
+			{
+				ResourceName:      resName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: resourceImportStateID(resName),
+			},
+		},
+	})
+}
+
+func TestAccResourceInstanceInterface_vpc(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_instance_interface.foobar"
+	publicResName := "linode_instance_interface.public"
+	label := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.PreCheck(t) },
+		ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+		CheckDestroy:             checkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: tmpl.VPC(t, label, testRegion),
+				Check: resource.ComposeTestCheckFunc(
+					checkExists(resName, nil),
+					resource.TestCheckResourceAttr(resName, "purpose", "vpc"),
+					resource.TestCheckResourceAttr(resName, "primary", "true"),
+					resource.TestCheckResourceAttr(resName, "ipv4.vpc", "10.0.4.250"),
+					resource.TestCheckResourceAttrSet(resName, "ipv4.nat_1_1"),
+					resource.TestCheckResourceAttrPair(resName, "subnet_id", "linode_vpc_subnet.foobar", "id"),
+					resource.TestCheckResourceAttrPair(resName, "vpc_id", "linode_vpc.foobar", "id"),
+				),
+			},
+			{
+				Config: tmpl.VPCUpdated(t, label, testRegion),
+				Check: resource.ComposeTestCheckFunc(
+					checkExists(publicResName, nil),
+					resource.TestCheckResourceAttr(publicResName, "purpose", "public"),
+					resource.TestCheckResourceAttr(publicResName, "position", "0"),
+
+					checkExists(resName, nil),
+					resource.TestCheckResourceAttr(resName, "purpose", "vpc"),
+					resource.TestCheckResourceAttr(resName, "primary", "true"),
+					resource.TestCheckResourceAttr(resName, "ipv4.vpc", "10.0.4.251"),
+					resource.TestCheckResourceAttrSet(resName, "ipv4.nat_1_1"),
+					resource.TestCheckResourceAttr(resName, "ip_ranges.#", "1"),
+					resource.TestCheckResourceAttr(resName, "ip_ranges.0", "10.0.4.101/32"),
+				),
+			},
+			{
+				ResourceName:      resName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: resourceImportStateID(resName),
+			},
+		},
+	})
+}
+
+func checkExists(name string, iface *linodego.InstanceConfigInterface) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.TestAccProvider.Meta().(*helper.ProviderMeta).Client
+
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		linodeID, configID, id, err := getResourceIDs(rs)
+		if err != nil {
+			return fmt.Errorf("failed to get interface info: %v", err)
+		}
+
+		found, err := client.GetInstanceConfigInterface(context.Background(), linodeID, configID, id)
+		if err != nil {
+			return fmt.Errorf("error retrieving state of interface %d: %s", id, err)
+		}
+
+		if iface != nil {
+			*iface = *found
+		}
+
+		return nil
+	}
+}
+
+func checkDestroy(s *terraform.State) error {
+	client := acceptance.TestAccProvider.Meta().(*helper.ProviderMeta).Client
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "linode_instance_interface" {
+			continue
+		}
+
+		linodeID, configID, id, err := getResourceIDs(rs)
+		if err != nil {
+			return fmt.Errorf("failed to get interface info: %v", err)
+		}
+
+		_, err = client.GetInstanceConfigInterface(context.Background(), linodeID, configID, id)
+
+		if err == nil {
+			return fmt.Errorf("interface with id %d still exists", id)
+		}
+
+		if apiErr, ok := err.(*linodego.Error); ok && apiErr.Code != 404 {
+			return fmt.Errorf("error requesting interface with id %d", id)
+		}
+	}
+
+	return nil
+}
+
+func getResourceIDs(rs *terraform.ResourceState) (int, int, int, error) {
+	id, err := strconv.Atoi(rs.Primary.ID)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	linodeID, err := strconv.Atoi(rs.Primary.Attributes["linode_id"])
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	configID, err := strconv.Atoi(rs.Primary.Attributes["config_id"])
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	return linodeID, configID, id, nil
+}
+
+func resourceImportStateID(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("Error finding %s", name)
+		}
+
+		linodeID, configID, id, err := getResourceIDs(rs)
+		if err != nil {
+			return "", fmt.Errorf("failed to get interface info: %v", err)
+		}
+
+		return fmt.Sprintf("%d,%d,%d", linodeID, configID, id), nil
+	}
+}
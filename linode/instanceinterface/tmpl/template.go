@@ -0,0 +1,36 @@
+package tmpl
+
+import (
+	"testing"
+
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+)
+
+type TemplateData struct {
+	Label  string
+	Region string
+}
+
+func Basic(t *testing.T, label, region string) string {
+	return acceptance.ExecuteTemplate(t,
+		"instance_interface_basic", TemplateData{
+			Label:  label,
+			Region: region,
+		})
+}
+
+func VPC(t *testing.T, label, region string) string {
+	return acceptance.ExecuteTemplate(t,
+		"instance_interface_vpc", TemplateData{
+			Label:  label,
+			Region: region,
+		})
+}
+
+func VPCUpdated(t *testing.T, label, region string) string {
+	return acceptance.ExecuteTemplate(t,
+		"instance_interface_vpc_updated", TemplateData{
+			Label:  label,
+			Region: region,
+		})
+}
@@ -117,7 +117,7 @@ func (r *Resource) Create(
 		if instance.Status == linodego.InstanceRunning {
 			tflog.Info(ctx, "detected instance in running status, rebooting instance")
 			ctx, cancel := context.WithTimeout(ctx, time.Duration(600)*time.Second)
-			resp.Diagnostics.Append(helper.FrameworkRebootInstance(ctx, linodeID, client, 0)...)
+			resp.Diagnostics.Append(helper.FrameworkRebootInstance(ctx, linodeID, client, r.Meta.EventWatcher, 0)...)
 			cancel()
 		} else {
 			tflog.Info(ctx, "Detected instance not in running status, can't perform a reboot.")
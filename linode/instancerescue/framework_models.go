@@ -0,0 +1,55 @@
+package instancerescue
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/instanceconfig"
+)
+
+type ResourceModel struct {
+	ID           types.String   `tfsdk:"id"`
+	LinodeID     types.Int64    `tfsdk:"linode_id"`
+	BootConfigID types.Int64    `tfsdk:"boot_config_id"`
+	Devices      []DeviceModel  `tfsdk:"device"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+type DeviceModel struct {
+	DeviceName types.String `tfsdk:"device_name"`
+	DiskID     types.Int64  `tfsdk:"disk_id"`
+	VolumeID   types.Int64  `tfsdk:"volume_id"`
+}
+
+// GetRescueOptions returns the options to boot the Linode into
+// Rescue Mode with the devices of this resource.
+func (data *ResourceModel) GetRescueOptions() linodego.InstanceRescueOptions {
+	var opts linodego.InstanceRescueOptions
+
+	devices := make([]any, len(data.Devices))
+	for i, device := range data.Devices {
+		devices[i] = device.toDeviceBlock()
+	}
+
+	if deviceMap := instanceconfig.ExpandDeviceMap(devices); deviceMap != nil {
+		opts.Devices = *deviceMap
+	}
+
+	return opts
+}
+
+func (d DeviceModel) toDeviceBlock() map[string]any {
+	result := map[string]any{
+		"device_name": d.DeviceName.ValueString(),
+	}
+
+	if !d.DiskID.IsNull() {
+		result["disk_id"] = int(d.DiskID.ValueInt64())
+	}
+
+	if !d.VolumeID.IsNull() {
+		result["volume_id"] = int(d.VolumeID.ValueInt64())
+	}
+
+	return result
+}
@@ -0,0 +1,48 @@
+//go:build unit
+
+package instancerescue
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRescueOptions(t *testing.T) {
+	data := ResourceModel{
+		Devices: []DeviceModel{
+			{
+				DeviceName: types.StringValue("sda"),
+				DiskID:     types.Int64Value(123),
+				VolumeID:   types.Int64Null(),
+			},
+			{
+				DeviceName: types.StringValue("sdc"),
+				DiskID:     types.Int64Null(),
+				VolumeID:   types.Int64Value(456),
+			},
+		},
+	}
+
+	opts := data.GetRescueOptions()
+
+	require.NotNil(t, opts.Devices.SDA)
+	assert.Equal(t, 123, opts.Devices.SDA.DiskID)
+	assert.Zero(t, opts.Devices.SDA.VolumeID)
+
+	assert.Nil(t, opts.Devices.SDB)
+
+	require.NotNil(t, opts.Devices.SDC)
+	assert.Equal(t, 456, opts.Devices.SDC.VolumeID)
+	assert.Zero(t, opts.Devices.SDC.DiskID)
+}
+
+func TestGetRescueOptions_noDevices(t *testing.T) {
+	data := ResourceModel{}
+
+	opts := data.GetRescueOptions()
+
+	assert.Nil(t, opts.Devices.SDA)
+}
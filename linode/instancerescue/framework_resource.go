@@ -0,0 +1,365 @@
+package instancerescue
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+const (
+	InstanceRescueCreateTimeout = 15 * time.Minute
+	InstanceRescueDeleteTimeout = 15 * time.Minute
+)
+
+// rescueEventKey is the private state key of the ID of the boot event
+// the Linode entered Rescue Mode with.
+const rescueEventKey = "rescue_event_id"
+
+func NewResource() resource.Resource {
+	return &Resource{
+		BaseResource: helper.NewBaseResource(
+			helper.BaseResourceConfig{
+				Name:   "linode_instance_rescue",
+				IDAttr: "linode_id",
+				IDType: types.Int64Type,
+				Schema: &frameworkResourceSchema,
+				TimeoutOpts: &timeouts.Opts{
+					Create: true,
+					Delete: true,
+				},
+			},
+		),
+	}
+}
+
+type Resource struct {
+	helper.BaseResource
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	tflog.Debug(ctx, "Create "+r.Config.Name)
+
+	var plan ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, plan)
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, InstanceRescueCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	client := r.Meta.Client
+
+	timeoutSeconds := helper.FrameworkSafeFloat64ToInt(createTimeout.Seconds(), &resp.Diagnostics)
+	linodeID := helper.FrameworkSafeInt64ToInt(plan.LinodeID.ValueInt64(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Keep track of the config to reboot into when leaving Rescue Mode
+	if plan.BootConfigID.IsNull() || plan.BootConfigID.IsUnknown() {
+		bootConfig, err := getDefaultBootConfig(ctx, client, linodeID)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to Get Default Boot Config", err.Error())
+			return
+		}
+
+		plan.BootConfigID = types.Int64Value(int64(bootConfig))
+	}
+
+	rescueOpts := plan.GetRescueOptions()
+
+	p, err := r.Meta.EventWatcher.NewEventWaiter(ctx, linodeID, linodego.EntityLinode, linodego.ActionLinodeBoot)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Initialize Event Waiter", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "client.RescueInstance(...)", map[string]any{
+		"options": rescueOpts,
+	})
+
+	if err := client.RescueInstance(ctx, linodeID, rescueOpts); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Boot Linode %d into Rescue Mode", linodeID),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(strconv.Itoa(linodeID))
+
+	// Add resource to TF states earlier so a failed wait
+	// still reboots the Linode out of Rescue Mode on destroy
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Waiting for instance to boot into rescue mode")
+
+	event, err := p.WaitForFinished(ctx, timeoutSeconds)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Wait for Linode %d to Boot into Rescue Mode", linodeID),
+			err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Instance has booted into rescue mode")
+
+	resp.Diagnostics.Append(setRescueEventID(ctx, resp.Private, event.ID)...)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	tflog.Debug(ctx, "Read "+r.Config.Name)
+
+	var state ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, state)
+
+	client := r.Meta.Client
+
+	linodeID := helper.FrameworkSafeInt64ToInt(state.LinodeID.ValueInt64(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	inst, err := client.GetInstance(ctx, linodeID)
+	if err != nil {
+		if linodego.IsNotFound(err) {
+			resp.Diagnostics.AddWarning(
+				"Linode Not Found",
+				fmt.Sprintf(
+					"Removing Instance Rescue of Linode %d from state because the Linode no longer exists",
+					linodeID,
+				),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Get Linode %d", linodeID),
+			err.Error(),
+		)
+		return
+	}
+
+	eventID := 0
+	if helper.IsInstanceInBootedState(inst.Status) {
+		eventID, err = helper.GetRescueBootEventID(ctx, client, linodeID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed to Check Boot Status of Linode %d", linodeID),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	if eventID == 0 {
+		resp.Diagnostics.AddWarning(
+			"Linode Not in Rescue Mode",
+			fmt.Sprintf(
+				"Removing Instance Rescue of Linode %d from state because the Linode is no longer in Rescue Mode",
+				linodeID,
+			),
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	knownEventID, diags := getRescueEventID(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The API doesn't return the device map of Rescue Mode, so a Linode
+	// booted into Rescue Mode again outside of Terraform may have different
+	// devices mapped and can only be detected by its boot event.
+	if knownEventID != 0 && knownEventID != eventID {
+		resp.Diagnostics.AddWarning(
+			"Linode Rebooted into Rescue Mode",
+			fmt.Sprintf(
+				"Removing Instance Rescue of Linode %d from state because the Linode "+
+					"was booted into Rescue Mode again outside of Terraform",
+				linodeID,
+			),
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(setRescueEventID(ctx, resp.Private, eventID)...)
+
+	// The config booted before entering Rescue Mode isn't known after an import
+	if state.BootConfigID.IsNull() || state.BootConfigID.IsUnknown() {
+		bootConfig, err := getDefaultBootConfig(ctx, client, linodeID)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to Get Default Boot Config", err.Error())
+			return
+		}
+
+		state.BootConfigID = types.Int64Value(int64(bootConfig))
+	}
+
+	state.ID = types.StringValue(strconv.Itoa(linodeID))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	tflog.Debug(ctx, "Update "+r.Config.Name)
+
+	// boot_config_id is only used when this resource is destroyed,
+	// so there is nothing to update on the Linode.
+	var plan ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	tflog.Debug(ctx, "Delete "+r.Config.Name)
+
+	var state ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, state)
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, InstanceRescueDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	client := r.Meta.Client
+
+	linodeID := helper.FrameworkSafeInt64ToInt(state.LinodeID.ValueInt64(), &resp.Diagnostics)
+	bootConfig := helper.FrameworkSafeInt64ToInt(state.BootConfigID.ValueInt64(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := client.GetInstance(ctx, linodeID); err != nil {
+		if linodego.IsNotFound(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Get Linode %d", linodeID),
+			err.Error(),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Rebooting instance out of rescue mode")
+
+	resp.Diagnostics.Append(
+		helper.FrameworkRebootInstance(ctx, linodeID, client, r.Meta.EventWatcher, bootConfig)...,
+	)
+}
+
+// getDefaultBootConfig returns the config the instance is currently booted with,
+// falling back to its first config if no config is booted.
+func getDefaultBootConfig(ctx context.Context, client *linodego.Client, linodeID int) (int, error) {
+	bootConfig, err := helper.GetCurrentBootedConfig(ctx, client, linodeID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get current booted config: %w", err)
+	}
+
+	if bootConfig != 0 {
+		return bootConfig, nil
+	}
+
+	configs, err := client.ListInstanceConfigs(ctx, linodeID, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list instance configs: %w", err)
+	}
+
+	if len(configs) > 0 {
+		return configs[0].ID, nil
+	}
+
+	return 0, nil
+}
+
+type privateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// getRescueEventID returns the ID of the boot event the Linode entered
+// Rescue Mode with, or 0 if it isn't known (e.g. after an import).
+func getRescueEventID(ctx context.Context, private privateState) (int, diag.Diagnostics) {
+	value, diags := private.GetKey(ctx, rescueEventKey)
+	if diags.HasError() || value == nil {
+		return 0, diags
+	}
+
+	eventID, err := strconv.Atoi(string(value))
+	if err != nil {
+		diags.AddError("Failed to Parse Rescue Event ID", err.Error())
+	}
+
+	return eventID, diags
+}
+
+func setRescueEventID(ctx context.Context, private privateState, eventID int) diag.Diagnostics {
+	return private.SetKey(ctx, rescueEventKey, []byte(strconv.Itoa(eventID)))
+}
+
+func populateLogAttributes(ctx context.Context, data ResourceModel) context.Context {
+	return helper.SetLogFieldBulk(ctx, map[string]any{
+		"linode_id": data.LinodeID.ValueInt64(),
+	})
+}
@@ -0,0 +1,69 @@
+package instancerescue
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var frameworkResourceSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The ID of the Linode in Rescue Mode.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"linode_id": schema.Int64Attribute{
+			Description: "The ID of the Linode to boot into Rescue Mode.",
+			Required:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"boot_config_id": schema.Int64Attribute{
+			Description: "The ID of the Config to reboot the Linode into when this resource is destroyed. " +
+				"Defaults to the Config the Linode was booted with before entering Rescue Mode.",
+			Optional: true,
+			Computed: true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+	},
+	Blocks: map[string]schema.Block{
+		"device": schema.SetNestedBlock{
+			Description: "Blocks for the Disks and Volumes to map to devices in Rescue Mode.",
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"device_name": schema.StringAttribute{
+						Description: "The device slot to map the Disk or Volume to.",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(
+								"sda", "sdb", "sdc", "sdd",
+								"sde", "sdf", "sdg", "sdh",
+							),
+						},
+					},
+					"disk_id": schema.Int64Attribute{
+						Description: "The ID of the Disk to map to this device slot.",
+						Optional:    true,
+					},
+					"volume_id": schema.Int64Attribute{
+						Description: "The ID of the Volume to map to this device slot.",
+						Optional:    true,
+					},
+				},
+			},
+			PlanModifiers: []planmodifier.Set{
+				setplanmodifier.RequiresReplace(),
+			},
+		},
+	},
+}
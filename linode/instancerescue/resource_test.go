@@ -0,0 +1,92 @@
+//go:build integration || instancerescue
+
+package instancerescue_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	"github.com/linode/terraform-provider-linode/v2/linode/instancerescue/tmpl"
+)
+
+var testRegion string
+
+func init() {
+	region, err := acceptance.GetRandomRegionWithCaps([]string{"Linodes"}, "core")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	testRegion = region
+}
+
+func TestAccResourceInstanceRescue_basic(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_instance_rescue.foobar"
+	instanceName := acctest.RandomWithPrefix("tf_test")
+	rootPass := acctest.RandString(64)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.PreCheck(t) },
+		ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+		CheckDestroy:             acceptance.CheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: tmpl.Basic(t, instanceName, testRegion, rootPass),
+				Check: resource.ComposeTestCheckFunc(
+					checkInRescue(resName),
+					resource.TestCheckResourceAttrPair(resName, "linode_id", "linode_instance.foobar", "id"),
+					resource.TestCheckResourceAttrPair(
+						resName, "boot_config_id", "linode_instance.foobar", "config.0.id",
+					),
+					resource.TestCheckResourceAttr(resName, "device.#", "1"),
+				),
+			},
+			{
+				ResourceName:            resName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"device"},
+			},
+		},
+	})
+}
+
+func checkInRescue(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, err := acceptance.GetTestClient()
+		if err != nil {
+			return fmt.Errorf("failed to get client: %s", err)
+		}
+
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		linodeID, err := strconv.Atoi(rs.Primary.Attributes["linode_id"])
+		if err != nil {
+			return err
+		}
+
+		inRescue, err := helper.IsInstanceBootedIntoRescue(context.Background(), client, linodeID)
+		if err != nil {
+			return fmt.Errorf("failed to get boot events: %s", err)
+		}
+
+		if !inRescue {
+			return fmt.Errorf("expected instance %d to be in rescue mode", linodeID)
+		}
+
+		return nil
+	}
+}
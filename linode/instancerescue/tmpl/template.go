@@ -0,0 +1,24 @@
+package tmpl
+
+import (
+	"testing"
+
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+)
+
+type TemplateData struct {
+	Label    string
+	Image    string
+	Region   string
+	RootPass string
+}
+
+func Basic(t *testing.T, label, region, rootPass string) string {
+	return acceptance.ExecuteTemplate(t,
+		"instance_rescue_basic", TemplateData{
+			Label:    label,
+			Image:    acceptance.TestImageLatest,
+			Region:   region,
+			RootPass: rootPass,
+		})
+}
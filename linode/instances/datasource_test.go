@@ -1,16 +1,28 @@
-//go:build integration || instance
+//go:build integration || instances
 
-package instance_test
+package instances_test
 
 import (
+	"log"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
-	"github.com/linode/terraform-provider-linode/v2/linode/instance/tmpl"
+	"github.com/linode/terraform-provider-linode/v2/linode/instances/tmpl"
 )
 
+var testRegion string
+
+func init() {
+	region, err := acceptance.GetRandomRegionWithCaps([]string{"Linodes"}, "core")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	testRegion = region
+}
+
 func TestAccDataSourceInstances_basic(t *testing.T) {
 	t.Parallel()
 
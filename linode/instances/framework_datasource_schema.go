@@ -1,222 +1,291 @@
-package instance
+package instances
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper/frameworkfilter"
 )
 
-var instanceDataSourceSchema = map[string]*schema.Schema{
-	"id": {
-		Type:        schema.TypeInt,
+const deviceDescription = "Device can be either a Disk or Volume identified by disk_id or volume_id. Only one " +
+	"type per slot allowed."
+
+var filterConfig = frameworkfilter.Config{
+	"group":          {APIFilterable: true, TypeFunc: helper.FilterTypeString},
+	"id":             {APIFilterable: true, TypeFunc: helper.FilterTypeInt},
+	"image":          {APIFilterable: true, TypeFunc: helper.FilterTypeString},
+	"label":          {APIFilterable: true, TypeFunc: helper.FilterTypeString},
+	"region":         {APIFilterable: true, TypeFunc: helper.FilterTypeString},
+	"lke_cluster_id": {APIFilterable: true, TypeFunc: helper.FilterTypeInt},
+
+	// Tags must be filtered on the client
+	"tags":             {APIFilterable: false, TypeFunc: helper.FilterTypeString},
+	"status":           {APIFilterable: false, TypeFunc: helper.FilterTypeString},
+	"type":             {APIFilterable: false, TypeFunc: helper.FilterTypeString},
+	"watchdog_enabled": {APIFilterable: false, TypeFunc: helper.FilterTypeBool},
+	"disk_encryption":  {APIFilterable: false, TypeFunc: helper.FilterTypeString},
+}
+
+var deviceDiskBlock = schema.ListNestedBlock{
+	Description: deviceDescription,
+	NestedObject: schema.NestedBlockObject{
+		Attributes: map[string]schema.Attribute{
+			"disk_label": schema.StringAttribute{
+				Description: "The `label` of the `disk` to map to this `device` slot.",
+				Computed:    true,
+			},
+			"disk_id": schema.Int64Attribute{
+				Description: "The Disk ID to map to this disk slot",
+				Computed:    true,
+			},
+			"volume_id": schema.Int64Attribute{
+				Description: "The Block Storage volume ID to map to this disk slot",
+				Computed:    true,
+			},
+		},
+	},
+}
+
+var interfaceBlock = schema.ListNestedBlock{
+	Description: "An array of Network Interfaces for this Linode’s Configuration Profile.",
+	NestedObject: schema.NestedBlockObject{
+		Attributes: map[string]schema.Attribute{
+			"purpose": schema.StringAttribute{
+				Description: "The type of interface.",
+				Computed:    true,
+			},
+			"ipam_address": schema.StringAttribute{
+				Description: "This Network Interface's private IP address in " +
+					"Classless Inter-Domain Routing (CIDR) notation.",
+				Computed: true,
+			},
+			"label": schema.StringAttribute{
+				Description: "The name of the VLAN.",
+				Computed:    true,
+			},
+			"id": schema.Int64Attribute{
+				Description: "The ID of the interface.",
+				Computed:    true,
+			},
+			"subnet_id": schema.Int64Attribute{
+				Description: "The ID of the subnet which the VPC interface is connected to.",
+				Computed:    true,
+			},
+			"vpc_id": schema.Int64Attribute{
+				Description: "The ID of VPC of the subnet which the VPC " +
+					"interface is connected to.",
+				Computed: true,
+			},
+			"primary": schema.BoolAttribute{
+				Description: "Whether the interface is the primary interface that should " +
+					"have the default route for this Linode.",
+				Computed: true,
+			},
+			"active": schema.BoolAttribute{
+				Description: "Whether this interface is currently booted and active.",
+				Computed:    true,
+			},
+			"ip_ranges": schema.ListAttribute{
+				Description: "List of VPC IPs or IP ranges inside the VPC subnet.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"ipv4": schema.ListNestedBlock{
+				Description: "The IPv4 configuration of the VPC interface.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"vpc": schema.StringAttribute{
+							Description: "The IP from the VPC subnet to use for this interface.",
+							Computed:    true,
+						},
+						"nat_1_1": schema.StringAttribute{
+							Description: "The public IP that will be used for the " +
+								"one-to-one NAT purpose.",
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+var instanceAttributes = map[string]schema.Attribute{
+	"id": schema.Int64Attribute{
 		Description: "The ID of the Linode instance.",
 		Computed:    true,
 	},
-	"image": {
-		Type: schema.TypeString,
+	"image": schema.StringAttribute{
 		Description: "An Image ID to deploy the Disk from. Official Linode Images start with linode/, while " +
 			"your Images start with private/. See /images for more information on the Images available for you to use.",
 		Computed: true,
 	},
-	"label": {
-		Type: schema.TypeString,
+	"label": schema.StringAttribute{
 		Description: "The Linode's label is for display purposes only. If no label is provided for a Linode, " +
 			"a default will be assigned",
 		Computed: true,
 	},
-	"group": {
-		Type:        schema.TypeString,
+	"group": schema.StringAttribute{
 		Description: "The display group of the Linode instance.",
 		Computed:    true,
 	},
-	"tags": {
-		Type:        schema.TypeSet,
+	"tags": schema.SetAttribute{
 		Description: "The tags assigned to this Instance.",
-		Elem:        &schema.Schema{Type: schema.TypeString},
+		ElementType: types.StringType,
 		Computed:    true,
 	},
-	"boot_config_label": {
-		Type:        schema.TypeString,
+	"boot_config_label": schema.StringAttribute{
 		Description: "The Label of the Instance Config that should be used to boot the Linode instance.",
 		Computed:    true,
 	},
-	"region": {
-		Type: schema.TypeString,
+	"region": schema.StringAttribute{
 		Description: "This is the location where the Linode was deployed. This cannot be changed without " +
 			"opening a support ticket.",
 		Computed: true,
 	},
-	"type": {
-		Type:        schema.TypeString,
+	"type": schema.StringAttribute{
 		Description: "The type of instance to be deployed, determining the price and size.",
 		Computed:    true,
 	},
-	"status": {
-		Type:        schema.TypeString,
+	"status": schema.StringAttribute{
 		Description: "The status of the instance, indicating the current readiness state.",
 		Computed:    true,
 	},
-	"ip_address": {
-		Type: schema.TypeString,
+	"ip_address": schema.StringAttribute{
 		Description: "This Linode's Public IPv4 Address. If there are multiple public IPv4 addresses on this " +
 			"Instance, an arbitrary address will be used for this field.",
 		Computed: true,
 	},
-	"ipv6": {
-		Type:        schema.TypeString,
+	"ipv6": schema.StringAttribute{
 		Description: "This Linode's IPv6 SLAAC addresses. This address is specific to a Linode, and may not be shared.",
 		Computed:    true,
 	},
-
-	"ipv4": {
-		Type: schema.TypeSet,
-		Elem: &schema.Schema{Type: schema.TypeString},
+	"ipv4": schema.SetAttribute{
 		Description: "This Linode's IPv4 Addresses. Each Linode is assigned a single public IPv4 address upon " +
 			"creation, and may get a single private IPv4 address if needed. You may need to open a support " +
 			"ticket to get additional IPv4 addresses.",
-		Computed: true,
+		ElementType: types.StringType,
+		Computed:    true,
 	},
-	"private_ip_address": {
-		Type: schema.TypeString,
+	"private_ip_address": schema.StringAttribute{
 		Description: "This Linode's Private IPv4 Address.  The regional private IP address range is " +
 			"192.168.128/17 address shared by all Linode Instances in a region.",
 		Computed: true,
 	},
-	"swap_size": {
-		Type: schema.TypeInt,
+	"swap_size": schema.Int64Attribute{
 		Description: "When deploying from an Image, this field is optional with a Linode API default of " +
 			"512mb, otherwise it is ignored. This is used to set the swap disk size for the newly-created Linode.",
 		Computed: true,
 	},
-	"watchdog_enabled": {
-		Type: schema.TypeBool,
+	"watchdog_enabled": schema.BoolAttribute{
 		Description: "The watchdog, named Lassie, is a Shutdown Watchdog that monitors your Linode and will " +
 			"reboot it if it powers off unexpectedly. It works by issuing a boot job when your Linode powers " +
 			"off without a shutdown job being responsible. To prevent a loop, Lassie will give up if there have " +
 			"been more than 5 boot jobs issued within 15 minutes.",
 		Computed: true,
 	},
-	"host_uuid": {
-		Type:        schema.TypeString,
+	"host_uuid": schema.StringAttribute{
 		Description: "The Linode’s host machine, as a UUID.",
 		Computed:    true,
 	},
-	"has_user_data": {
-		Type:        schema.TypeBool,
+	"has_user_data": schema.BoolAttribute{
 		Description: "Whether this Instance was created with user-data.",
 		Computed:    true,
 	},
-	"disk_encryption": {
-		Type: schema.TypeString,
+	"disk_encryption": schema.StringAttribute{
 		Description: "The disk encryption policy for this Instance." +
 			"NOTE: Disk encryption may not currently be available to all users.",
 		Computed: true,
 	},
-	"lke_cluster_id": {
-		Type:        schema.TypeInt,
+	"lke_cluster_id": schema.Int64Attribute{
 		Description: "If applicable, the ID of the LKE cluster this Instance is a node of.",
 		Computed:    true,
 	},
-	"specs": {
-		Computed: true,
-		Type:     schema.TypeList,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"disk": {
-					Type:     schema.TypeInt,
-					Computed: true,
+}
+
+var instanceBlocks = map[string]schema.Block{
+	"specs": schema.ListNestedBlock{
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"disk": schema.Int64Attribute{
 					Description: "The amount of storage space, in GB. this Linode has access to. A typical " +
 						"Linode will divide this space between a primary disk with an image deployed to it, " +
 						"and a swap disk, usually 512 MB. This is the default configuration created when " +
 						"deploying a Linode with an image without specifying disks.",
-				},
-				"memory": {
-					Type:     schema.TypeInt,
 					Computed: true,
+				},
+				"memory": schema.Int64Attribute{
 					Description: "The amount of RAM, in MB, this Linode has access to. Typically a Linode will " +
 						"choose to boot with all of its available RAM, but this can be configured in a Config profile.",
-				},
-				"vcpus": {
-					Type:     schema.TypeInt,
 					Computed: true,
+				},
+				"vcpus": schema.Int64Attribute{
 					Description: "The number of vcpus this Linode has access to. Typically a Linode will " +
 						"choose to boot with all of its available vcpus, but this can be configured in a Config Profile.",
+					Computed: true,
 				},
-				"transfer": {
-					Type:        schema.TypeInt,
-					Computed:    true,
+				"transfer": schema.Int64Attribute{
 					Description: "The amount of network transfer this Linode is allotted each month.",
+					Computed:    true,
 				},
 			},
 		},
 	},
-
-	"alerts": {
-		Computed: true,
-		Type:     schema.TypeList,
-		Optional: true,
-		MaxItems: 1,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"cpu": {
-					Type:     schema.TypeInt,
-					Computed: true,
+	"alerts": schema.ListNestedBlock{
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"cpu": schema.Int64Attribute{
 					Description: "The percentage of CPU usage required to trigger an alert. If the average " +
-						"CPU usage over two hours exceeds this value, we'll Device can be either a Disk or Volume " +
-						"identified by disk_id or volume_id. Only one type per slot allowed.send you an alert. If " +
-						"this is set to 0, the alert is disabled.",
-				},
-				"network_in": {
-					Type:     schema.TypeInt,
+						"CPU usage over two hours exceeds this value, we'll send you an alert. If this is set " +
+						"to 0, the alert is disabled.",
 					Computed: true,
+				},
+				"network_in": schema.Int64Attribute{
 					Description: "The amount of incoming traffic, in Mbit/s, required to trigger an alert. " +
 						"If the average incoming traffic over two hours exceeds this value, we'll send you an " +
 						"alert. If this is set to 0 (zero), the alert is disabled.",
-				},
-				"network_out": {
-					Type:     schema.TypeInt,
 					Computed: true,
+				},
+				"network_out": schema.Int64Attribute{
 					Description: "The amount of outbound traffic, in Mbit/s, required to trigger an alert. " +
 						"If the average outbound traffic over two hours exceeds this value, we'll send you an alert. " +
 						"If this is set to 0 (zero), the alert is disabled.",
-				},
-				"transfer_quota": {
-					Type:     schema.TypeInt,
 					Computed: true,
+				},
+				"transfer_quota": schema.Int64Attribute{
 					Description: "The percentage of network transfer that may be used before an alert is triggered. " +
 						"When this value is exceeded, we'll alert you. If this is set to 0 (zero), the alert is disabled.",
-				},
-				"io": {
-					Type:     schema.TypeInt,
 					Computed: true,
+				},
+				"io": schema.Int64Attribute{
 					Description: "The amount of disk IO operation per second required to trigger an alert. " +
 						"If the average disk IO over two hours exceeds this value, we'll send you an alert. " +
 						"If set to 0, this alert is disabled.",
+					Computed: true,
 				},
 			},
 		},
 	},
-	"backups": {
-		Type:        schema.TypeList,
+	"backups": schema.ListNestedBlock{
 		Description: "Information about this Linode's backups status.",
-		Computed:    true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"available": {
-					Type:        schema.TypeBool,
-					Computed:    true,
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"available": schema.BoolAttribute{
 					Description: "Whether this Backup is available for restoration.",
-				},
-				"enabled": {
-					Type:        schema.TypeBool,
 					Computed:    true,
+				},
+				"enabled": schema.BoolAttribute{
 					Description: "If this Linode has the Backup service enabled.",
+					Computed:    true,
 				},
-				"schedule": {
-					Type:     schema.TypeList,
-					Computed: true,
-					Elem: &schema.Resource{
-						Schema: map[string]*schema.Schema{
-							"day": {
-								Type: schema.TypeString,
+			},
+			Blocks: map[string]schema.Block{
+				"schedule": schema.ListNestedBlock{
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"day": schema.StringAttribute{
 								Description: "The day ('Sunday'-'Saturday') of the week that your Linode's weekly " +
 									"Backup is taken. If not set manually, a day will be chosen for you. Backups are " +
 									"taken every day, but backups taken on this day are preferred when selecting backups " +
@@ -224,8 +293,7 @@ var instanceDataSourceSchema = map[string]*schema.Schema{
 									"enabled, this may come back as 'Scheduling' until the day is automatically selected.",
 								Computed: true,
 							},
-							"window": {
-								Type: schema.TypeString,
+							"window": schema.StringAttribute{
 								Description: "The window ('W0'-'W22') in which your backups will be taken, in UTC. " +
 									"A backups window is a two-hour span of time in which the backup may occur. " +
 									"For example, 'W10' indicates that your backups should be taken between 10:00 " +
@@ -240,208 +308,133 @@ var instanceDataSourceSchema = map[string]*schema.Schema{
 			},
 		},
 	},
-	"config": {
+	"config": schema.ListNestedBlock{
 		Description: "Configuration profiles define the VM settings and boot behavior of the Linode Instance.",
-		Type:        schema.TypeList,
-		Computed:    true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"id": {
-					Type:        schema.TypeInt,
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.Int64Attribute{
 					Description: "The unique ID of this Config.",
 					Computed:    true,
 				},
-				"label": {
-					Type:        schema.TypeString,
+				"label": schema.StringAttribute{
 					Description: "The Config's label for display purposes.  Also used by `boot_config_label`.",
 					Computed:    true,
 				},
-				"helpers": {
-					Type:        schema.TypeList,
-					Description: "Helpers enabled when booting to this Linode Config.",
+				"kernel": schema.StringAttribute{
+					Description: "A Kernel ID to boot a Linode with. Default is based on image choice. " +
+						"(examples: linode/latest-64bit, linode/grub2, linode/direct-disk)",
+					Computed: true,
+				},
+				"run_level": schema.StringAttribute{
+					Description: "Defines the state of your Linode after booting. Defaults to default.",
+					Computed:    true,
+				},
+				"virt_mode": schema.StringAttribute{
+					Description: "Controls the virtualization mode. Defaults to paravirt.",
+					Computed:    true,
+				},
+				"root_device": schema.StringAttribute{
+					Description: "The root device to boot. The corresponding disk must be attached.",
 					Computed:    true,
-					Elem: &schema.Resource{
-						Schema: map[string]*schema.Schema{
-							"updatedb_disabled": {
-								Type:        schema.TypeBool,
+				},
+				"comments": schema.StringAttribute{
+					Description: "Optional field for arbitrary User comments on this Config.",
+					Computed:    true,
+				},
+				"memory_limit": schema.Int64Attribute{
+					Description: "Defaults to the total RAM of the Linode",
+					Computed:    true,
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"helpers": schema.ListNestedBlock{
+					Description: "Helpers enabled when booting to this Linode Config.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"updatedb_disabled": schema.BoolAttribute{
 								Description: "Disables updatedb cron job to avoid disk thrashing.",
 								Computed:    true,
 							},
-							"distro": {
-								Type:        schema.TypeBool,
+							"distro": schema.BoolAttribute{
 								Description: "Controls the behavior of the Linode Config's Distribution Helper setting.",
 								Computed:    true,
 							},
-							"modules_dep": {
-								Type:        schema.TypeBool,
+							"modules_dep": schema.BoolAttribute{
 								Description: "Creates a modules dependency file for the Kernel you run.",
 								Computed:    true,
 							},
-							"network": {
-								Type: schema.TypeBool,
+							"network": schema.BoolAttribute{
 								Description: "Controls the behavior of the Linode Config's Network Helper setting, used to " +
 									"automatically configure additional IP addresses assigned to this instance.",
 								Computed: true,
 							},
-							"devtmpfs_automount": {
-								Type:        schema.TypeBool,
+							"devtmpfs_automount": schema.BoolAttribute{
 								Description: "Populates the /dev directory early during boot without udev. Defaults to false.",
 								Computed:    true,
 							},
 						},
 					},
 				},
-				"devices": {
-					Type: schema.TypeList,
+				"devices": schema.ListNestedBlock{
 					Description: "Device sda-sdh can be either a Disk or Volume identified by disk_label or " +
 						"volume_id. Only one type per slot allowed.",
-					Computed: true,
-					Elem: &schema.Resource{
-						Schema: map[string]*schema.Schema{
-							"sda": {
-								Type:        schema.TypeList,
-								Description: "",
-								Computed:    true,
-								Elem:        resourceDeviceDisk(),
-							},
-							"sdb": {
-								Type:        schema.TypeList,
-								Description: deviceDescription,
-								Computed:    true,
-								Elem:        resourceDeviceDisk(),
-							},
-							"sdc": {
-								Type:        schema.TypeList,
-								Description: deviceDescription,
-								Computed:    true,
-								Elem:        resourceDeviceDisk(),
-							},
-							"sdd": {
-								Type:        schema.TypeList,
-								Description: deviceDescription,
-								Computed:    true,
-								Elem:        resourceDeviceDisk(),
-							},
-							"sde": {
-								Type:        schema.TypeList,
-								Description: deviceDescription,
-								Computed:    true,
-								Elem:        resourceDeviceDisk(),
-							},
-							"sdf": {
-								Type:        schema.TypeList,
-								Description: deviceDescription,
-								Computed:    true,
-								Elem:        resourceDeviceDisk(),
-							},
-							"sdg": {
-								Type:        schema.TypeList,
-								Description: deviceDescription,
-								Computed:    true,
-								Elem:        resourceDeviceDisk(),
-							},
-							"sdh": {
-								Type:        schema.TypeList,
-								Description: deviceDescription,
-								Computed:    true,
-								Elem:        resourceDeviceDisk(),
-							},
+					NestedObject: schema.NestedBlockObject{
+						Blocks: map[string]schema.Block{
+							"sda": deviceDiskBlock,
+							"sdb": deviceDiskBlock,
+							"sdc": deviceDiskBlock,
+							"sdd": deviceDiskBlock,
+							"sde": deviceDiskBlock,
+							"sdf": deviceDiskBlock,
+							"sdg": deviceDiskBlock,
+							"sdh": deviceDiskBlock,
 						},
 					},
 				},
-				"interface": {
-					Type:        schema.TypeList,
-					Description: "An array of Network Interfaces for this Linode’s Configuration Profile.",
-					Computed:    true,
-					Elem:        InterfaceSchema,
-				},
-				"kernel": {
-					Type:     schema.TypeString,
-					Computed: true,
-					Description: "A Kernel ID to boot a Linode with. Default is based on image choice. " +
-						"(examples: linode/latest-64bit, linode/grub2, linode/direct-disk)",
-				},
-				"run_level": {
-					Type:        schema.TypeString,
-					Computed:    true,
-					Description: "Defines the state of your Linode after booting. Defaults to default.",
-				},
-				"virt_mode": {
-					Type:        schema.TypeString,
-					Description: "Controls the virtualization mode. Defaults to paravirt.",
-					Computed:    true,
-				},
-				"root_device": {
-					Type:        schema.TypeString,
-					Computed:    true,
-					Description: "The root device to boot. The corresponding disk must be attached.",
-				},
-				"comments": {
-					Type:        schema.TypeString,
-					Computed:    true,
-					Description: "Optional field for arbitrary User comments on this Config.",
-				},
-
-				"memory_limit": {
-					Type:        schema.TypeInt,
-					Computed:    true,
-					Description: "Defaults to the total RAM of the Linode",
-				},
+				"interface": interfaceBlock,
 			},
 		},
 	},
-	"disk": {
-		Computed:    true,
+	"disk": schema.ListNestedBlock{
 		Description: "Disks associated with this Linode.",
-		Type:        schema.TypeList,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"label": {
-					Type:        schema.TypeString,
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"label": schema.StringAttribute{
 					Description: "The disks label, which acts as an identifier in Terraform.",
 					Computed:    true,
 				},
-				"size": {
-					Type:        schema.TypeInt,
+				"size": schema.Int64Attribute{
 					Description: "The size of the Disk in MB.",
 					Computed:    true,
 				},
-				"id": {
-					Type:        schema.TypeInt,
+				"id": schema.Int64Attribute{
 					Description: "The ID of the Disk (for use in Linode Image resources and Linode Instance Config Devices)",
 					Computed:    true,
 				},
-				"filesystem": {
-					Type:        schema.TypeString,
+				"filesystem": schema.StringAttribute{
 					Description: "The Disk filesystem can be one of: raw, swap, ext3, ext4, initrd (max 32mb)",
 					Computed:    true,
 				},
 			},
 		},
 	},
-	"placement_group": {
-		Type:     schema.TypeList,
-		Computed: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"id": {
-					Type:        schema.TypeInt,
+	"placement_group": schema.ListNestedBlock{
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.Int64Attribute{
 					Description: "The placement group's ID. You need to provide it for all operations impacting it.",
 					Computed:    true,
 				},
-				"label": {
-					Type:        schema.TypeString,
+				"label": schema.StringAttribute{
 					Description: "The unique name set for the placement group.",
 					Computed:    true,
 				},
-				"placement_group_type": {
-					Type: schema.TypeString,
+				"placement_group_type": schema.StringAttribute{
 					Description: "How compute instances are distributed in your placement group. " +
 						"anti-affinity:local places compute instances in separate fault domains, but still in the same region.",
 					Computed: true,
 				},
-				"placement_group_policy": {
-					Type: schema.TypeString,
+				"placement_group_policy": schema.StringAttribute{
 					Description: "How the API enforces your placement_group_type. Set to strict, your group is strict. You can't " +
 						"add more compute instances to your placement group if your preferred container lacks capacity or is" +
 						" unavailable. Set to flexible, your group is flexible. You can add more compute instances to it even if " +
@@ -453,3 +446,24 @@ var instanceDataSourceSchema = map[string]*schema.Schema{
 		},
 	},
 }
+
+var frameworkDatasourceSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The data source's unique ID.",
+			Computed:    true,
+		},
+		"order":    filterConfig.OrderSchema(),
+		"order_by": filterConfig.OrderBySchema(),
+	},
+	Blocks: map[string]schema.Block{
+		"filter": filterConfig.Schema(),
+		"instances": schema.ListNestedBlock{
+			Description: "The returned list of Instances.",
+			NestedObject: schema.NestedBlockObject{
+				Attributes: instanceAttributes,
+				Blocks:     instanceBlocks,
+			},
+		},
+	},
+}
@@ -0,0 +1,460 @@
+package instances
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper/frameworkfilter"
+)
+
+// InstanceFilterModel describes the Terraform resource data model to match the
+// resource schema.
+type InstanceFilterModel struct {
+	ID        types.String                     `tfsdk:"id"`
+	Filters   frameworkfilter.FiltersModelType `tfsdk:"filter"`
+	Order     types.String                     `tfsdk:"order"`
+	OrderBy   types.String                     `tfsdk:"order_by"`
+	Instances []InstanceModel                  `tfsdk:"instances"`
+}
+
+type InstanceModel struct {
+	ID               types.Int64                   `tfsdk:"id"`
+	Image            types.String                  `tfsdk:"image"`
+	Label            types.String                  `tfsdk:"label"`
+	Group            types.String                  `tfsdk:"group"`
+	Tags             types.Set                     `tfsdk:"tags"`
+	BootConfigLabel  types.String                  `tfsdk:"boot_config_label"`
+	Region           types.String                  `tfsdk:"region"`
+	Type             types.String                  `tfsdk:"type"`
+	Status           types.String                  `tfsdk:"status"`
+	IPAddress        types.String                  `tfsdk:"ip_address"`
+	IPv6             types.String                  `tfsdk:"ipv6"`
+	IPv4             types.Set                     `tfsdk:"ipv4"`
+	PrivateIPAddress types.String                  `tfsdk:"private_ip_address"`
+	SwapSize         types.Int64                   `tfsdk:"swap_size"`
+	WatchdogEnabled  types.Bool                    `tfsdk:"watchdog_enabled"`
+	HostUUID         types.String                  `tfsdk:"host_uuid"`
+	HasUserData      types.Bool                    `tfsdk:"has_user_data"`
+	DiskEncryption   types.String                  `tfsdk:"disk_encryption"`
+	LKEClusterID     types.Int64                   `tfsdk:"lke_cluster_id"`
+	Specs            []InstanceSpecsModel          `tfsdk:"specs"`
+	Alerts           []InstanceAlertsModel         `tfsdk:"alerts"`
+	Backups          []InstanceBackupsModel        `tfsdk:"backups"`
+	Configs          []InstanceConfigModel         `tfsdk:"config"`
+	Disks            []InstanceDiskModel           `tfsdk:"disk"`
+	PlacementGroup   []InstancePlacementGroupModel `tfsdk:"placement_group"`
+}
+
+type InstanceSpecsModel struct {
+	Disk     types.Int64 `tfsdk:"disk"`
+	Memory   types.Int64 `tfsdk:"memory"`
+	VCPUs    types.Int64 `tfsdk:"vcpus"`
+	Transfer types.Int64 `tfsdk:"transfer"`
+}
+
+type InstanceAlertsModel struct {
+	CPU           types.Int64 `tfsdk:"cpu"`
+	NetworkIn     types.Int64 `tfsdk:"network_in"`
+	NetworkOut    types.Int64 `tfsdk:"network_out"`
+	TransferQuota types.Int64 `tfsdk:"transfer_quota"`
+	IO            types.Int64 `tfsdk:"io"`
+}
+
+type InstanceBackupsModel struct {
+	Available types.Bool                    `tfsdk:"available"`
+	Enabled   types.Bool                    `tfsdk:"enabled"`
+	Schedule  []InstanceBackupScheduleModel `tfsdk:"schedule"`
+}
+
+type InstanceBackupScheduleModel struct {
+	Day    types.String `tfsdk:"day"`
+	Window types.String `tfsdk:"window"`
+}
+
+type InstanceConfigModel struct {
+	ID          types.Int64                    `tfsdk:"id"`
+	Label       types.String                   `tfsdk:"label"`
+	Kernel      types.String                   `tfsdk:"kernel"`
+	RunLevel    types.String                   `tfsdk:"run_level"`
+	VirtMode    types.String                   `tfsdk:"virt_mode"`
+	RootDevice  types.String                   `tfsdk:"root_device"`
+	Comments    types.String                   `tfsdk:"comments"`
+	MemoryLimit types.Int64                    `tfsdk:"memory_limit"`
+	Helpers     []InstanceConfigHelpersModel   `tfsdk:"helpers"`
+	Devices     []InstanceConfigDevicesModel   `tfsdk:"devices"`
+	Interfaces  []InstanceConfigInterfaceModel `tfsdk:"interface"`
+}
+
+type InstanceConfigHelpersModel struct {
+	UpdateDBDisabled  types.Bool `tfsdk:"updatedb_disabled"`
+	Distro            types.Bool `tfsdk:"distro"`
+	ModulesDep        types.Bool `tfsdk:"modules_dep"`
+	Network           types.Bool `tfsdk:"network"`
+	DevTmpFsAutomount types.Bool `tfsdk:"devtmpfs_automount"`
+}
+
+type InstanceConfigDevicesModel struct {
+	SDA []InstanceConfigDeviceModel `tfsdk:"sda"`
+	SDB []InstanceConfigDeviceModel `tfsdk:"sdb"`
+	SDC []InstanceConfigDeviceModel `tfsdk:"sdc"`
+	SDD []InstanceConfigDeviceModel `tfsdk:"sdd"`
+	SDE []InstanceConfigDeviceModel `tfsdk:"sde"`
+	SDF []InstanceConfigDeviceModel `tfsdk:"sdf"`
+	SDG []InstanceConfigDeviceModel `tfsdk:"sdg"`
+	SDH []InstanceConfigDeviceModel `tfsdk:"sdh"`
+}
+
+type InstanceConfigDeviceModel struct {
+	DiskLabel types.String `tfsdk:"disk_label"`
+	DiskID    types.Int64  `tfsdk:"disk_id"`
+	VolumeID  types.Int64  `tfsdk:"volume_id"`
+}
+
+type InstanceConfigInterfaceModel struct {
+	Purpose     types.String                       `tfsdk:"purpose"`
+	IPAMAddress types.String                       `tfsdk:"ipam_address"`
+	Label       types.String                       `tfsdk:"label"`
+	ID          types.Int64                        `tfsdk:"id"`
+	SubnetID    types.Int64                        `tfsdk:"subnet_id"`
+	VPCID       types.Int64                        `tfsdk:"vpc_id"`
+	Primary     types.Bool                         `tfsdk:"primary"`
+	Active      types.Bool                         `tfsdk:"active"`
+	IPRanges    types.List                         `tfsdk:"ip_ranges"`
+	IPv4        []InstanceConfigInterfaceIPv4Model `tfsdk:"ipv4"`
+}
+
+type InstanceConfigInterfaceIPv4Model struct {
+	VPC     types.String `tfsdk:"vpc"`
+	NAT1To1 types.String `tfsdk:"nat_1_1"`
+}
+
+type InstanceDiskModel struct {
+	Label      types.String `tfsdk:"label"`
+	Size       types.Int64  `tfsdk:"size"`
+	ID         types.Int64  `tfsdk:"id"`
+	Filesystem types.String `tfsdk:"filesystem"`
+}
+
+type InstancePlacementGroupModel struct {
+	ID                   types.Int64  `tfsdk:"id"`
+	Label                types.String `tfsdk:"label"`
+	PlacementGroupType   types.String `tfsdk:"placement_group_type"`
+	PlacementGroupPolicy types.String `tfsdk:"placement_group_policy"`
+}
+
+func (data *InstanceFilterModel) parseInstances(
+	ctx context.Context,
+	client *linodego.Client,
+	instances []linodego.Instance,
+) diag.Diagnostics {
+	result := make([]InstanceModel, len(instances))
+	for i := range instances {
+		var instance InstanceModel
+
+		diags := instance.parseInstance(ctx, &instances[i])
+		if diags.HasError() {
+			return diags
+		}
+
+		diags = instance.parseInstanceNetworking(ctx, client, &instances[i])
+		if diags.HasError() {
+			return diags
+		}
+
+		diags = instance.parseInstanceDisksAndConfigs(ctx, client, &instances[i])
+		if diags.HasError() {
+			return diags
+		}
+
+		result[i] = instance
+	}
+
+	data.Instances = result
+	return nil
+}
+
+func (data *InstanceModel) parseInstance(
+	ctx context.Context,
+	instance *linodego.Instance,
+) diag.Diagnostics {
+	data.ID = types.Int64Value(int64(instance.ID))
+	data.Image = types.StringValue(instance.Image)
+	data.Label = types.StringValue(instance.Label)
+	data.Group = types.StringValue(instance.Group)
+	data.Region = types.StringValue(instance.Region)
+	data.Type = types.StringValue(instance.Type)
+	data.Status = types.StringValue(string(instance.Status))
+	data.IPv6 = types.StringValue(instance.IPv6)
+	data.WatchdogEnabled = types.BoolValue(instance.WatchdogEnabled)
+	data.HostUUID = types.StringValue(instance.HostUUID)
+	data.HasUserData = types.BoolValue(instance.HasUserData)
+	data.DiskEncryption = types.StringValue(string(instance.DiskEncryption))
+	data.LKEClusterID = types.Int64Value(int64(instance.LKEClusterID))
+
+	tags, diags := types.SetValueFrom(ctx, types.StringType, instance.Tags)
+	if diags.HasError() {
+		return diags
+	}
+	data.Tags = tags
+
+	ips := make([]string, len(instance.IPv4))
+	for i, ip := range instance.IPv4 {
+		ips[i] = ip.String()
+	}
+
+	ipv4, diags := types.SetValueFrom(ctx, types.StringType, ips)
+	if diags.HasError() {
+		return diags
+	}
+	data.IPv4 = ipv4
+
+	if instance.Specs != nil {
+		data.Specs = []InstanceSpecsModel{{
+			Disk:     types.Int64Value(int64(instance.Specs.Disk)),
+			Memory:   types.Int64Value(int64(instance.Specs.Memory)),
+			VCPUs:    types.Int64Value(int64(instance.Specs.VCPUs)),
+			Transfer: types.Int64Value(int64(instance.Specs.Transfer)),
+		}}
+	}
+
+	if instance.Alerts != nil {
+		data.Alerts = []InstanceAlertsModel{{
+			CPU:           types.Int64Value(int64(instance.Alerts.CPU)),
+			NetworkIn:     types.Int64Value(int64(instance.Alerts.NetworkIn)),
+			NetworkOut:    types.Int64Value(int64(instance.Alerts.NetworkOut)),
+			TransferQuota: types.Int64Value(int64(instance.Alerts.TransferQuota)),
+			IO:            types.Int64Value(int64(instance.Alerts.IO)),
+		}}
+	}
+
+	if instance.Backups != nil {
+		data.Backups = []InstanceBackupsModel{{
+			Available: types.BoolValue(instance.Backups.Available),
+			Enabled:   types.BoolValue(instance.Backups.Enabled),
+			Schedule: []InstanceBackupScheduleModel{{
+				Day:    types.StringValue(instance.Backups.Schedule.Day),
+				Window: types.StringValue(instance.Backups.Schedule.Window),
+			}},
+		}}
+	}
+
+	if instance.PlacementGroup != nil {
+		data.PlacementGroup = []InstancePlacementGroupModel{{
+			ID:                   types.Int64Value(int64(instance.PlacementGroup.ID)),
+			Label:                types.StringValue(instance.PlacementGroup.Label),
+			PlacementGroupType:   types.StringValue(string(instance.PlacementGroup.PlacementGroupType)),
+			PlacementGroupPolicy: types.StringValue(string(instance.PlacementGroup.PlacementGroupPolicy)),
+		}}
+	}
+
+	return nil
+}
+
+func (data *InstanceModel) parseInstanceNetworking(
+	ctx context.Context,
+	client *linodego.Client,
+	instance *linodego.Instance,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	instanceNetwork, err := client.GetInstanceIPAddresses(ctx, instance.ID)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Failed to get IPs for Linode Instance %d", instance.ID),
+			err.Error(),
+		)
+		return diags
+	}
+
+	data.IPAddress = types.StringNull()
+	data.PrivateIPAddress = types.StringNull()
+
+	if instanceNetwork.IPv4 == nil {
+		return nil
+	}
+
+	if public := instanceNetwork.IPv4.Public; len(public) > 0 {
+		data.IPAddress = types.StringValue(public[0].Address)
+	}
+
+	if private := instanceNetwork.IPv4.Private; len(private) > 0 {
+		data.PrivateIPAddress = types.StringValue(private[0].Address)
+	}
+
+	return nil
+}
+
+func (data *InstanceModel) parseInstanceDisksAndConfigs(
+	ctx context.Context,
+	client *linodego.Client,
+	instance *linodego.Instance,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	instanceDisks, err := client.ListInstanceDisks(ctx, instance.ID, nil)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Failed to get the disks for Linode Instance %d", instance.ID),
+			err.Error(),
+		)
+		return diags
+	}
+
+	var swapSize int
+
+	disks := make([]InstanceDiskModel, len(instanceDisks))
+	diskLabelIDMap := make(map[int]string, len(instanceDisks))
+
+	for i, disk := range instanceDisks {
+		// Determine if swap exists and the size.  If it does not exist, swap_size=0
+		if disk.Filesystem == linodego.FilesystemSwap {
+			swapSize += disk.Size
+		}
+
+		disks[i] = InstanceDiskModel{
+			ID:         types.Int64Value(int64(disk.ID)),
+			Label:      types.StringValue(disk.Label),
+			Size:       types.Int64Value(int64(disk.Size)),
+			Filesystem: types.StringValue(string(disk.Filesystem)),
+		}
+		diskLabelIDMap[disk.ID] = disk.Label
+	}
+
+	data.Disks = disks
+	data.SwapSize = types.Int64Value(int64(swapSize))
+
+	instanceConfigs, err := client.ListInstanceConfigs(ctx, instance.ID, nil)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Failed to get the configs for Linode Instance %d (%s)", instance.ID, instance.Label),
+			err.Error(),
+		)
+		return diags
+	}
+
+	configs := make([]InstanceConfigModel, len(instanceConfigs))
+	for i := range instanceConfigs {
+		configs[i], diags = parseInstanceConfig(ctx, &instanceConfigs[i], diskLabelIDMap)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	data.Configs = configs
+
+	data.BootConfigLabel = types.StringNull()
+	if len(instanceConfigs) == 1 {
+		data.BootConfigLabel = types.StringValue(instanceConfigs[0].Label)
+	}
+
+	return nil
+}
+
+func parseInstanceConfig(
+	ctx context.Context,
+	config *linodego.InstanceConfig,
+	diskLabelIDMap map[int]string,
+) (InstanceConfigModel, diag.Diagnostics) {
+	result := InstanceConfigModel{
+		ID:          types.Int64Value(int64(config.ID)),
+		Label:       types.StringValue(config.Label),
+		Kernel:      types.StringValue(config.Kernel),
+		RunLevel:    types.StringValue(config.RunLevel),
+		VirtMode:    types.StringValue(config.VirtMode),
+		RootDevice:  types.StringValue(config.RootDevice),
+		Comments:    types.StringValue(config.Comments),
+		MemoryLimit: types.Int64Value(int64(config.MemoryLimit)),
+	}
+
+	if config.Helpers != nil {
+		result.Helpers = []InstanceConfigHelpersModel{{
+			UpdateDBDisabled:  types.BoolValue(config.Helpers.UpdateDBDisabled),
+			Distro:            types.BoolValue(config.Helpers.Distro),
+			ModulesDep:        types.BoolValue(config.Helpers.ModulesDep),
+			Network:           types.BoolValue(config.Helpers.Network),
+			DevTmpFsAutomount: types.BoolValue(config.Helpers.DevTmpFsAutomount),
+		}}
+	}
+
+	if devices := config.Devices; devices != nil {
+		result.Devices = []InstanceConfigDevicesModel{{
+			SDA: parseInstanceConfigDevice(devices.SDA, diskLabelIDMap),
+			SDB: parseInstanceConfigDevice(devices.SDB, diskLabelIDMap),
+			SDC: parseInstanceConfigDevice(devices.SDC, diskLabelIDMap),
+			SDD: parseInstanceConfigDevice(devices.SDD, diskLabelIDMap),
+			SDE: parseInstanceConfigDevice(devices.SDE, diskLabelIDMap),
+			SDF: parseInstanceConfigDevice(devices.SDF, diskLabelIDMap),
+			SDG: parseInstanceConfigDevice(devices.SDG, diskLabelIDMap),
+			SDH: parseInstanceConfigDevice(devices.SDH, diskLabelIDMap),
+		}}
+	}
+
+	interfaces := make([]InstanceConfigInterfaceModel, len(config.Interfaces))
+	for i, iface := range config.Interfaces {
+		ipRanges, diags := types.ListValueFrom(ctx, types.StringType, iface.IPRanges)
+		if diags.HasError() {
+			return result, diags
+		}
+
+		interfaces[i] = InstanceConfigInterfaceModel{
+			Purpose:     types.StringValue(string(iface.Purpose)),
+			IPAMAddress: types.StringValue(iface.IPAMAddress),
+			Label:       types.StringValue(iface.Label),
+			ID:          types.Int64Value(int64(iface.ID)),
+			SubnetID:    intPointerValue(iface.SubnetID),
+			VPCID:       intPointerValue(iface.VPCID),
+			Primary:     types.BoolValue(iface.Primary),
+			Active:      types.BoolValue(iface.Active),
+			IPRanges:    ipRanges,
+		}
+
+		if iface.IPv4 != nil {
+			interfaces[i].IPv4 = []InstanceConfigInterfaceIPv4Model{{
+				VPC:     types.StringValue(iface.IPv4.VPC),
+				NAT1To1: types.StringPointerValue(iface.IPv4.NAT1To1),
+			}}
+		}
+	}
+
+	result.Interfaces = interfaces
+
+	return result, nil
+}
+
+func parseInstanceConfigDevice(
+	device *linodego.InstanceConfigDevice,
+	diskLabelIDMap map[int]string,
+) []InstanceConfigDeviceModel {
+	if device == nil || (device.DiskID == 0 && device.VolumeID == 0) {
+		return nil
+	}
+
+	result := InstanceConfigDeviceModel{
+		DiskLabel: types.StringNull(),
+		DiskID:    types.Int64Null(),
+		VolumeID:  types.Int64Null(),
+	}
+
+	if device.DiskID > 0 {
+		result.DiskID = types.Int64Value(int64(device.DiskID))
+
+		if label, ok := diskLabelIDMap[device.DiskID]; ok {
+			result.DiskLabel = types.StringValue(label)
+		}
+	} else {
+		result.VolumeID = types.Int64Value(int64(device.VolumeID))
+	}
+
+	return []InstanceConfigDeviceModel{result}
+}
+
+func intPointerValue(value *int) types.Int64 {
+	if value == nil {
+		return types.Int64Null()
+	}
+
+	return types.Int64Value(int64(*value))
+}
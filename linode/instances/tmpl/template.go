@@ -0,0 +1,82 @@
+package tmpl
+
+import (
+	"testing"
+
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+)
+
+type TemplateData struct {
+	Label    string
+	Image    string
+	Tag      string
+	Region   string
+	RootPass string
+
+	PlacementGroups []string
+	AssignedGroup   string
+}
+
+func DataBasic(t *testing.T, label, region string, rootPass string) string {
+	return acceptance.ExecuteTemplate(t,
+		"instances_data_basic", TemplateData{
+			Label:    label,
+			Image:    acceptance.TestImageLatest,
+			Region:   region,
+			RootPass: rootPass,
+		})
+}
+
+func DataWithPG(t *testing.T, label, region, assignedGroup string, groups []string) string {
+	return acceptance.ExecuteTemplate(t,
+		"instances_data_with_pg", TemplateData{
+			Label:           label,
+			Region:          region,
+			PlacementGroups: groups,
+			AssignedGroup:   assignedGroup,
+		})
+}
+
+func DataMultiple(t *testing.T, label, tag, region string, rootPass string) string {
+	return acceptance.ExecuteTemplate(t,
+		"instances_data_multiple", TemplateData{
+			Label:    label,
+			Tag:      tag,
+			Region:   region,
+			Image:    acceptance.TestImageLatest,
+			RootPass: rootPass,
+		})
+}
+
+func DataMultipleOrder(t *testing.T, label, tag, region string, rootPass string) string {
+	return acceptance.ExecuteTemplate(t,
+		"instances_data_multiple_order", TemplateData{
+			Label:    label,
+			Tag:      tag,
+			Image:    acceptance.TestImageLatest,
+			Region:   region,
+			RootPass: rootPass,
+		})
+}
+
+func DataMultipleRegex(t *testing.T, label, tag, region string, rootPass string) string {
+	return acceptance.ExecuteTemplate(t,
+		"instances_data_multiple_regex", TemplateData{
+			Label:    label,
+			Tag:      tag,
+			Image:    acceptance.TestImageLatest,
+			Region:   region,
+			RootPass: rootPass,
+		})
+}
+
+func DataClientFilter(t *testing.T, label, tag, region string, rootPass string) string {
+	return acceptance.ExecuteTemplate(t,
+		"instances_data_clientfilter", TemplateData{
+			Label:    label,
+			Tag:      tag,
+			Image:    acceptance.TestImageLatest,
+			Region:   region,
+			RootPass: rootPass,
+		})
+}
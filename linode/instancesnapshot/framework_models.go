@@ -0,0 +1,80 @@
+package instancesnapshot
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+type ResourceModel struct {
+	ID        types.String      `tfsdk:"id"`
+	LinodeID  types.Int64       `tfsdk:"linode_id"`
+	Label     types.String      `tfsdk:"label"`
+	Status    types.String      `tfsdk:"status"`
+	Type      types.String      `tfsdk:"type"`
+	Created   timetypes.RFC3339 `tfsdk:"created"`
+	Updated   timetypes.RFC3339 `tfsdk:"updated"`
+	Finished  timetypes.RFC3339 `tfsdk:"finished"`
+	Configs   types.List        `tfsdk:"configs"`
+	Disks     types.List        `tfsdk:"disks"`
+	Available types.Bool        `tfsdk:"available"`
+	Timeouts  timeouts.Value    `tfsdk:"timeouts"`
+}
+
+type DiskModel struct {
+	Label      types.String `tfsdk:"label"`
+	Size       types.Int64  `tfsdk:"size"`
+	Filesystem types.String `tfsdk:"filesystem"`
+}
+
+func (data *ResourceModel) FlattenSnapshot(
+	ctx context.Context,
+	snapshot *linodego.InstanceSnapshot,
+	preserveKnown bool,
+) diag.Diagnostics {
+	data.ID = helper.KeepOrUpdateString(data.ID, strconv.Itoa(snapshot.ID), preserveKnown)
+	data.Label = helper.KeepOrUpdateString(data.Label, snapshot.Label, preserveKnown)
+	data.Status = helper.KeepOrUpdateString(data.Status, string(snapshot.Status), preserveKnown)
+	data.Type = helper.KeepOrUpdateString(data.Type, snapshot.Type, preserveKnown)
+	data.Created = helper.KeepOrUpdateValue(
+		data.Created, timetypes.NewRFC3339TimePointerValue(snapshot.Created), preserveKnown,
+	)
+	data.Updated = helper.KeepOrUpdateValue(
+		data.Updated, timetypes.NewRFC3339TimePointerValue(snapshot.Updated), preserveKnown,
+	)
+	data.Finished = helper.KeepOrUpdateValue(
+		data.Finished, timetypes.NewRFC3339TimePointerValue(snapshot.Finished), preserveKnown,
+	)
+	data.Available = helper.KeepOrUpdateBool(data.Available, snapshot.Available, preserveKnown)
+
+	configs, diags := types.ListValueFrom(ctx, types.StringType, snapshot.Configs)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.Configs = helper.KeepOrUpdateValue(data.Configs, configs, preserveKnown)
+
+	disks := make([]DiskModel, len(snapshot.Disks))
+	for i, disk := range snapshot.Disks {
+		disks[i] = DiskModel{
+			Label:      types.StringValue(disk.Label),
+			Size:       types.Int64Value(int64(disk.Size)),
+			Filesystem: types.StringValue(disk.Filesystem),
+		}
+	}
+
+	disksList, diags := types.ListValueFrom(ctx, diskObjectType, disks)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.Disks = helper.KeepOrUpdateValue(data.Disks, disksList, preserveKnown)
+
+	return nil
+}
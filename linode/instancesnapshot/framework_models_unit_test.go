@@ -0,0 +1,93 @@
+//go:build unit
+
+package instancesnapshot
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlattenSnapshot(t *testing.T) {
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	finished := created.Add(10 * time.Minute)
+
+	snapshot := &linodego.InstanceSnapshot{
+		ID:        123,
+		Label:     "pre-change",
+		Status:    linodego.SnapshotSuccessful,
+		Type:      "snapshot",
+		Created:   &created,
+		Updated:   &finished,
+		Finished:  &finished,
+		Configs:   []string{"My Debian 12 Disk Profile"},
+		Available: true,
+		Disks: []*linodego.InstanceSnapshotDisk{
+			{
+				Label:      "Debian 12 Disk",
+				Size:       25000,
+				Filesystem: "ext4",
+			},
+		},
+	}
+
+	data := ResourceModel{
+		LinodeID: types.Int64Value(456),
+	}
+
+	diags := data.FlattenSnapshot(context.Background(), snapshot, false)
+	require.False(t, diags.HasError())
+
+	assert.Equal(t, types.StringValue("123"), data.ID)
+	assert.Equal(t, types.Int64Value(456), data.LinodeID)
+	assert.Equal(t, types.StringValue("pre-change"), data.Label)
+	assert.Equal(t, types.StringValue("successful"), data.Status)
+	assert.Equal(t, types.StringValue("snapshot"), data.Type)
+	assert.Equal(t, timetypes.NewRFC3339TimeValue(created), data.Created)
+	assert.Equal(t, timetypes.NewRFC3339TimeValue(finished), data.Finished)
+	assert.Equal(t, types.BoolValue(true), data.Available)
+
+	var configs []string
+	require.False(t, data.Configs.ElementsAs(context.Background(), &configs, false).HasError())
+	assert.Equal(t, []string{"My Debian 12 Disk Profile"}, configs)
+
+	var disks []DiskModel
+	require.False(t, data.Disks.ElementsAs(context.Background(), &disks, false).HasError())
+	assert.Equal(t, []DiskModel{
+		{
+			Label:      types.StringValue("Debian 12 Disk"),
+			Size:       types.Int64Value(25000),
+			Filesystem: types.StringValue("ext4"),
+		},
+	}, disks)
+}
+
+func TestFlattenSnapshotPreserveKnown(t *testing.T) {
+	snapshot := &linodego.InstanceSnapshot{
+		ID:     123,
+		Label:  "pre-change",
+		Status: linodego.SnapshotSuccessful,
+	}
+
+	data := ResourceModel{
+		ID:      types.StringUnknown(),
+		Label:   types.StringValue("pre-change"),
+		Status:  types.StringUnknown(),
+		Created: timetypes.NewRFC3339Unknown(),
+		Disks:   types.ListUnknown(diskObjectType),
+	}
+
+	diags := data.FlattenSnapshot(context.Background(), snapshot, true)
+	require.False(t, diags.HasError())
+
+	assert.Equal(t, types.StringValue("123"), data.ID)
+	assert.Equal(t, types.StringValue("successful"), data.Status)
+	assert.True(t, data.Created.IsNull())
+	assert.Equal(t, 0, len(data.Disks.Elements()))
+}
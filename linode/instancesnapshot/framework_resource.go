@@ -0,0 +1,264 @@
+package instancesnapshot
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+const (
+	DefaultCreateTimeout = 30 * time.Minute
+)
+
+func NewResource() resource.Resource {
+	return &Resource{
+		BaseResource: helper.NewBaseResource(
+			helper.BaseResourceConfig{
+				Name:   "linode_instance_snapshot",
+				IDType: types.StringType,
+				Schema: &frameworkResourceSchema,
+				TimeoutOpts: &timeouts.Opts{
+					Create: true,
+				},
+			},
+		),
+	}
+}
+
+type Resource struct {
+	helper.BaseResource
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	tflog.Debug(ctx, "Create "+r.Config.Name)
+
+	var plan ResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, plan)
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	client := r.Meta.Client
+
+	timeoutSeconds := helper.FrameworkSafeFloat64ToInt(createTimeout.Seconds(), &resp.Diagnostics)
+	linodeID := helper.FrameworkSafeInt64ToInt(plan.LinodeID.ValueInt64(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	p, err := r.Meta.EventWatcher.NewEventWaiter(
+		ctx, linodeID, linodego.EntityLinode, linodego.ActionLinodeSnapshot,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Initialize Event Waiter", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "client.CreateInstanceSnapshot(...)", map[string]any{
+		"label": plan.Label.ValueString(),
+	})
+
+	snapshot, err := client.CreateInstanceSnapshot(ctx, linodeID, plan.Label.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Create Snapshot of Linode Instance %d", linodeID),
+			err.Error(),
+		)
+		return
+	}
+
+	// Add resource to TF states earlier to prevent
+	// dangling resources (resources created but not managed by TF)
+	resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(strconv.Itoa(snapshot.ID)))
+	resp.State.SetAttribute(ctx, path.Root("linode_id"), plan.LinodeID)
+
+	tflog.Debug(ctx, "Waiting for snapshot to finish", map[string]any{
+		"snapshot_id": snapshot.ID,
+	})
+
+	if _, err := p.WaitForFinished(ctx, timeoutSeconds); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Wait for Snapshot %d of Linode Instance %d to Finish", snapshot.ID, linodeID),
+			err.Error(),
+		)
+		return
+	}
+
+	snapshot, err = client.GetInstanceSnapshot(ctx, linodeID, snapshot.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Get Snapshot of Linode Instance %d", linodeID),
+			err.Error(),
+		)
+		return
+	}
+
+	// IDs should always be overridden during creation (see #1085)
+	// TODO: Remove when Crossplane empty string ID issue is resolved
+	plan.ID = types.StringValue(strconv.Itoa(snapshot.ID))
+
+	resp.Diagnostics.Append(plan.FlattenSnapshot(ctx, snapshot, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	tflog.Debug(ctx, "Read "+r.Config.Name)
+
+	var state ResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if helper.FrameworkAttemptRemoveResourceForEmptyID(ctx, state.ID, resp) {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, state)
+
+	linodeID, id := getIDs(state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	snapshot, err := r.Meta.Client.GetInstanceSnapshot(ctx, linodeID, id)
+	if err != nil {
+		if linodego.IsNotFound(err) {
+			// Taking a new snapshot of a Linode replaces its previous snapshot
+			resp.Diagnostics.AddWarning(
+				"Snapshot Not Found",
+				fmt.Sprintf(
+					"Removing snapshot %d of Linode %d from state because it no longer exists",
+					id, linodeID,
+				),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Get Snapshot %d of Linode Instance %d", id, linodeID),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(state.FlattenSnapshot(ctx, snapshot, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	tflog.Debug(ctx, "Update "+r.Config.Name)
+
+	var plan, state ResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// All other attributes require replacement, so only the timeouts can be updated
+	state.Timeouts = plan.Timeouts
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	tflog.Debug(ctx, "Delete "+r.Config.Name)
+
+	var state ResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, state)
+
+	// Snapshots can't be deleted through the API; they are replaced by the
+	// next snapshot of the Linode or removed when its backups are cancelled.
+	tflog.Info(ctx, "Removing snapshot from state without deleting it")
+}
+
+func (r *Resource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	tflog.Debug(ctx, "Import "+r.Config.Name)
+
+	helper.ImportStateWithMultipleIDs(
+		ctx, req, resp,
+		[]helper.ImportableID{
+			{
+				Name:          "linode_id",
+				TypeConverter: helper.IDTypeConverterInt64,
+			},
+			{
+				Name:          "id",
+				TypeConverter: helper.IDTypeConverterString,
+			},
+		},
+	)
+}
+
+func getIDs(data ResourceModel, diags *diag.Diagnostics) (int, int) {
+	linodeID := helper.FrameworkSafeInt64ToInt(data.LinodeID.ValueInt64(), diags)
+	id := helper.FrameworkSafeStringToInt(data.ID.ValueString(), diags)
+	return linodeID, id
+}
+
+func populateLogAttributes(ctx context.Context, model ResourceModel) context.Context {
+	return helper.SetLogFieldBulk(ctx, map[string]any{
+		"linode_id":   model.LinodeID.ValueInt64(),
+		"snapshot_id": model.ID.ValueString(),
+	})
+}
@@ -0,0 +1,87 @@
+package instancesnapshot
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var diskObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"label":      types.StringType,
+		"size":       types.Int64Type,
+		"filesystem": types.StringType,
+	},
+}
+
+var frameworkResourceSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The ID of the snapshot.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"linode_id": schema.Int64Attribute{
+			Description: "The ID of the Linode to take a snapshot of.",
+			Required:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"label": schema.StringAttribute{
+			Description: "The label of the snapshot.",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(1, 255),
+			},
+		},
+		"status": schema.StringAttribute{
+			Description: "The status of the snapshot.",
+			Computed:    true,
+		},
+		"type": schema.StringAttribute{
+			Description: "The type of the backup, which is always 'snapshot' for manual snapshots.",
+			Computed:    true,
+		},
+		"created": schema.StringAttribute{
+			Description: "When the snapshot was taken.",
+			Computed:    true,
+			CustomType:  timetypes.RFC3339Type{},
+		},
+		"updated": schema.StringAttribute{
+			Description: "When the snapshot was last updated.",
+			Computed:    true,
+			CustomType:  timetypes.RFC3339Type{},
+		},
+		"finished": schema.StringAttribute{
+			Description: "When the snapshot was finished.",
+			Computed:    true,
+			CustomType:  timetypes.RFC3339Type{},
+		},
+		"configs": schema.ListAttribute{
+			Description: "The labels of the Configuration profiles that are part of the snapshot.",
+			Computed:    true,
+			ElementType: types.StringType,
+		},
+		"disks": schema.ListAttribute{
+			Description: "The disks that are part of the snapshot.",
+			Computed:    true,
+			ElementType: diskObjectType,
+		},
+		"available": schema.BoolAttribute{
+			Description: "Whether the snapshot is available for restoring.",
+			Computed:    true,
+		},
+	},
+}
@@ -0,0 +1,20 @@
+package tmpl
+
+import (
+	"testing"
+
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+)
+
+type TemplateData struct {
+	Label  string
+	Region string
+}
+
+func Basic(t *testing.T, label, region string) string {
+	return acceptance.ExecuteTemplate(t,
+		"instance_snapshot_basic", TemplateData{
+			Label:  label,
+			Region: region,
+		})
+}
@@ -0,0 +1,71 @@
+//go:build integration || instancestats
+
+package instancestats_test
+
+import (
+	"log"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+	"github.com/linode/terraform-provider-linode/v2/linode/instancestats/tmpl"
+)
+
+const (
+	testInstanceStatsResName       = "data.linode_instance_stats.test"
+	testInstanceStatsByDateResName = "data.linode_instance_stats.by_date"
+)
+
+var testRegion string
+
+func init() {
+	region, err := acceptance.GetRandomRegionWithCaps([]string{"Linodes"}, "core")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	testRegion = region
+}
+
+func TestAccDataSourceInstanceStats_basic(t *testing.T) {
+	t.Parallel()
+
+	var instance linodego.Instance
+
+	name := acctest.RandomWithPrefix("tf_test")
+	now := time.Now().UTC()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.PreCheck(t) },
+		ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+		CheckDestroy:             acceptance.CheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: tmpl.DataBasic(t, name, testRegion, now.Year(), int(now.Month())),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.CheckInstanceExists("linode_instance.foobar", &instance),
+					resource.TestCheckResourceAttrSet(testInstanceStatsResName, "id"),
+					resource.TestCheckResourceAttrSet(testInstanceStatsResName, "cpu.#"),
+					resource.TestCheckResourceAttr(testInstanceStatsResName, "io.#", "1"),
+					resource.TestCheckResourceAttrSet(testInstanceStatsResName, "io.0.io.#"),
+					resource.TestCheckResourceAttrSet(testInstanceStatsResName, "io.0.swap.#"),
+					resource.TestCheckResourceAttr(testInstanceStatsResName, "netv4.#", "1"),
+					resource.TestCheckResourceAttrSet(testInstanceStatsResName, "netv4.0.in.#"),
+					resource.TestCheckResourceAttrSet(testInstanceStatsResName, "netv4.0.out.#"),
+					resource.TestCheckResourceAttr(testInstanceStatsResName, "netv6.#", "1"),
+					resource.TestCheckResourceAttrSet(testInstanceStatsResName, "netv6.0.private_in.#"),
+					resource.TestCheckResourceAttrSet(testInstanceStatsResName, "netv6.0.private_out.#"),
+
+					resource.TestCheckResourceAttrSet(testInstanceStatsByDateResName, "id"),
+					resource.TestCheckResourceAttrSet(testInstanceStatsByDateResName, "cpu.#"),
+					resource.TestCheckResourceAttr(testInstanceStatsByDateResName, "io.#", "1"),
+					resource.TestCheckResourceAttr(testInstanceStatsByDateResName, "netv4.#", "1"),
+					resource.TestCheckResourceAttr(testInstanceStatsByDateResName, "netv6.#", "1"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,115 @@
+package instancestats
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+func NewDataSource() datasource.DataSource {
+	return &DataSource{
+		BaseDataSource: helper.NewBaseDataSource(
+			helper.BaseDataSourceConfig{
+				Name:   "linode_instance_stats",
+				Schema: &frameworkDatasourceSchema,
+			},
+		),
+	}
+}
+
+type DataSource struct {
+	helper.BaseDataSource
+}
+
+func (d *DataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	tflog.Debug(ctx, "Read data.linode_instance_stats")
+
+	client := d.Meta.Client
+
+	var data DataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	linodeID := helper.FrameworkSafeInt64ToInt(
+		data.LinodeID.ValueInt64(),
+		&resp.Diagnostics,
+	)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var stats *linodego.InstanceStats
+	var err error
+
+	if data.Year.IsNull() {
+		tflog.Trace(ctx, "client.GetInstanceStats(...)")
+
+		stats, err = client.GetInstanceStats(ctx, linodeID)
+	} else {
+		year := helper.FrameworkSafeInt64ToInt(data.Year.ValueInt64(), &resp.Diagnostics)
+		month := helper.FrameworkSafeInt64ToInt(data.Month.ValueInt64(), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		tflog.Trace(ctx, "client.GetInstanceStatsByDate(...)", map[string]any{
+			"year":  year,
+			"month": month,
+		})
+
+		stats, err = client.GetInstanceStatsByDate(ctx, linodeID, year, month)
+	}
+
+	if err != nil {
+		// Statistics are not available for a short while after a Linode has
+		// been created, which shouldn't prevent the data source from being read.
+		if !isStatsUnavailableError(err) {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed to Get Statistics of Linode %d", linodeID), err.Error(),
+			)
+			return
+		}
+
+		resp.Diagnostics.AddWarning(
+			fmt.Sprintf("Statistics of Linode %d Unavailable", linodeID), err.Error(),
+		)
+
+		stats = &linodego.InstanceStats{}
+	}
+
+	data.parseInstanceStats(stats, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// isStatsUnavailableError returns whether the given error was returned
+// because the statistics of a Linode aren't available yet.
+func isStatsUnavailableError(err error) bool {
+	if !linodego.ErrHasStatus(err, http.StatusBadRequest) {
+		return false
+	}
+
+	var lerr *linodego.Error
+	if !errors.As(err, &lerr) {
+		return false
+	}
+
+	return strings.Contains(strings.ToLower(lerr.Message), "stats are unavailable")
+}
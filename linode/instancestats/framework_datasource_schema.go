@@ -0,0 +1,87 @@
+package instancestats
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var pointObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"timestamp": types.Int64Type,
+		"value":     types.Float64Type,
+	},
+}
+
+var ioObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"io":   types.ListType{ElemType: pointObjectType},
+		"swap": types.ListType{ElemType: pointObjectType},
+	},
+}
+
+var netObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"in":          types.ListType{ElemType: pointObjectType},
+		"out":         types.ListType{ElemType: pointObjectType},
+		"private_in":  types.ListType{ElemType: pointObjectType},
+		"private_out": types.ListType{ElemType: pointObjectType},
+	},
+}
+
+var frameworkDatasourceSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"linode_id": schema.Int64Attribute{
+			Description: "The ID of the Linode to get the statistics of.",
+			Required:    true,
+		},
+		"year": schema.Int64Attribute{
+			Description: "The year to get the statistics of. " +
+				"If neither year nor month is set, the statistics of the last 24 hours are returned.",
+			Optional: true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+				int64validator.AlsoRequires(path.MatchRoot("month")),
+			},
+		},
+		"month": schema.Int64Attribute{
+			Description: "The month to get the statistics of.",
+			Optional:    true,
+			Validators: []validator.Int64{
+				int64validator.Between(1, 12),
+				int64validator.AlsoRequires(path.MatchRoot("year")),
+			},
+		},
+		"title": schema.StringAttribute{
+			Description: "The title of the statistics graph.",
+			Computed:    true,
+		},
+		"cpu": schema.ListAttribute{
+			Description: "The CPU usage of the Linode in percent, as timestamp (in milliseconds) and value pairs.",
+			Computed:    true,
+			ElementType: pointObjectType,
+		},
+		"io": schema.ListAttribute{
+			Description: "The disk IO and swap usage of the Linode in blocks per second.",
+			Computed:    true,
+			ElementType: ioObjectType,
+		},
+		"netv4": schema.ListAttribute{
+			Description: "The public and private IPv4 network traffic of the Linode in bits per second.",
+			Computed:    true,
+			ElementType: netObjectType,
+		},
+		"netv6": schema.ListAttribute{
+			Description: "The public and private IPv6 network traffic of the Linode in bits per second.",
+			Computed:    true,
+			ElementType: netObjectType,
+		},
+		"id": schema.StringAttribute{
+			Description: "Unique identifier for this DataSource.",
+			Computed:    true,
+		},
+	},
+}
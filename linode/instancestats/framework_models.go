@@ -0,0 +1,98 @@
+package instancestats
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+type DataSourceModel struct {
+	LinodeID types.Int64  `tfsdk:"linode_id"`
+	Year     types.Int64  `tfsdk:"year"`
+	Month    types.Int64  `tfsdk:"month"`
+	Title    types.String `tfsdk:"title"`
+	CPU      types.List   `tfsdk:"cpu"`
+	IO       types.List   `tfsdk:"io"`
+	NetV4    types.List   `tfsdk:"netv4"`
+	NetV6    types.List   `tfsdk:"netv6"`
+	ID       types.String `tfsdk:"id"`
+}
+
+func (data *DataSourceModel) parseInstanceStats(stats *linodego.InstanceStats, diags *diag.Diagnostics) {
+	data.Title = types.StringValue(stats.Title)
+
+	data.CPU = flattenSeries(stats.Data.CPU, diags)
+	if diags.HasError() {
+		return
+	}
+
+	data.IO = helper.MapToSingleObjList(ioObjectType, map[string]attr.Value{
+		"io":   flattenSeries(stats.Data.IO.IO, diags),
+		"swap": flattenSeries(stats.Data.IO.Swap, diags),
+	}, diags)
+	if diags.HasError() {
+		return
+	}
+
+	data.NetV4 = flattenNet(stats.Data.NetV4, diags)
+	if diags.HasError() {
+		return
+	}
+
+	data.NetV6 = flattenNet(stats.Data.NetV6, diags)
+	if diags.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(data.generateID())
+}
+
+func (data *DataSourceModel) generateID() string {
+	if data.Year.IsNull() {
+		return fmt.Sprintf("%d", data.LinodeID.ValueInt64())
+	}
+
+	return fmt.Sprintf(
+		"%d:%d-%02d", data.LinodeID.ValueInt64(), data.Year.ValueInt64(), data.Month.ValueInt64(),
+	)
+}
+
+func flattenNet(net linodego.StatsNet, diags *diag.Diagnostics) types.List {
+	return helper.MapToSingleObjList(netObjectType, map[string]attr.Value{
+		"in":          flattenSeries(net.In, diags),
+		"out":         flattenSeries(net.Out, diags),
+		"private_in":  flattenSeries(net.PrivateIn, diags),
+		"private_out": flattenSeries(net.PrivateOut, diags),
+	}, diags)
+}
+
+func flattenSeries(series [][]float64, diags *diag.Diagnostics) types.List {
+	// Series missing from the response are flattened to empty lists
+	// so they can always be iterated over
+	if series == nil {
+		series = [][]float64{}
+	}
+
+	return helper.GenericSliceToList(series, pointObjectType, flattenPoint, diags)
+}
+
+func flattenPoint(point []float64) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if len(point) != 2 {
+		diags.AddError(
+			"Invalid Statistics Data Point",
+			fmt.Sprintf("expected a timestamp and value pair, got %v", point),
+		)
+		return types.ObjectNull(pointObjectType.AttrTypes), diags
+	}
+
+	return types.ObjectValue(pointObjectType.AttrTypes, map[string]attr.Value{
+		"timestamp": types.Int64Value(int64(point[0])),
+		"value":     types.Float64Value(point[1]),
+	})
+}
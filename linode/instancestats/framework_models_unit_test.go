@@ -0,0 +1,90 @@
+//go:build unit
+
+package instancestats
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseInstanceStats(t *testing.T) {
+	stats := &linodego.InstanceStats{
+		Title: "linode.com - my-linode (linode123456) - day (5 min avg)",
+		Data: linodego.InstanceStatsData{
+			CPU: [][]float64{
+				{1521483600000, 0.42},
+				{1521483900000, 0.5},
+			},
+			IO: linodego.StatsIO{
+				IO:   [][]float64{{1521484800000, 0.19}},
+				Swap: [][]float64{{1521484800000, 0}},
+			},
+			NetV4: linodego.StatsNet{
+				In:  [][]float64{{1521484800000, 2004.36}},
+				Out: [][]float64{{1521484800000, 3928.91}},
+			},
+		},
+	}
+
+	data := &DataSourceModel{
+		LinodeID: types.Int64Value(123),
+		Year:     types.Int64Value(2024),
+		Month:    types.Int64Value(5),
+	}
+
+	var diags diag.Diagnostics
+	data.parseInstanceStats(stats, &diags)
+
+	assert.False(t, diags.HasError())
+
+	assert.Equal(t, types.StringValue(stats.Title), data.Title)
+	assert.Equal(t, types.StringValue("123:2024-05"), data.ID)
+
+	assert.Len(t, data.CPU.Elements(), 2)
+	assert.Contains(t, data.CPU.String(), "1521483600000")
+	assert.Contains(t, data.CPU.String(), "0.42")
+
+	assert.Len(t, data.IO.Elements(), 1)
+	assert.Contains(t, data.IO.String(), "0.19")
+
+	assert.Len(t, data.NetV4.Elements(), 1)
+	assert.Contains(t, data.NetV4.String(), "2004.36")
+	assert.Contains(t, data.NetV4.String(), "3928.91")
+
+	// Series missing from the response are empty rather than null
+	assert.Len(t, data.NetV6.Elements(), 1)
+	assert.Contains(t, data.NetV6.String(), `"in":[]`)
+}
+
+func TestParseInstanceStats_invalidPoint(t *testing.T) {
+	stats := &linodego.InstanceStats{
+		Data: linodego.InstanceStatsData{
+			CPU: [][]float64{{1521483600000}},
+		},
+	}
+
+	data := &DataSourceModel{
+		LinodeID: types.Int64Value(123),
+	}
+
+	var diags diag.Diagnostics
+	data.parseInstanceStats(stats, &diags)
+
+	assert.True(t, diags.HasError())
+}
+
+func TestIsStatsUnavailableError(t *testing.T) {
+	unavailable := &linodego.Error{Code: 400, Message: "Stats are unavailable at this time."}
+
+	assert.True(t, isStatsUnavailableError(unavailable))
+	assert.True(t, isStatsUnavailableError(fmt.Errorf("wrapped: %w", unavailable)))
+
+	assert.False(t, isStatsUnavailableError(&linodego.Error{Code: 400, Message: "Invalid month"}))
+	assert.False(t, isStatsUnavailableError(&linodego.Error{Code: 404, Message: "Not found"}))
+	assert.False(t, isStatsUnavailableError(fmt.Errorf("connection reset")))
+}
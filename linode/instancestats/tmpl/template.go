@@ -0,0 +1,24 @@
+package tmpl
+
+import (
+	"testing"
+
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+)
+
+type TemplateData struct {
+	Label  string
+	Region string
+	Year   int
+	Month  int
+}
+
+func DataBasic(t *testing.T, instanceLabel, region string, year, month int) string {
+	return acceptance.ExecuteTemplate(t,
+		"instance_stats_data_basic", TemplateData{
+			Label:  instanceLabel,
+			Region: region,
+			Year:   year,
+			Month:  month,
+		})
+}
@@ -0,0 +1,56 @@
+//go:build integration || instancetransfer
+
+package instancetransfer_test
+
+import (
+	"log"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+	"github.com/linode/terraform-provider-linode/v2/linode/instancetransfer/tmpl"
+)
+
+const testInstanceTransferResName = "data.linode_instance_transfer.test"
+
+var testRegion string
+
+func init() {
+	region, err := acceptance.GetRandomRegionWithCaps([]string{"Linodes"}, "core")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	testRegion = region
+}
+
+func TestAccDataSourceInstanceTransfer_basic(t *testing.T) {
+	t.Parallel()
+
+	var instance linodego.Instance
+
+	name := acctest.RandomWithPrefix("tf_test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.PreCheck(t) },
+		ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+		CheckDestroy:             acceptance.CheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: tmpl.DataBasic(t, name, testRegion),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.CheckInstanceExists("linode_instance.foobar", &instance),
+					resource.TestCheckResourceAttrPair(
+						testInstanceTransferResName, "id",
+						"linode_instance.foobar", "id",
+					),
+					resource.TestCheckResourceAttrSet(testInstanceTransferResName, "used"),
+					resource.TestCheckResourceAttrSet(testInstanceTransferResName, "quota"),
+					resource.TestCheckResourceAttrSet(testInstanceTransferResName, "billable"),
+				),
+			},
+		},
+	})
+}
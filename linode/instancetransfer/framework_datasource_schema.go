@@ -0,0 +1,32 @@
+package instancetransfer
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+var frameworkDatasourceSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"linode_id": schema.Int64Attribute{
+			Description: "The ID of the Linode to get the network transfer of.",
+			Required:    true,
+		},
+		"used": schema.Int64Attribute{
+			Description: "The amount of network transfer this Linode has used during the current billing month, " +
+				"in bytes.",
+			Computed: true,
+		},
+		"quota": schema.Int64Attribute{
+			Description: "The amount of network transfer this Linode adds to the transfer pool, in GB.",
+			Computed:    true,
+		},
+		"billable": schema.Int64Attribute{
+			Description: "The amount of billable network transfer this Linode has used during the current " +
+				"billing month, in GB.",
+			Computed: true,
+		},
+		"id": schema.StringAttribute{
+			Description: "Unique identifier for this DataSource.",
+			Computed:    true,
+		},
+	},
+}
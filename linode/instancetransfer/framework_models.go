@@ -0,0 +1,24 @@
+package instancetransfer
+
+import (
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+)
+
+type DataSourceModel struct {
+	LinodeID types.Int64  `tfsdk:"linode_id"`
+	Used     types.Int64  `tfsdk:"used"`
+	Quota    types.Int64  `tfsdk:"quota"`
+	Billable types.Int64  `tfsdk:"billable"`
+	ID       types.String `tfsdk:"id"`
+}
+
+func (data *DataSourceModel) parseInstanceTransfer(transfer *linodego.InstanceTransfer) {
+	data.Used = types.Int64Value(int64(transfer.Used))
+	data.Quota = types.Int64Value(int64(transfer.Quota))
+	data.Billable = types.Int64Value(int64(transfer.Billable))
+
+	data.ID = types.StringValue(strconv.FormatInt(data.LinodeID.ValueInt64(), 10))
+}
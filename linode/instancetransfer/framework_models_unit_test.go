@@ -0,0 +1,30 @@
+//go:build unit
+
+package instancetransfer
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseInstanceTransfer(t *testing.T) {
+	transfer := &linodego.InstanceTransfer{
+		Used:     2147483648,
+		Quota:    1000,
+		Billable: 3,
+	}
+
+	data := &DataSourceModel{
+		LinodeID: types.Int64Value(123),
+	}
+
+	data.parseInstanceTransfer(transfer)
+
+	assert.Equal(t, types.Int64Value(2147483648), data.Used)
+	assert.Equal(t, types.Int64Value(1000), data.Quota)
+	assert.Equal(t, types.Int64Value(3), data.Billable)
+	assert.Equal(t, types.StringValue("123"), data.ID)
+}
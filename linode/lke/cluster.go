@@ -3,7 +3,9 @@ package lke
 import (
 	"context"
 	"fmt"
+	"maps"
 	"reflect"
+	"slices"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -21,8 +23,13 @@ type NodePoolSpec struct {
 	AutoScalerEnabled bool
 	AutoScalerMin     int
 	AutoScalerMax     int
+	Labels            map[string]string
+	Taints            []linodego.LKENodePoolTaint
 }
 
+// NodePoolUpdates contains the changes needed to reconcile the node pools of a cluster.
+// Pools in ToCreate may replace pools in ToDelete, so they should be ready before
+// any pools are deleted.
 type NodePoolUpdates struct {
 	ToDelete []int
 	ToCreate []linodego.LKENodePoolCreateOptions
@@ -40,9 +47,11 @@ func ReconcileLKENodePoolSpecs(
 
 	createPool := func(spec NodePoolSpec) error {
 		createOpts := linodego.LKENodePoolCreateOptions{
-			Count: spec.Count,
-			Type:  spec.Type,
-			Tags:  spec.Tags,
+			Count:  spec.Count,
+			Type:   spec.Type,
+			Tags:   spec.Tags,
+			Labels: spec.Labels,
+			Taints: spec.Taints,
 		}
 
 		if createOpts.Count == 0 {
@@ -127,6 +136,26 @@ func ReconcileLKENodePoolSpecs(
 			}
 		}
 
+		// Labels and taints are only included if they have been updated
+		// so that they aren't reset to null when not configured
+		if !maps.Equal(newSpec.Labels, oldSpec.Labels) {
+			labels := linodego.LKENodePoolLabels(newSpec.Labels)
+			if labels == nil {
+				labels = linodego.LKENodePoolLabels{}
+			}
+
+			updateOpts.Labels = &labels
+		}
+
+		if !slices.Equal(newSpec.Taints, oldSpec.Taints) {
+			taints := newSpec.Taints
+			if taints == nil {
+				taints = []linodego.LKENodePoolTaint{}
+			}
+
+			updateOpts.Taints = &taints
+		}
+
 		result.ToUpdate[oldSpec.ID] = updateOpts
 	}
 
@@ -342,6 +371,14 @@ func matchPoolsWithSchema(pools []linodego.LKENodePool, declaredPools []interfac
 				continue
 			}
 
+			if !maps.Equal(expandLinodeLKENodePoolLabels(declaredPool), apiPool.Labels) {
+				continue
+			}
+
+			if !compareLKENodePoolTaints(expandLinodeLKENodePoolTaints(declaredPool), apiPool.Taints) {
+				continue
+			}
+
 			// Pair the API pool with the declared pool
 			result[i] = apiPool
 			delete(apiPools, apiPool.ID)
@@ -375,6 +412,61 @@ func expandLinodeLKEClusterAutoscalerFromPool(pool map[string]interface{}) *lino
 	}
 }
 
+func expandLinodeLKENodePoolLabels(pool map[string]interface{}) map[string]string {
+	labelsSpec, ok := pool["labels"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	labels := make(map[string]string, len(labelsSpec))
+	for k, v := range labelsSpec {
+		labels[k] = v.(string)
+	}
+
+	return labels
+}
+
+func expandLinodeLKENodePoolTaints(pool map[string]interface{}) []linodego.LKENodePoolTaint {
+	taintsSpec, ok := pool["taint"].(*schema.Set)
+	if !ok {
+		return nil
+	}
+
+	taints := make([]linodego.LKENodePoolTaint, taintsSpec.Len())
+	for i, taint := range taintsSpec.List() {
+		taintSpec := taint.(map[string]interface{})
+		taints[i] = linodego.LKENodePoolTaint{
+			Effect: linodego.LKENodePoolTaintEffect(taintSpec["effect"].(string)),
+			Key:    taintSpec["key"].(string),
+			Value:  taintSpec["value"].(string),
+		}
+	}
+
+	return taints
+}
+
+// compareLKENodePoolTaints returns whether the given taints
+// are equal regardless of their order.
+func compareLKENodePoolTaints(a, b []linodego.LKENodePoolTaint) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	taints := make(map[linodego.LKENodePoolTaint]int, len(a))
+	for _, taint := range a {
+		taints[taint]++
+	}
+
+	for _, taint := range b {
+		if taints[taint] < 1 {
+			return false
+		}
+		taints[taint]--
+	}
+
+	return true
+}
+
 func expandLinodeLKENodePoolSpecs(pool []interface{}, preserveNoTarget bool) (poolSpecs []NodePoolSpec) {
 	for _, spec := range pool {
 		specMap := spec.(map[string]interface{})
@@ -399,6 +491,8 @@ func expandLinodeLKENodePoolSpecs(pool []interface{}, preserveNoTarget bool) (po
 			AutoScalerEnabled: autoscaler.Enabled,
 			AutoScalerMin:     autoscaler.Min,
 			AutoScalerMax:     autoscaler.Max,
+			Labels:            expandLinodeLKENodePoolLabels(specMap),
+			Taints:            expandLinodeLKENodePoolTaints(specMap),
 		})
 	}
 	return
@@ -436,6 +530,20 @@ func flattenLKENodePools(pools []linodego.LKENodePool) []map[string]interface{}
 			"disk_encryption": pool.DiskEncryption,
 			"nodes":           nodes,
 			"autoscaler":      autoscaler,
+			"labels":          pool.Labels,
+			"taint":           flattenLKENodePoolTaints(pool.Taints),
+		}
+	}
+	return flattened
+}
+
+func flattenLKENodePoolTaints(taints []linodego.LKENodePoolTaint) []map[string]interface{} {
+	flattened := make([]map[string]interface{}, len(taints))
+	for i, taint := range taints {
+		flattened[i] = map[string]interface{}{
+			"effect": string(taint.Effect),
+			"key":    taint.Key,
+			"value":  taint.Value,
 		}
 	}
 	return flattened
@@ -143,6 +143,84 @@ func TestReconcileLKENodePoolSpecs(t *testing.T) {
 			expectedToDelete: []int{},
 			expectedToCreate: []linodego.LKENodePoolCreateOptions{},
 		},
+		{
+			name: "labels and taints update",
+			oldSpecs: []lke.NodePoolSpec{
+				{ID: 123, Type: "g6-standard-1", Count: 2, Labels: map[string]string{}},
+			},
+			newSpecs: []lke.NodePoolSpec{
+				{
+					ID: 123, Type: "g6-standard-1", Count: 2, Tags: []string{"example"},
+					Labels: map[string]string{"foo": "bar"},
+					Taints: []linodego.LKENodePoolTaint{
+						{Effect: linodego.LKENodePoolTaintEffectNoSchedule, Key: "foo", Value: "bar"},
+					},
+				},
+			},
+			expectedToUpdate: map[int]linodego.LKENodePoolUpdateOptions{
+				123: {
+					Count:  2,
+					Tags:   &[]string{"example"},
+					Labels: &linodego.LKENodePoolLabels{"foo": "bar"},
+					Taints: &[]linodego.LKENodePoolTaint{
+						{Effect: linodego.LKENodePoolTaintEffectNoSchedule, Key: "foo", Value: "bar"},
+					},
+				},
+			},
+			expectedToCreate: []linodego.LKENodePoolCreateOptions{},
+			expectedToDelete: []int{},
+		},
+		{
+			name: "labels and taints drop",
+			oldSpecs: []lke.NodePoolSpec{
+				{
+					ID: 123, Type: "g6-standard-1", Count: 2,
+					Labels: map[string]string{"foo": "bar"},
+					Taints: []linodego.LKENodePoolTaint{
+						{Effect: linodego.LKENodePoolTaintEffectNoSchedule, Key: "foo", Value: "bar"},
+					},
+				},
+			},
+			newSpecs: []lke.NodePoolSpec{
+				{ID: 123, Type: "g6-standard-1", Count: 2, Tags: []string{"example"}},
+			},
+			expectedToUpdate: map[int]linodego.LKENodePoolUpdateOptions{
+				123: {
+					Count:  2,
+					Tags:   &[]string{"example"},
+					Labels: &linodego.LKENodePoolLabels{},
+					Taints: &[]linodego.LKENodePoolTaint{},
+				},
+			},
+			expectedToCreate: []linodego.LKENodePoolCreateOptions{},
+			expectedToDelete: []int{},
+		},
+		{
+			name: "change pool type with labels and taints",
+			oldSpecs: []lke.NodePoolSpec{
+				{ID: 123, Type: "g6-standard-1", Count: 2},
+			},
+			newSpecs: []lke.NodePoolSpec{
+				{
+					ID: 123, Type: "g6-standard-2", Count: 2,
+					Labels: map[string]string{"foo": "bar"},
+					Taints: []linodego.LKENodePoolTaint{
+						{Effect: linodego.LKENodePoolTaintEffectNoExecute, Key: "foo", Value: "bar"},
+					},
+				},
+			},
+			expectedToCreate: []linodego.LKENodePoolCreateOptions{
+				{
+					Type: "g6-standard-2", Count: 2,
+					Labels: linodego.LKENodePoolLabels{"foo": "bar"},
+					Taints: []linodego.LKENodePoolTaint{
+						{Effect: linodego.LKENodePoolTaintEffectNoExecute, Key: "foo", Value: "bar"},
+					},
+				},
+			},
+			expectedToDelete: []int{123},
+			expectedToUpdate: map[int]linodego.LKENodePoolUpdateOptions{},
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			updates, err := lke.ReconcileLKENodePoolSpecs(tc.oldSpecs, tc.newSpecs)
@@ -0,0 +1,44 @@
+//go:build integration || lke
+
+package lke_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+	"github.com/linode/terraform-provider-linode/v2/linode/lke/tmpl"
+)
+
+func TestAccEphemeralResourceLKEKubeconfig_basic(t *testing.T) {
+	t.Parallel()
+
+	resName := "echo.test"
+
+	acceptance.RunTestRetry(t, 2, func(tRetry *acceptance.TRetry) {
+		clusterName := acctest.RandomWithPrefix("tf_test")
+		resource.Test(tRetry, resource.TestCase{
+			PreCheck: func() { acceptance.PreCheck(t) },
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_10_0),
+			},
+			ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+			ProtoV6ProviderFactories: acceptance.ProtoV6EchoProviderFactories,
+			CheckDestroy:             acceptance.CheckLKEClusterDestroy,
+			Steps: []resource.TestStep{
+				{
+					Config: tmpl.EphemeralKubeconfig(t, clusterName, k8sVersionLatest, testRegion),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttrPair(resName, "data.cluster_id", "linode_lke_cluster.test", "id"),
+						resource.TestCheckResourceAttrSet(resName, "data.kubeconfig"),
+						resource.TestCheckResourceAttrSet(resName, "data.host"),
+						resource.TestCheckResourceAttrSet(resName, "data.cluster_ca_certificate"),
+						resource.TestCheckResourceAttrSet(resName, "data.token"),
+					),
+				},
+			},
+		})
+	})
+}
@@ -48,6 +48,26 @@ var frameworkDataSourceSchema = schema.Schema{
 			Sensitive:   true,
 			Description: "The Base64-encoded Kubeconfig for the cluster.",
 		},
+		"host": schema.StringAttribute{
+			Computed:    true,
+			Sensitive:   true,
+			Description: "The Kubernetes API server endpoint of the cluster's kubeconfig.",
+		},
+		"cluster_ca_certificate": schema.StringAttribute{
+			Computed:    true,
+			Sensitive:   true,
+			Description: "The PEM-encoded root certificate of the cluster's kubeconfig.",
+		},
+		"token": schema.StringAttribute{
+			Computed:    true,
+			Sensitive:   true,
+			Description: "The service account token of the cluster's kubeconfig.",
+		},
+		"client_certificate": schema.StringAttribute{
+			Computed:    true,
+			Sensitive:   true,
+			Description: "The PEM-encoded client certificate of the cluster's kubeconfig, if any.",
+		},
 		"dashboard_url": schema.StringAttribute{
 			Computed:    true,
 			Description: "The dashboard URL of the cluster.",
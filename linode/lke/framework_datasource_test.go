@@ -89,6 +89,9 @@ func TestAccDataSourceLKECluster_basic(t *testing.T) {
 						resource.TestCheckResourceAttrSet(dataSourceClusterName, "pools.0.id"),
 						resource.TestCheckResourceAttrSet(dataSourceClusterName, "kubeconfig"),
 						resource.TestCheckResourceAttrSet(dataSourceClusterName, "dashboard_url"),
+						resource.TestCheckResourceAttrSet(dataSourceClusterName, "host"),
+						resource.TestCheckResourceAttrSet(dataSourceClusterName, "cluster_ca_certificate"),
+						resource.TestCheckResourceAttrSet(dataSourceClusterName, "token"),
 					),
 				},
 			},
@@ -0,0 +1,37 @@
+package lke
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+)
+
+var frameworkEphemeralResourceSchema = schema.Schema{
+	Description: "Retrieves the kubeconfig of an LKE cluster without persisting it to the Terraform state.",
+	Attributes: map[string]schema.Attribute{
+		"cluster_id": schema.Int64Attribute{
+			Description: "The ID of the LKE cluster to retrieve the kubeconfig of.",
+			Required:    true,
+		},
+		"kubeconfig": schema.StringAttribute{
+			Description: "The Base64-encoded kubeconfig for the cluster.",
+			Computed:    true,
+			Sensitive:   true,
+		},
+		"host": schema.StringAttribute{
+			Description: "The Kubernetes API server endpoint of the current context of the kubeconfig.",
+			Computed:    true,
+		},
+		"cluster_ca_certificate": schema.StringAttribute{
+			Description: "The PEM-encoded cluster CA certificate of the current context of the kubeconfig.",
+			Computed:    true,
+		},
+		"token": schema.StringAttribute{
+			Description: "The service account token of the current context of the kubeconfig.",
+			Computed:    true,
+			Sensitive:   true,
+		},
+		"client_certificate": schema.StringAttribute{
+			Description: "The PEM-encoded client certificate of the current context of the kubeconfig, if any.",
+			Computed:    true,
+		},
+	},
+}
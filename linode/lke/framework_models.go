@@ -5,6 +5,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/linode/linodego"
 	"github.com/linode/terraform-provider-linode/v2/linode/helper"
@@ -29,7 +30,11 @@ type LKEDataModel struct {
 	Pools []LKENodePool `tfsdk:"pools"`
 
 	// LKE Cluster Kubeconfig
-	Kubeconfig types.String `tfsdk:"kubeconfig"`
+	Kubeconfig           types.String `tfsdk:"kubeconfig"`
+	Host                 types.String `tfsdk:"host"`
+	ClusterCACertificate types.String `tfsdk:"cluster_ca_certificate"`
+	Token                types.String `tfsdk:"token"`
+	ClientCertificate    types.String `tfsdk:"client_certificate"`
 
 	// LKE Cluster API endpoints
 	APIEndpoints types.List `tfsdk:"api_endpoints"`
@@ -179,6 +184,19 @@ func (data *LKEDataModel) parseLKEAttributes(
 
 	data.Kubeconfig = types.StringValue(kubeconfig.KubeConfig)
 
+	// The kubeconfig is still exposed if it can't be parsed
+	kubeconfigDetails, err := parseKubeconfig(kubeconfig.KubeConfig)
+	if err != nil {
+		tflog.Warn(ctx, "Failed to parse LKE cluster kubeconfig", map[string]any{
+			"error": err.Error(),
+		})
+	}
+
+	data.Host = types.StringValue(kubeconfigDetails.Host)
+	data.ClusterCACertificate = types.StringValue(kubeconfigDetails.ClusterCACertificate)
+	data.Token = types.StringValue(kubeconfigDetails.Token)
+	data.ClientCertificate = types.StringValue(kubeconfigDetails.ClientCertificate)
+
 	var urls []string
 	for _, e := range endpoints {
 		urls = append(urls, e.Endpoint)
@@ -230,3 +248,31 @@ func parseControlPlane(
 
 	return cp, nil
 }
+
+// KubeconfigEphemeralModel describes the Terraform ephemeral resource data model
+// to match the linode_lke_kubeconfig ephemeral resource schema.
+type KubeconfigEphemeralModel struct {
+	ClusterID            types.Int64  `tfsdk:"cluster_id"`
+	Kubeconfig           types.String `tfsdk:"kubeconfig"`
+	Host                 types.String `tfsdk:"host"`
+	ClusterCACertificate types.String `tfsdk:"cluster_ca_certificate"`
+	Token                types.String `tfsdk:"token"`
+	ClientCertificate    types.String `tfsdk:"client_certificate"`
+}
+
+func (m *KubeconfigEphemeralModel) FlattenKubeconfig(kubeconfig string) (diags diag.Diagnostics) {
+	m.Kubeconfig = types.StringValue(kubeconfig)
+
+	details, err := parseKubeconfig(kubeconfig)
+	if err != nil {
+		// The raw kubeconfig is still exposed if it can't be parsed
+		diags.AddWarning("Failed to parse LKE cluster kubeconfig", err.Error())
+	}
+
+	m.Host = types.StringValue(details.Host)
+	m.ClusterCACertificate = types.StringValue(details.ClusterCACertificate)
+	m.Token = types.StringValue(details.Token)
+	m.ClientCertificate = types.StringValue(details.ClientCertificate)
+
+	return diags
+}
@@ -0,0 +1,119 @@
+package lke
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/linode/linodego"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// KubeconfigDetails contains the connection details of the
+// current context of the kubeconfig of an LKE cluster.
+type KubeconfigDetails struct {
+	Host                 string
+	ClusterCACertificate string
+	Token                string
+	ClientCertificate    string
+}
+
+// parseKubeconfig parses the connection details of the given Base64-encoded kubeconfig.
+func parseKubeconfig(encodedKubeconfig string) (KubeconfigDetails, error) {
+	var result KubeconfigDetails
+
+	rawKubeconfig, err := base64.StdEncoding.DecodeString(encodedKubeconfig)
+	if err != nil {
+		return result, fmt.Errorf("failed to decode kubeconfig: %w", err)
+	}
+
+	config, err := clientcmd.Load(rawKubeconfig)
+	if err != nil {
+		return result, fmt.Errorf("failed to parse kubeconfig: %w", err)
+	}
+
+	kubeContext, ok := config.Contexts[config.CurrentContext]
+	if !ok {
+		return result, fmt.Errorf("kubeconfig has no current context %q", config.CurrentContext)
+	}
+
+	if cluster, ok := config.Clusters[kubeContext.Cluster]; ok {
+		result.Host = cluster.Server
+		result.ClusterCACertificate = string(cluster.CertificateAuthorityData)
+	}
+
+	if authInfo, ok := config.AuthInfos[kubeContext.AuthInfo]; ok {
+		result.Token = authInfo.Token
+		result.ClientCertificate = string(authInfo.ClientCertificateData)
+	}
+
+	return result, nil
+}
+
+func setKubeconfigDetails(ctx context.Context, d *schema.ResourceData, kubeconfig string) {
+	// The kubeconfig is still exposed if it can't be parsed
+	details, err := parseKubeconfig(kubeconfig)
+	if err != nil {
+		tflog.Warn(ctx, "Failed to parse LKE cluster kubeconfig", map[string]any{
+			"error": err.Error(),
+		})
+	}
+
+	d.Set("host", details.Host)
+	d.Set("cluster_ca_certificate", details.ClusterCACertificate)
+	d.Set("token", details.Token)
+	d.Set("client_certificate", details.ClientCertificate)
+}
+
+// rotateLKEClusterKubeconfig regenerates the kubeconfig and service account token
+// of the given cluster and waits for the kubeconfig to differ from the old one.
+func rotateLKEClusterKubeconfig(
+	ctx context.Context,
+	client linodego.Client,
+	id int,
+	oldKubeconfig string,
+	timeout time.Duration,
+) error {
+	opts := linodego.LKEClusterRegenerateOptions{
+		KubeConfig:   true,
+		ServiceToken: true,
+	}
+
+	tflog.Debug(ctx, "client.RegenerateLKECluster(...)", map[string]any{
+		"options": opts,
+	})
+
+	if _, err := client.RegenerateLKECluster(ctx, id, opts); err != nil {
+		return fmt.Errorf("failed to regenerate kubeconfig of LKE cluster %d: %w", id, err)
+	}
+
+	tflog.Debug(ctx, "Waiting for the regenerated kubeconfig to be available")
+
+	// The kubeconfig is regenerated asynchronously and may be
+	// unavailable or unchanged for a short period of time
+	return retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		tflog.Trace(ctx, "client.GetLKEClusterKubeconfig(...)")
+
+		kubeconfig, err := client.GetLKEClusterKubeconfig(ctx, id)
+		if err != nil {
+			if linodego.ErrHasStatus(err, http.StatusServiceUnavailable) {
+				return retry.RetryableError(err)
+			}
+
+			return retry.NonRetryableError(
+				fmt.Errorf("failed to get kubeconfig for LKE cluster %d: %w", id, err),
+			)
+		}
+
+		if kubeconfig.KubeConfig == oldKubeconfig {
+			return retry.RetryableError(fmt.Errorf("kubeconfig of LKE cluster %d has not been regenerated yet", id))
+		}
+
+		return nil
+	})
+}
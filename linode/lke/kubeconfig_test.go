@@ -0,0 +1,99 @@
+//go:build unit
+
+package lke
+
+import (
+	"encoding/base64"
+	"reflect"
+	"testing"
+)
+
+const testKubeconfig = `apiVersion: v1
+kind: Config
+preferences: {}
+clusters:
+- name: lke123
+  cluster:
+    certificate-authority-data: LS0tLS1CRUdJTiBDRVJUSUZJQ0FURS0tLS0tCnRlc3QKLS0tLS1FTkQgQ0VSVElGSUNBVEUtLS0tLQo=
+    server: https://abc123.us-mia-1.linodelke.net:443
+- name: other
+  cluster:
+    server: https://other.example.com:443
+users:
+- name: lke123-admin
+  user:
+    as-user-extra: {}
+    token: secret-token
+contexts:
+- name: lke123-ctx
+  context:
+    cluster: lke123
+    namespace: default
+    user: lke123-admin
+current-context: lke123-ctx
+`
+
+func TestParseKubeconfig(t *testing.T) {
+	details, err := parseKubeconfig(base64.StdEncoding.EncodeToString([]byte(testKubeconfig)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := KubeconfigDetails{
+		Host:                 "https://abc123.us-mia-1.linodelke.net:443",
+		ClusterCACertificate: "-----BEGIN CERTIFICATE-----\ntest\n-----END CERTIFICATE-----\n",
+		Token:                "secret-token",
+	}
+
+	if !reflect.DeepEqual(expected, details) {
+		t.Errorf("expected kubeconfig details:\n%#v\ngot:\n%#v", expected, details)
+	}
+}
+
+func TestParseKubeconfig_invalid(t *testing.T) {
+	for name, kubeconfig := range map[string]string{
+		"not base64":         "not base64!",
+		"not yaml":           base64.StdEncoding.EncodeToString([]byte("{")),
+		"no current context": base64.StdEncoding.EncodeToString([]byte("apiVersion: v1\nkind: Config\n")),
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := parseKubeconfig(kubeconfig); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestFlattenKubeconfigEphemeral(t *testing.T) {
+	encoded := base64.StdEncoding.EncodeToString([]byte(testKubeconfig))
+
+	var data KubeconfigEphemeralModel
+	if diags := data.FlattenKubeconfig(encoded); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	if data.Kubeconfig.ValueString() != encoded {
+		t.Errorf("expected kubeconfig to be %q, got %q", encoded, data.Kubeconfig.ValueString())
+	}
+
+	if data.Host.ValueString() != "https://abc123.us-mia-1.linodelke.net:443" {
+		t.Errorf("unexpected host %q", data.Host.ValueString())
+	}
+
+	if data.Token.ValueString() != "secret-token" {
+		t.Errorf("unexpected token %q", data.Token.ValueString())
+	}
+}
+
+func TestFlattenKubeconfigEphemeral_invalid(t *testing.T) {
+	var data KubeconfigEphemeralModel
+
+	diags := data.FlattenKubeconfig("not base64!")
+	if diags.HasError() || diags.WarningsCount() != 1 {
+		t.Fatalf("expected a single warning, got %v", diags)
+	}
+
+	if data.Kubeconfig.ValueString() != "not base64!" {
+		t.Errorf("expected the raw kubeconfig to be exposed, got %q", data.Kubeconfig.ValueString())
+	}
+}
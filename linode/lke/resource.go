@@ -38,6 +38,8 @@ func Resource() *schema.Resource {
 		},
 		CustomizeDiff: customdiff.All(
 			customDiffValidateOptionalCount,
+			customDiffValidateUpgradeStrategy,
+			customDiffKubeconfigRotation,
 			linodediffs.ComputedWithDefault("tags", []string{}),
 			linodediffs.CaseInsensitiveSet("tags"),
 		),
@@ -80,6 +82,14 @@ func readResource(ctx context.Context, d *schema.ResourceData, meta interface{})
 		return diag.Errorf("failed to get pools for LKE cluster %d: %s", id, err)
 	}
 
+	// The nodes of a pending upgrade may also belong to external pools
+	k8sVersion, pendingUpgrade := resolvePendingUpgrade(
+		d.Get("k8s_version").(string),
+		cluster.K8sVersion,
+		expandPendingUpgrade(d.Get("pending_upgrade").([]interface{})),
+		pools,
+	)
+
 	externalPoolTags := helper.ExpandStringSet(d.Get("external_pool_tags").(*schema.Set))
 	if len(externalPoolTags) > 0 && len(pools) > 0 {
 		pools = filterExternalPools(ctx, externalPoolTags, pools)
@@ -115,11 +125,13 @@ func readResource(ctx context.Context, d *schema.ResourceData, meta interface{})
 	}
 
 	d.Set("label", cluster.Label)
-	d.Set("k8s_version", cluster.K8sVersion)
+	d.Set("k8s_version", k8sVersion)
+	d.Set("pending_upgrade", flattenPendingUpgrade(pendingUpgrade))
 	d.Set("region", cluster.Region)
 	d.Set("tags", cluster.Tags)
 	d.Set("status", cluster.Status)
 	d.Set("kubeconfig", kubeconfig.KubeConfig)
+	setKubeconfigDetails(ctx, d, kubeconfig.KubeConfig)
 	d.Set("dashboard_url", dashboard.URL)
 	d.Set("api_endpoints", flattenLKEClusterAPIEndpoints(endpoints))
 
@@ -180,6 +192,8 @@ func createResource(ctx context.Context, d *schema.ResourceData, meta interface{
 			Tags:       helper.ExpandStringSet(poolSpec["tags"].(*schema.Set)),
 			Count:      count,
 			Autoscaler: autoscaler,
+			Labels:     expandLinodeLKENodePoolLabels(poolSpec),
+			Taints:     expandLinodeLKENodePoolTaints(poolSpec),
 		})
 	}
 
@@ -263,6 +277,18 @@ func updateResource(ctx context.Context, d *schema.ResourceData, meta interface{
 		}
 	}
 
+	if d.HasChange("kubeconfig_rotation_trigger") {
+		tflog.Debug(ctx, "Rotating LKE cluster kubeconfig")
+
+		oldKubeconfig, _ := d.GetChange("kubeconfig")
+
+		if err := rotateLKEClusterKubeconfig(
+			ctx, client, id, oldKubeconfig.(string), d.Timeout(schema.TimeoutUpdate),
+		); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	tflog.Trace(ctx, "client.ListLKENodePools(...)")
 
 	pools, err := client.ListLKENodePools(ctx, id, nil)
@@ -271,11 +297,36 @@ func updateResource(ctx context.Context, d *schema.ResourceData, meta interface{
 	}
 
 	if d.HasChange("k8s_version") {
-		tflog.Debug(ctx, "Implicitly recycling LKE cluster to apply Kubernetes version upgrade")
+		k8sVersion := d.Get("k8s_version").(string)
+
+		if strategy := expandUpgradeStrategy(d.Get("upgrade_strategy").([]interface{})); strategy != nil {
+			tflog.Debug(ctx, "Recycling LKE cluster node pools in batches to apply Kubernetes version upgrade")
+
+			pools = orderUpgradePools(pools, d.Get("pool").([]interface{}), strategy.PoolOrder)
+			nodeIDs := upgradeNodeIDs(
+				pools, expandPendingUpgrade(d.Get("pending_upgrade").([]interface{})), k8sVersion,
+			)
+
+			if err := rollingUpgradeLKECluster(ctx, providerMeta, id, pools, nodeIDs, *strategy); err != nil {
+				// Keep the previous k8s_version in state along with the nodes that
+				// haven't been recycled, so only those are recycled on the next apply
+				oldVersion, _ := d.GetChange("k8s_version")
+				d.Set("k8s_version", oldVersion)
+				d.Set("pending_upgrade", flattenPendingUpgrade(
+					pendingUpgradeAfterFailure(ctx, client, id, k8sVersion, nodeIDs),
+				))
+
+				return diag.FromErr(err)
+			}
+		} else {
+			tflog.Debug(ctx, "Implicitly recycling LKE cluster to apply Kubernetes version upgrade")
 
-		if err := recycleLKECluster(ctx, providerMeta, id, pools); err != nil {
-			return diag.FromErr(err)
+			if err := recycleLKECluster(ctx, providerMeta, id, pools); err != nil {
+				return diag.FromErr(err)
+			}
 		}
+
+		d.Set("pending_upgrade", flattenPendingUpgrade(nil))
 	}
 
 	oldPools, newPools := d.GetChange("pool")
@@ -292,6 +343,26 @@ func updateResource(ctx context.Context, d *schema.ResourceData, meta interface{
 		"updates": updates,
 	})
 
+	waitForPoolsReady := func(poolIDs []int) error {
+		for _, poolID := range poolIDs {
+			tflog.Trace(ctx, "Waiting for node pool to be ready", map[string]any{
+				"node_pool_id": poolID,
+			})
+
+			if _, err := lkenodepool.WaitForNodePoolReady(
+				ctx,
+				client,
+				providerMeta.Config.LKENodeReadyPollMilliseconds,
+				id,
+				poolID,
+			); err != nil {
+				return fmt.Errorf("failed to wait for LKE Cluster %d pool %d ready: %w", id, poolID, err)
+			}
+		}
+
+		return nil
+	}
+
 	updatedIds := []int{}
 
 	for poolID, updateOpts := range updates.ToUpdate {
@@ -307,16 +378,30 @@ func updateResource(ctx context.Context, d *schema.ResourceData, meta interface{
 		updatedIds = append(updatedIds, poolID)
 	}
 
+	createdIds := []int{}
+
 	for _, createOpts := range updates.ToCreate {
 		tflog.Debug(ctx, "client.CreateLKENodePool(...)", map[string]any{
-			"options": updateOpts,
+			"options": createOpts,
 		})
 		pool, err := client.CreateLKENodePool(ctx, id, createOpts)
 		if err != nil {
 			return diag.Errorf("failed to create LKE Cluster %d Pool: %s", id, err)
 		}
 
-		updatedIds = append(updatedIds, pool.ID)
+		createdIds = append(createdIds, pool.ID)
+	}
+
+	// Pools replacing pools with a changed type must be ready
+	// before the pools they replace are deleted
+	if len(updates.ToDelete) > 0 {
+		tflog.Debug(ctx, "Waiting for all created node pools to be ready before deleting pools")
+
+		if err := waitForPoolsReady(createdIds); err != nil {
+			return diag.FromErr(err)
+		}
+
+		createdIds = nil
 	}
 
 	for _, poolID := range updates.ToDelete {
@@ -331,20 +416,8 @@ func updateResource(ctx context.Context, d *schema.ResourceData, meta interface{
 
 	tflog.Debug(ctx, "Waiting for all updated node pools to be ready")
 
-	for _, poolID := range updatedIds {
-		tflog.Trace(ctx, "Waiting for node pool to be ready", map[string]any{
-			"node_pool_id": poolID,
-		})
-
-		if _, err := lkenodepool.WaitForNodePoolReady(
-			ctx,
-			client,
-			providerMeta.Config.LKENodeReadyPollMilliseconds,
-			id,
-			poolID,
-		); err != nil {
-			return diag.Errorf("failed to wait for LKE Cluster %d pool %d ready: %s", id, poolID, err)
-		}
+	if err := waitForPoolsReady(append(updatedIds, createdIds...)); err != nil {
+		return diag.FromErr(err)
 	}
 
 	return readResource(ctx, d, meta)
@@ -453,3 +526,19 @@ func customDiffValidateOptionalCount(ctx context.Context, diff *schema.ResourceD
 
 	return nil
 }
+
+// customDiffKubeconfigRotation marks the kubeconfig and its parsed
+// attributes as changing when the kubeconfig is going to be rotated.
+func customDiffKubeconfigRotation(ctx context.Context, diff *schema.ResourceDiff, meta any) error {
+	if diff.Id() == "" || !diff.HasChange("kubeconfig_rotation_trigger") {
+		return nil
+	}
+
+	for _, key := range []string{"kubeconfig", "host", "cluster_ca_certificate", "token", "client_certificate"} {
+		if err := diff.SetNewComputed(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
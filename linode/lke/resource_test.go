@@ -20,6 +20,7 @@ import (
 	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
 	"github.com/linode/terraform-provider-linode/v2/linode/helper"
 	"github.com/linode/terraform-provider-linode/v2/linode/lke/tmpl"
+	nodepooltmpl "github.com/linode/terraform-provider-linode/v2/linode/lkenodepool/tmpl"
 )
 
 var (
@@ -251,6 +252,49 @@ func TestAccResourceLKECluster_k8sUpgrade(t *testing.T) {
 	})
 }
 
+func TestAccResourceLKECluster_k8sUpgradeStrategy(t *testing.T) {
+	t.Parallel()
+
+	var cluster linodego.LKECluster
+
+	acceptance.RunTestRetry(t, 2, func(tRetry *acceptance.TRetry) {
+		clusterName := acctest.RandomWithPrefix("tf_test")
+		resource.Test(tRetry, resource.TestCase{
+			PreCheck:                 func() { acceptance.PreCheck(t) },
+			ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+			CheckDestroy:             acceptance.CheckLKEClusterDestroy,
+			Steps: []resource.TestStep{
+				{
+					Config: tmpl.UpgradeStrategy(t, clusterName, k8sVersionPrevious, testRegion),
+					Check: resource.ComposeTestCheckFunc(
+						checkLKEExists(&cluster),
+						resource.TestCheckResourceAttr(resourceClusterName, "k8s_version", k8sVersionPrevious),
+						resource.TestCheckResourceAttr(resourceClusterName, "upgrade_strategy.#", "1"),
+						resource.TestCheckResourceAttr(resourceClusterName, "upgrade_strategy.0.max_surge", "1"),
+						resource.TestCheckResourceAttr(resourceClusterName, "upgrade_strategy.0.max_unavailable", "1"),
+						resource.TestCheckResourceAttr(resourceClusterName, "upgrade_strategy.0.pool_order.#", "2"),
+						resource.TestCheckResourceAttr(resourceClusterName, "upgrade_strategy.0.drain_wait_seconds", "5"),
+					),
+				},
+				{
+					PreConfig: func() {
+						waitForAllNodesReady(t, &cluster, time.Second*5, time.Minute*5)
+					},
+					Config: tmpl.UpgradeStrategy(t, clusterName, k8sVersionLatest, testRegion),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceClusterName, "k8s_version", k8sVersionLatest),
+						resource.TestCheckResourceAttr(resourceClusterName, "pool.#", "2"),
+						resource.TestCheckResourceAttr(resourceClusterName, "pool.0.count", "2"),
+						resource.TestCheckResourceAttr(resourceClusterName, "pool.0.nodes.#", "2"),
+						resource.TestCheckResourceAttr(resourceClusterName, "pool.1.count", "1"),
+						resource.TestCheckResourceAttr(resourceClusterName, "pool.1.nodes.#", "1"),
+					),
+				},
+			},
+		})
+	})
+}
+
 func TestAccResourceLKECluster_basicUpdates(t *testing.T) {
 	t.Parallel()
 
@@ -536,6 +580,115 @@ func TestAccResourceLKECluster_controlPlane(t *testing.T) {
 	})
 }
 
+func TestAccResourceLKECluster_taintsLabels(t *testing.T) {
+	t.Parallel()
+
+	acceptance.RunTestRetry(t, 2, func(tRetry *acceptance.TRetry) {
+		clusterName := acctest.RandomWithPrefix("tf_test")
+		resource.Test(tRetry, resource.TestCase{
+			PreCheck:                 func() { acceptance.PreCheck(t) },
+			ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+			CheckDestroy:             acceptance.CheckLKEClusterDestroy,
+			Steps: []resource.TestStep{
+				{
+					Config: tmpl.TaintsLabels(
+						t, clusterName, k8sVersionLatest, testRegion,
+						map[string]string{"foo": "bar"},
+						[]nodepooltmpl.TaintData{
+							{Effect: "PreferNoSchedule", Key: "foo", Value: "bar"},
+						},
+					),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceClusterName, "pool.#", "1"),
+						resource.TestCheckResourceAttr(resourceClusterName, "pool.0.labels.%", "1"),
+						resource.TestCheckResourceAttr(resourceClusterName, "pool.0.labels.foo", "bar"),
+						resource.TestCheckResourceAttr(resourceClusterName, "pool.0.taint.#", "1"),
+						resource.TestCheckResourceAttr(resourceClusterName, "pool.0.taint.0.effect", "PreferNoSchedule"),
+						resource.TestCheckResourceAttr(resourceClusterName, "pool.0.taint.0.key", "foo"),
+						resource.TestCheckResourceAttr(resourceClusterName, "pool.0.taint.0.value", "bar"),
+					),
+				},
+				{
+					Config: tmpl.TaintsLabels(
+						t, clusterName, k8sVersionLatest, testRegion,
+						map[string]string{"bar": "baz"},
+						[]nodepooltmpl.TaintData{
+							{Effect: "NoSchedule", Key: "bar", Value: "baz"},
+						},
+					),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceClusterName, "pool.#", "1"),
+						resource.TestCheckResourceAttr(resourceClusterName, "pool.0.labels.%", "1"),
+						resource.TestCheckResourceAttr(resourceClusterName, "pool.0.labels.bar", "baz"),
+						resource.TestCheckResourceAttr(resourceClusterName, "pool.0.taint.#", "1"),
+						resource.TestCheckResourceAttr(resourceClusterName, "pool.0.taint.0.effect", "NoSchedule"),
+						resource.TestCheckResourceAttr(resourceClusterName, "pool.0.taint.0.key", "bar"),
+						resource.TestCheckResourceAttr(resourceClusterName, "pool.0.taint.0.value", "baz"),
+					),
+				},
+				{
+					Config: tmpl.TaintsLabels(t, clusterName, k8sVersionLatest, testRegion, nil, nil),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceClusterName, "pool.#", "1"),
+						resource.TestCheckResourceAttr(resourceClusterName, "pool.0.labels.%", "0"),
+						resource.TestCheckResourceAttr(resourceClusterName, "pool.0.taint.#", "0"),
+					),
+				},
+			},
+		})
+	})
+}
+
+func TestAccResourceLKECluster_kubeconfigRotation(t *testing.T) {
+	t.Parallel()
+
+	var oldToken string
+
+	acceptance.RunTestRetry(t, 2, func(tRetry *acceptance.TRetry) {
+		clusterName := acctest.RandomWithPrefix("tf_test")
+		resource.Test(tRetry, resource.TestCase{
+			PreCheck:                 func() { acceptance.PreCheck(t) },
+			ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+			CheckDestroy:             acceptance.CheckLKEClusterDestroy,
+			Steps: []resource.TestStep{
+				{
+					Config: tmpl.KubeconfigRotation(t, clusterName, k8sVersionLatest, testRegion, "1"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceClusterName, "kubeconfig_rotation_trigger", "1"),
+						resource.TestCheckResourceAttrSet(resourceClusterName, "kubeconfig"),
+						resource.TestMatchResourceAttr(resourceClusterName, "host", regexp.MustCompile("^https://")),
+						resource.TestMatchResourceAttr(
+							resourceClusterName, "cluster_ca_certificate", regexp.MustCompile("^-----BEGIN CERTIFICATE-----"),
+						),
+						resource.TestCheckResourceAttrWith(resourceClusterName, "token", func(value string) error {
+							if value == "" {
+								return fmt.Errorf("expected token to be set")
+							}
+
+							oldToken = value
+							return nil
+						}),
+					),
+				},
+				{
+					Config: tmpl.KubeconfigRotation(t, clusterName, k8sVersionLatest, testRegion, "2"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceClusterName, "kubeconfig_rotation_trigger", "2"),
+						resource.TestMatchResourceAttr(resourceClusterName, "host", regexp.MustCompile("^https://")),
+						resource.TestCheckResourceAttrWith(resourceClusterName, "token", func(value string) error {
+							if value == "" || value == oldToken {
+								return fmt.Errorf("expected token to be rotated")
+							}
+
+							return nil
+						}),
+					),
+				},
+			},
+		})
+	})
+}
+
 func TestAccResourceLKECluster_noCount(t *testing.T) {
 	t.Parallel()
 
@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/linode/linodego"
 	"github.com/linode/terraform-provider-linode/v2/linode/helper"
 )
 
@@ -52,6 +53,36 @@ var resourceSchema = map[string]*schema.Schema{
 		Sensitive:   true,
 		Description: "The Base64-encoded Kubeconfig for the cluster.",
 	},
+	"host": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Sensitive:   true,
+		Description: "The Kubernetes API server endpoint of the cluster's kubeconfig.",
+	},
+	"cluster_ca_certificate": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Sensitive:   true,
+		Description: "The PEM-encoded root certificate of the cluster's kubeconfig.",
+	},
+	"token": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Sensitive:   true,
+		Description: "The service account token of the cluster's kubeconfig.",
+	},
+	"client_certificate": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Sensitive:   true,
+		Description: "The PEM-encoded client certificate of the cluster's kubeconfig, if any.",
+	},
+	"kubeconfig_rotation_trigger": {
+		Type:     schema.TypeString,
+		Optional: true,
+		Description: "An arbitrary value that regenerates the kubeconfig and service account token " +
+			"of the cluster when changed.",
+	},
 	"dashboard_url": {
 		Type:        schema.TypeString,
 		Computed:    true,
@@ -161,12 +192,112 @@ var resourceSchema = map[string]*schema.Schema{
 					Description: "When specified, the number of nodes autoscales within " +
 						"the defined minimum and maximum values.",
 				},
+				"labels": {
+					Type:     schema.TypeMap,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+					Optional: true,
+					Description: "Key-value pairs added as labels to nodes in the node pool. " +
+						"Labels help classify your nodes and to easily select subsets of objects.",
+				},
+				"taint": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"effect": {
+								Type:        schema.TypeString,
+								Description: "The Kubernetes taint effect.",
+								Required:    true,
+								ValidateFunc: validation.StringInSlice([]string{
+									string(linodego.LKENodePoolTaintEffectNoExecute),
+									string(linodego.LKENodePoolTaintEffectNoSchedule),
+									string(linodego.LKENodePoolTaintEffectPreferNoSchedule),
+								}, false),
+							},
+							"key": {
+								Type:        schema.TypeString,
+								Description: "The Kubernetes taint key.",
+								Required:    true,
+							},
+							"value": {
+								Type:        schema.TypeString,
+								Description: "The Kubernetes taint value.",
+								Required:    true,
+							},
+						},
+					},
+					Description: "Kubernetes taints to add to node pool nodes. Taints help control how " +
+						"pods are scheduled onto nodes, specifically allowing them to repel certain pods.",
+				},
 			},
 		},
 		MinItems:    1,
 		Required:    true,
 		Description: "A node pool in the cluster.",
 	},
+	"upgrade_strategy": {
+		Type:     schema.TypeList,
+		MaxItems: 1,
+		Optional: true,
+		Description: "When specified, Kubernetes version upgrades are applied by recycling the nodes of " +
+			"each pool in batches rather than recycling all nodes of the cluster at once.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_surge": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      1,
+					ValidateFunc: validation.IntAtLeast(0),
+					Description: "The number of nodes temporarily added to each pool while it is upgraded. " +
+						"This is also the number of nodes recycled at once without reducing the capacity of the pool.",
+				},
+				"max_unavailable": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      0,
+					ValidateFunc: validation.IntAtLeast(0),
+					Description: "The number of additional nodes of each pool that may be recycled at once, " +
+						"reducing the capacity of the pool while they are replaced.",
+				},
+				"pool_order": {
+					Type:     schema.TypeList,
+					Elem:     &schema.Schema{Type: schema.TypeInt, ValidateFunc: validation.IntAtLeast(0)},
+					Optional: true,
+					Description: "The zero-based indexes of the pool blocks in the order they are upgraded. " +
+						"Pools that aren't listed are upgraded afterwards in the order they are defined.",
+				},
+				"drain_wait_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      0,
+					ValidateFunc: validation.IntAtLeast(0),
+					Description: "The number of seconds to wait after each batch of recycled nodes is ready, " +
+						"giving workloads time to be rescheduled before the next batch is recycled.",
+				},
+			},
+		},
+	},
+	"pending_upgrade": {
+		Type:     schema.TypeList,
+		Computed: true,
+		Description: "A rolling upgrade that failed to complete. Its remaining nodes are recycled " +
+			"when the upgrade is applied again.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"k8s_version": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The Kubernetes version the cluster is being upgraded to.",
+				},
+				"node_ids": {
+					Type:        schema.TypeList,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Computed:    true,
+					Description: "The IDs of the nodes that haven't been recycled yet.",
+				},
+			},
+		},
+	},
 	"control_plane": {
 		Type:        schema.TypeList,
 		MaxItems:    1,
@@ -15,6 +15,10 @@ type TemplateData struct {
 	ACLEnabled       bool
 	IPv4             string
 	IPv6             string
+	Labels           map[string]string
+	Taints           []nodepooltmpl.TaintData
+
+	KubeconfigRotationTrigger string
 }
 
 func Basic(t *testing.T, name, version, region string) string {
@@ -69,6 +73,32 @@ func ControlPlane(t *testing.T, name, version, region, ipv4, ipv6 string, ha, en
 		})
 }
 
+func UpgradeStrategy(t *testing.T, name, version, region string) string {
+	return acceptance.ExecuteTemplate(t,
+		"lke_cluster_upgrade_strategy", TemplateData{Label: name, K8sVersion: version, Region: region})
+}
+
+func KubeconfigRotation(t *testing.T, name, version, region, trigger string) string {
+	return acceptance.ExecuteTemplate(t,
+		"lke_cluster_kubeconfig_rotation", TemplateData{
+			Label:                     name,
+			K8sVersion:                version,
+			Region:                    region,
+			KubeconfigRotationTrigger: trigger,
+		})
+}
+
+func TaintsLabels(t *testing.T, name, version, region string, labels map[string]string, taints []nodepooltmpl.TaintData) string {
+	return acceptance.ExecuteTemplate(t,
+		"lke_cluster_taints_labels", TemplateData{
+			Label:      name,
+			K8sVersion: version,
+			Region:     region,
+			Labels:     labels,
+			Taints:     taints,
+		})
+}
+
 func NoCount(t *testing.T, name, version, region string) string {
 	return acceptance.ExecuteTemplate(t,
 		"lke_cluster_no_count", TemplateData{
@@ -113,3 +143,8 @@ func DataControlPlane(t *testing.T, name, version, region, ipv4, ipv6 string, ha
 func DataTaintsLabels(t *testing.T, data *nodepooltmpl.TemplateData) string {
 	return acceptance.ExecuteTemplate(t, "lke_cluster_data_taints_labels", *data)
 }
+
+func EphemeralKubeconfig(t *testing.T, name, version, region string) string {
+	return acceptance.ExecuteTemplate(t,
+		"lke_kubeconfig_ephemeral", TemplateData{Label: name, K8sVersion: version, Region: region})
+}
@@ -0,0 +1,507 @@
+package lke
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	"github.com/linode/terraform-provider-linode/v2/linode/lkenodepool"
+)
+
+// restorePoolSizeTimeout is the maximum time spent scaling a pool back to
+// its original size or listing its remaining nodes after a failed rolling upgrade.
+const restorePoolSizeTimeout = 5 * time.Minute
+
+type UpgradeStrategy struct {
+	MaxSurge       int
+	MaxUnavailable int
+	PoolOrder      []int
+	DrainWait      time.Duration
+}
+
+// BatchSize returns the number of nodes recycled at once.
+func (s UpgradeStrategy) BatchSize() int {
+	return s.MaxSurge + s.MaxUnavailable
+}
+
+func expandUpgradeStrategy(strategies []interface{}) *UpgradeStrategy {
+	if len(strategies) < 1 || strategies[0] == nil {
+		return nil
+	}
+
+	strategySpec := strategies[0].(map[string]interface{})
+
+	var poolOrder []int
+	for _, index := range strategySpec["pool_order"].([]interface{}) {
+		poolOrder = append(poolOrder, index.(int))
+	}
+
+	return &UpgradeStrategy{
+		MaxSurge:       strategySpec["max_surge"].(int),
+		MaxUnavailable: strategySpec["max_unavailable"].(int),
+		PoolOrder:      poolOrder,
+		DrainWait:      time.Duration(strategySpec["drain_wait_seconds"].(int)) * time.Second,
+	}
+}
+
+// PendingUpgrade is a rolling upgrade that failed to complete.
+// Its remaining nodes are recycled when the upgrade is applied again.
+type PendingUpgrade struct {
+	K8sVersion string
+	NodeIDs    []string
+}
+
+func expandPendingUpgrade(pendingUpgrades []interface{}) *PendingUpgrade {
+	if len(pendingUpgrades) < 1 || pendingUpgrades[0] == nil {
+		return nil
+	}
+
+	pendingSpec := pendingUpgrades[0].(map[string]interface{})
+
+	return &PendingUpgrade{
+		K8sVersion: pendingSpec["k8s_version"].(string),
+		NodeIDs:    helper.ExpandStringList(pendingSpec["node_ids"].([]interface{})),
+	}
+}
+
+func flattenPendingUpgrade(pending *PendingUpgrade) []map[string]interface{} {
+	if pending == nil {
+		return []map[string]interface{}{}
+	}
+
+	return []map[string]interface{}{
+		{
+			"k8s_version": pending.K8sVersion,
+			"node_ids":    pending.NodeIDs,
+		},
+	}
+}
+
+// resolvePendingUpgrade returns the Kubernetes version to store in state and
+// the part of the given pending upgrade that still needs to be applied.
+// While an upgrade is pending, the previous version is kept in state so the
+// upgrade is applied again. The version of the cluster is used otherwise,
+// including when the cluster has since been upgraded to another version or
+// all of the remaining nodes have been recycled outside of Terraform.
+func resolvePendingUpgrade(
+	stateVersion, clusterVersion string, pending *PendingUpgrade, pools []linodego.LKENodePool,
+) (string, *PendingUpgrade) {
+	if pending == nil || pending.K8sVersion != clusterVersion || stateVersion == "" {
+		return clusterVersion, nil
+	}
+
+	remaining := remainingUpgradeNodeIDs(pools, nodeIDSet(pending.NodeIDs))
+	if len(remaining) < 1 {
+		return clusterVersion, nil
+	}
+
+	return stateVersion, &PendingUpgrade{
+		K8sVersion: pending.K8sVersion,
+		NodeIDs:    remaining,
+	}
+}
+
+// upgradeNodeIDs returns the IDs of the nodes that need to be recycled to upgrade
+// the given pools to the given version. Only the remaining nodes of a pending
+// upgrade to the same version are recycled, as the others already run it.
+func upgradeNodeIDs(
+	pools []linodego.LKENodePool, pending *PendingUpgrade, k8sVersion string,
+) map[string]bool {
+	if pending != nil && pending.K8sVersion == k8sVersion {
+		return nodeIDSet(pending.NodeIDs)
+	}
+
+	result := make(map[string]bool)
+
+	for _, pool := range pools {
+		for _, node := range pool.Linodes {
+			result[node.ID] = true
+		}
+	}
+
+	return result
+}
+
+// pendingUpgradeAfterFailure returns the pending upgrade to the given version
+// after a rolling upgrade of the given nodes failed to complete.
+func pendingUpgradeAfterFailure(
+	ctx context.Context, client linodego.Client, clusterID int, k8sVersion string, nodeIDs map[string]bool,
+) *PendingUpgrade {
+	// The upgrade may have failed because the context was canceled or timed out
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), restorePoolSizeTimeout)
+	defer cancel()
+
+	result := &PendingUpgrade{K8sVersion: k8sVersion}
+
+	pools, err := client.ListLKENodePools(ctx, clusterID, nil)
+	if err != nil {
+		tflog.Warn(ctx, "Failed to list node pools after failed upgrade, all nodes remain pending", map[string]any{
+			"error": err.Error(),
+		})
+
+		for nodeID := range nodeIDs {
+			result.NodeIDs = append(result.NodeIDs, nodeID)
+		}
+
+		sort.Strings(result.NodeIDs)
+
+		return result
+	}
+
+	result.NodeIDs = remainingUpgradeNodeIDs(pools, nodeIDs)
+
+	return result
+}
+
+func nodeIDSet(nodeIDs []string) map[string]bool {
+	result := make(map[string]bool, len(nodeIDs))
+
+	for _, nodeID := range nodeIDs {
+		result[nodeID] = true
+	}
+
+	return result
+}
+
+// remainingUpgradeNodeIDs returns the IDs of the given nodes
+// that still exist in the given pools.
+func remainingUpgradeNodeIDs(pools []linodego.LKENodePool, nodeIDs map[string]bool) []string {
+	var result []string
+
+	for _, pool := range pools {
+		for _, node := range pool.Linodes {
+			if nodeIDs[node.ID] {
+				result = append(result, node.ID)
+			}
+		}
+	}
+
+	return result
+}
+
+// orderUpgradePools returns the given pools in the order they should be upgraded.
+// Declared pools are ordered by the given indexes first, followed by the remaining
+// declared pools and finally any pools that aren't declared (e.g. external pools).
+func orderUpgradePools(
+	pools []linodego.LKENodePool, declaredPools []interface{}, poolOrder []int,
+) []linodego.LKENodePool {
+	result := make([]linodego.LKENodePool, 0, len(pools))
+
+	poolsByID := make(map[int]linodego.LKENodePool, len(pools))
+	for _, pool := range pools {
+		poolsByID[pool.ID] = pool
+	}
+
+	addDeclaredPool := func(index int) {
+		if index < 0 || index >= len(declaredPools) {
+			return
+		}
+
+		poolID, ok := declaredPools[index].(map[string]interface{})["id"].(int)
+		if !ok {
+			return
+		}
+
+		// Pools that don't exist yet or have already been added are skipped
+		pool, ok := poolsByID[poolID]
+		if !ok {
+			return
+		}
+
+		result = append(result, pool)
+		delete(poolsByID, poolID)
+	}
+
+	for _, index := range poolOrder {
+		addDeclaredPool(index)
+	}
+
+	for index := range declaredPools {
+		addDeclaredPool(index)
+	}
+
+	for _, pool := range pools {
+		if _, ok := poolsByID[pool.ID]; ok {
+			result = append(result, pool)
+		}
+	}
+
+	return result
+}
+
+// batchLKENodes splits the given nodes into batches of the given size.
+func batchLKENodes(nodes []linodego.LKENodePoolLinode, batchSize int) [][]linodego.LKENodePoolLinode {
+	if batchSize < 1 {
+		batchSize = 1
+	}
+
+	batches := make([][]linodego.LKENodePoolLinode, 0, (len(nodes)+batchSize-1)/batchSize)
+
+	for start := 0; start < len(nodes); start += batchSize {
+		end := min(start+batchSize, len(nodes))
+		batches = append(batches, nodes[start:end])
+	}
+
+	return batches
+}
+
+// rollingUpgradeLKECluster recycles the given nodes of the given pools one pool
+// and one batch of nodes at a time according to the given upgrade strategy.
+func rollingUpgradeLKECluster(
+	ctx context.Context,
+	meta *helper.ProviderMeta,
+	id int,
+	pools []linodego.LKENodePool,
+	nodeIDs map[string]bool,
+	strategy UpgradeStrategy,
+) error {
+	ctx = helper.SetLogFieldBulk(ctx, map[string]any{
+		"cluster_id":      id,
+		"max_surge":       strategy.MaxSurge,
+		"max_unavailable": strategy.MaxUnavailable,
+	})
+
+	tflog.Info(ctx, "Performing rolling upgrade of LKE cluster")
+
+	for _, pool := range pools {
+		if err := rollingUpgradeLKENodePool(ctx, meta, id, pool, nodeIDs, strategy); err != nil {
+			return fmt.Errorf("failed to upgrade pool %d: %w", pool.ID, err)
+		}
+	}
+
+	tflog.Debug(ctx, "All node pools have been upgraded; rolling upgrade completed")
+
+	return nil
+}
+
+func rollingUpgradeLKENodePool(
+	ctx context.Context,
+	meta *helper.ProviderMeta,
+	clusterID int,
+	pool linodego.LKENodePool,
+	nodeIDs map[string]bool,
+	strategy UpgradeStrategy,
+) (err error) {
+	client := meta.Client
+
+	ctx = tflog.SetField(ctx, "node_pool_id", pool.ID)
+
+	// Only the given nodes that exist before the surge need to be recycled
+	oldNodes := make([]linodego.LKENodePoolLinode, 0, len(pool.Linodes))
+	for _, node := range pool.Linodes {
+		if nodeIDs[node.ID] {
+			oldNodes = append(oldNodes, node)
+		}
+	}
+
+	if len(oldNodes) < 1 {
+		return nil
+	}
+
+	if strategy.MaxSurge > 0 {
+		tflog.Info(ctx, "Temporarily scaling up node pool", map[string]any{
+			"count": pool.Count + strategy.MaxSurge,
+		})
+
+		// Don't leave the pool and its autoscaler at the surged size
+		// if the upgrade can't be completed
+		defer func() {
+			if err == nil {
+				return
+			}
+
+			if restoreErr := restoreLKENodePoolSize(ctx, &client, clusterID, pool); restoreErr != nil {
+				err = errors.Join(err, restoreErr)
+			}
+		}()
+
+		if err := scaleLKENodePool(ctx, meta, clusterID, pool, strategy.MaxSurge); err != nil {
+			return err
+		}
+	}
+
+	for _, batch := range batchLKENodes(oldNodes, strategy.BatchSize()) {
+		tflog.Debug(ctx, "Recycling batch of nodes", map[string]any{
+			"nodes": batch,
+		})
+
+		for _, node := range batch {
+			tflog.Trace(ctx, "POST lke/clusters/{cluster_id}/nodes/{node_id}/recycle", map[string]any{
+				"node_id": node.ID,
+			})
+
+			if err := recycleLKEClusterNode(ctx, &client, clusterID, node.ID); err != nil {
+				return fmt.Errorf("failed to recycle node %s: %w", node.ID, err)
+			}
+		}
+
+		if err := waitForNodesDeleted(ctx, client, meta.Config.EventPollMilliseconds, batch); err != nil {
+			return fmt.Errorf("failed to wait for old nodes to be recycled: %w", err)
+		}
+
+		if _, err := lkenodepool.WaitForNodePoolReady(
+			ctx, client, meta.Config.LKENodeReadyPollMilliseconds, clusterID, pool.ID,
+		); err != nil {
+			return fmt.Errorf("failed to wait for pool ready: %w", err)
+		}
+
+		if strategy.DrainWait > 0 {
+			tflog.Debug(ctx, "Waiting for workloads to be rescheduled", map[string]any{
+				"drain_wait": strategy.DrainWait.String(),
+			})
+
+			select {
+			case <-time.After(strategy.DrainWait):
+			case <-ctx.Done():
+				return fmt.Errorf("failed to wait for workloads to be rescheduled: %w", ctx.Err())
+			}
+		}
+	}
+
+	if strategy.MaxSurge > 0 {
+		tflog.Info(ctx, "Scaling node pool back down", map[string]any{
+			"count": pool.Count,
+		})
+
+		if err := scaleLKENodePool(ctx, meta, clusterID, pool, 0); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// scaleLKENodePool scales the given pool to its original size plus the given
+// number of surge nodes and waits for all of its nodes to be ready.
+func scaleLKENodePool(
+	ctx context.Context,
+	meta *helper.ProviderMeta,
+	clusterID int,
+	pool linodego.LKENodePool,
+	surge int,
+) error {
+	client := meta.Client
+
+	updateOpts := surgeLKENodePoolUpdateOptions(pool, surge)
+
+	tflog.Debug(ctx, "client.UpdateLKENodePool(...)", map[string]any{
+		"options": updateOpts,
+	})
+
+	if _, err := client.UpdateLKENodePool(ctx, clusterID, pool.ID, updateOpts); err != nil {
+		return fmt.Errorf("failed to scale pool to %d nodes: %w", updateOpts.Count, err)
+	}
+
+	if _, err := lkenodepool.WaitForNodePoolReady(
+		ctx, client, meta.Config.LKENodeReadyPollMilliseconds, clusterID, pool.ID,
+	); err != nil {
+		return fmt.Errorf("failed to wait for pool ready: %w", err)
+	}
+
+	return nil
+}
+
+// restoreLKENodePoolSize scales the given pool back to its original size
+// after a failed rolling upgrade. The pool isn't waited on since the nodes
+// being removed don't need to become ready.
+func restoreLKENodePoolSize(
+	ctx context.Context,
+	client *linodego.Client,
+	clusterID int,
+	pool linodego.LKENodePool,
+) error {
+	// The original context may have been canceled or timed out
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), restorePoolSizeTimeout)
+	defer cancel()
+
+	updateOpts := surgeLKENodePoolUpdateOptions(pool, 0)
+
+	tflog.Info(ctx, "Scaling node pool back down after failed upgrade", map[string]any{
+		"options": updateOpts,
+	})
+
+	if _, err := client.UpdateLKENodePool(ctx, clusterID, pool.ID, updateOpts); err != nil {
+		return fmt.Errorf(
+			"failed to scale pool %d back to %d nodes, please scale it down manually: %w",
+			pool.ID, updateOpts.Count, err,
+		)
+	}
+
+	return nil
+}
+
+// surgeLKENodePoolUpdateOptions returns the options to scale the given pool
+// to its original size plus the given number of surge nodes.
+func surgeLKENodePoolUpdateOptions(pool linodego.LKENodePool, surge int) linodego.LKENodePoolUpdateOptions {
+	updateOpts := linodego.LKENodePoolUpdateOptions{
+		Count: pool.Count + surge,
+	}
+
+	// The autoscaler would otherwise immediately remove the surge nodes
+	if pool.Autoscaler.Enabled {
+		updateOpts.Autoscaler = &linodego.LKENodePoolAutoscaler{
+			Enabled: true,
+			Min:     pool.Autoscaler.Min + surge,
+			Max:     pool.Autoscaler.Max + surge,
+		}
+	}
+
+	return updateOpts
+}
+
+// recycleLKEClusterNode recycles a single node of an LKE cluster.
+// NOTE: This endpoint isn't currently implemented by linodego.
+func recycleLKEClusterNode(ctx context.Context, client *linodego.Client, clusterID int, nodeID string) error {
+	endpoint := fmt.Sprintf("lke/clusters/%d/nodes/%s/recycle", clusterID, url.PathEscape(nodeID))
+
+	resp, err := client.R(ctx).Post(endpoint)
+	if err != nil {
+		return linodego.NewError(err)
+	}
+
+	if resp.IsError() {
+		return linodego.NewError(resp)
+	}
+
+	return nil
+}
+
+// customDiffValidateUpgradeStrategy ensures the upgrade strategy
+// recycles at least one node at a time and only references
+// declared pools.
+func customDiffValidateUpgradeStrategy(ctx context.Context, diff *schema.ResourceDiff, meta any) error {
+	strategy := expandUpgradeStrategy(diff.Get("upgrade_strategy").([]interface{}))
+	if strategy == nil {
+		return nil
+	}
+
+	if strategy.BatchSize() < 1 {
+		return fmt.Errorf("upgrade_strategy: at least one of `max_surge` and `max_unavailable` must be greater than 0")
+	}
+
+	poolCount := len(diff.Get("pool").([]interface{}))
+	seen := make(map[int]bool, len(strategy.PoolOrder))
+
+	for _, index := range strategy.PoolOrder {
+		if index >= poolCount {
+			return fmt.Errorf(
+				"upgrade_strategy.0.pool_order: pool index %d is out of range for %d pools", index, poolCount,
+			)
+		}
+
+		if seen[index] {
+			return fmt.Errorf("upgrade_strategy.0.pool_order: pool index %d is listed more than once", index)
+		}
+
+		seen[index] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,361 @@
+//go:build unit
+
+package lke
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+func TestExpandUpgradeStrategy(t *testing.T) {
+	if strategy := expandUpgradeStrategy([]interface{}{}); strategy != nil {
+		t.Errorf("expected nil strategy, got %#v", strategy)
+	}
+
+	strategy := expandUpgradeStrategy([]interface{}{
+		map[string]interface{}{
+			"max_surge":          2,
+			"max_unavailable":    1,
+			"pool_order":         []interface{}{1, 0},
+			"drain_wait_seconds": 30,
+		},
+	})
+
+	expected := &UpgradeStrategy{
+		MaxSurge:       2,
+		MaxUnavailable: 1,
+		PoolOrder:      []int{1, 0},
+		DrainWait:      30 * time.Second,
+	}
+
+	if !reflect.DeepEqual(expected, strategy) {
+		t.Errorf("expected strategy:\n%#v\ngot:\n%#v", expected, strategy)
+	}
+
+	if strategy.BatchSize() != 3 {
+		t.Errorf("expected batch size 3, got %d", strategy.BatchSize())
+	}
+}
+
+func TestOrderUpgradePools(t *testing.T) {
+	pools := []linodego.LKENodePool{{ID: 10}, {ID: 11}, {ID: 12}, {ID: 13}}
+
+	declaredPools := []interface{}{
+		map[string]interface{}{"id": 11},
+		map[string]interface{}{"id": 10},
+		map[string]interface{}{"id": 0}, // not created yet
+		map[string]interface{}{"id": 12},
+	}
+
+	for _, tc := range []struct {
+		name      string
+		poolOrder []int
+		expected  []int
+	}{
+		{
+			name:     "declared order",
+			expected: []int{11, 10, 12, 13},
+		},
+		{
+			name:      "explicit order",
+			poolOrder: []int{3, 1},
+			expected:  []int{12, 10, 11, 13},
+		},
+		{
+			name:      "pool not created yet",
+			poolOrder: []int{2, 0},
+			expected:  []int{11, 10, 12, 13},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			result := orderUpgradePools(pools, declaredPools, tc.poolOrder)
+
+			ids := make([]int, len(result))
+			for i, pool := range result {
+				ids[i] = pool.ID
+			}
+
+			if !reflect.DeepEqual(tc.expected, ids) {
+				t.Errorf("expected pool order %v, got %v", tc.expected, ids)
+			}
+		})
+	}
+}
+
+func TestBatchLKENodes(t *testing.T) {
+	nodes := []linodego.LKENodePoolLinode{{ID: "a"}, {ID: "b"}, {ID: "c"}, {ID: "d"}, {ID: "e"}}
+
+	for _, tc := range []struct {
+		batchSize int
+		expected  []int
+	}{
+		{batchSize: 1, expected: []int{1, 1, 1, 1, 1}},
+		{batchSize: 2, expected: []int{2, 2, 1}},
+		{batchSize: 5, expected: []int{5}},
+		{batchSize: 10, expected: []int{5}},
+	} {
+		batches := batchLKENodes(nodes, tc.batchSize)
+
+		sizes := make([]int, len(batches))
+		for i, batch := range batches {
+			sizes[i] = len(batch)
+		}
+
+		if !reflect.DeepEqual(tc.expected, sizes) {
+			t.Errorf("batch size %d: expected batches of %v, got %v", tc.batchSize, tc.expected, sizes)
+		}
+	}
+}
+
+func TestRecycleLKEClusterNode(t *testing.T) {
+	var requests []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Path == "/v4/lke/clusters/123/nodes/missing/recycle" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"errors": [{"reason": "Not found"}]}`))
+			return
+		}
+
+		w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client := linodego.NewClient(http.DefaultClient)
+	client.SetBaseURL(server.URL)
+
+	if err := recycleLKEClusterNode(context.Background(), &client, 123, "123-abc"); err != nil {
+		t.Fatal(err)
+	}
+
+	err := recycleLKEClusterNode(context.Background(), &client, 123, "missing")
+	if !linodego.IsNotFound(err) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+
+	expected := []string{
+		"POST /v4/lke/clusters/123/nodes/123-abc/recycle",
+		"POST /v4/lke/clusters/123/nodes/missing/recycle",
+	}
+
+	if !reflect.DeepEqual(expected, requests) {
+		t.Errorf("expected requests %v, got %v", expected, requests)
+	}
+}
+
+func TestResolvePendingUpgrade(t *testing.T) {
+	pools := []linodego.LKENodePool{
+		{
+			ID: 1,
+			Linodes: []linodego.LKENodePoolLinode{
+				{ID: "1-a"}, {ID: "1-new"},
+			},
+		},
+		{
+			ID: 2,
+			Linodes: []linodego.LKENodePoolLinode{
+				{ID: "2-a"}, {ID: "2-b"},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		pending         *PendingUpgrade
+		expectedVersion string
+		expected        *PendingUpgrade
+	}{
+		"no pending upgrade": {
+			pending:         nil,
+			expectedVersion: "1.31",
+		},
+		"pending upgrade": {
+			pending: &PendingUpgrade{
+				K8sVersion: "1.31",
+				NodeIDs:    []string{"2-b", "1-a", "1-recycled"},
+			},
+			expectedVersion: "1.30",
+			expected: &PendingUpgrade{
+				K8sVersion: "1.31",
+				NodeIDs:    []string{"1-a", "2-b"},
+			},
+		},
+		"nodes recycled outside of terraform": {
+			pending: &PendingUpgrade{
+				K8sVersion: "1.31",
+				NodeIDs:    []string{"1-recycled"},
+			},
+			expectedVersion: "1.31",
+		},
+		"cluster upgraded to another version": {
+			pending: &PendingUpgrade{
+				K8sVersion: "1.30",
+				NodeIDs:    []string{"1-a"},
+			},
+			expectedVersion: "1.31",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			version, pending := resolvePendingUpgrade("1.30", "1.31", tc.pending, pools)
+
+			if version != tc.expectedVersion {
+				t.Errorf("expected version %q, got %q", tc.expectedVersion, version)
+			}
+
+			if !reflect.DeepEqual(tc.expected, pending) {
+				t.Errorf("expected pending upgrade %#v, got %#v", tc.expected, pending)
+			}
+		})
+	}
+}
+
+func TestUpgradeNodeIDs(t *testing.T) {
+	pools := []linodego.LKENodePool{
+		{
+			ID: 1,
+			Linodes: []linodego.LKENodePoolLinode{
+				{ID: "1-a"}, {ID: "1-b"},
+			},
+		},
+	}
+
+	pending := &PendingUpgrade{K8sVersion: "1.31", NodeIDs: []string{"1-b"}}
+
+	if nodeIDs := upgradeNodeIDs(pools, pending, "1.31"); !reflect.DeepEqual(map[string]bool{"1-b": true}, nodeIDs) {
+		t.Errorf("expected only the remaining nodes of the pending upgrade, got %v", nodeIDs)
+	}
+
+	expected := map[string]bool{"1-a": true, "1-b": true}
+
+	if nodeIDs := upgradeNodeIDs(pools, pending, "1.32"); !reflect.DeepEqual(expected, nodeIDs) {
+		t.Errorf("expected all nodes for another version, got %v", nodeIDs)
+	}
+
+	if nodeIDs := upgradeNodeIDs(pools, nil, "1.31"); !reflect.DeepEqual(expected, nodeIDs) {
+		t.Errorf("expected all nodes without a pending upgrade, got %v", nodeIDs)
+	}
+}
+
+func TestRollingUpgradeLKENodePool_restoresSizeOnFailure(t *testing.T) {
+	var updates []linodego.LKENodePoolUpdateOptions
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == http.MethodPut && r.URL.Path == "/v4/lke/clusters/123/pools/456":
+			body, _ := io.ReadAll(r.Body)
+
+			var opts linodego.LKENodePoolUpdateOptions
+			if err := json.Unmarshal(body, &opts); err != nil {
+				t.Errorf("failed to unmarshal update options: %s", err)
+			}
+
+			updates = append(updates, opts)
+			w.Write([]byte(`{"id": 456}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/v4/lke/clusters/123/pools/456":
+			w.Write([]byte(`{"id": 456, "nodes": [{"id": "123-abc", "status": "ready"}]}`))
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"errors": [{"reason": "Internal server error"}]}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	client := linodego.NewClient(http.DefaultClient)
+	client.SetBaseURL(server.URL)
+
+	meta := &helper.ProviderMeta{
+		Client: client,
+		Config: &helper.Config{
+			EventPollMilliseconds:        1,
+			LKENodeReadyPollMilliseconds: 1,
+		},
+	}
+
+	pool := linodego.LKENodePool{
+		ID:    456,
+		Count: 1,
+		Linodes: []linodego.LKENodePoolLinode{
+			{ID: "123-abc", Status: linodego.LKELinodeReady},
+		},
+		Autoscaler: linodego.LKENodePoolAutoscaler{
+			Enabled: true,
+			Min:     1,
+			Max:     3,
+		},
+	}
+
+	err := rollingUpgradeLKENodePool(
+		context.Background(), meta, 123, pool, map[string]bool{"123-abc": true}, UpgradeStrategy{MaxSurge: 2},
+	)
+	if err == nil {
+		t.Fatal("expected the failed recycle to be returned")
+	}
+
+	expected := []linodego.LKENodePoolUpdateOptions{
+		{
+			Count:      3,
+			Autoscaler: &linodego.LKENodePoolAutoscaler{Enabled: true, Min: 3, Max: 5},
+		},
+		{
+			Count:      1,
+			Autoscaler: &linodego.LKENodePoolAutoscaler{Enabled: true, Min: 1, Max: 3},
+		},
+	}
+
+	if !reflect.DeepEqual(expected, updates) {
+		t.Errorf("expected updates:\n%#v\ngot:\n%#v", expected, updates)
+	}
+}
+
+func TestRollingUpgradeLKENodePool_skipsUpgradedNodes(t *testing.T) {
+	var requests []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	t.Cleanup(server.Close)
+
+	client := linodego.NewClient(http.DefaultClient)
+	client.SetBaseURL(server.URL)
+
+	meta := &helper.ProviderMeta{
+		Client: client,
+		Config: &helper.Config{},
+	}
+
+	pool := linodego.LKENodePool{
+		ID:    456,
+		Count: 1,
+		Linodes: []linodego.LKENodePoolLinode{
+			{ID: "123-upgraded", Status: linodego.LKELinodeReady},
+		},
+	}
+
+	err := rollingUpgradeLKENodePool(
+		context.Background(), meta, 123, pool, map[string]bool{"123-abc": true}, UpgradeStrategy{MaxSurge: 1},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(requests) > 0 {
+		t.Errorf("expected a pool without remaining nodes to be skipped, got requests %v", requests)
+	}
+}
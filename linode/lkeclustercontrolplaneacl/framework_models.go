@@ -0,0 +1,68 @@
+package lkeclustercontrolplaneacl
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+type ResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	ClusterID types.Int64  `tfsdk:"cluster_id"`
+	Enabled   types.Bool   `tfsdk:"enabled"`
+	IPv4      types.Set    `tfsdk:"ipv4"`
+	IPv6      types.Set    `tfsdk:"ipv6"`
+}
+
+func (data *ResourceModel) FlattenControlPlaneACL(
+	clusterID int, acl linodego.LKEClusterControlPlaneACL, preserveKnown bool, diags *diag.Diagnostics,
+) {
+	ipv4 := make([]string, 0)
+	ipv6 := make([]string, 0)
+
+	if acl.Addresses != nil {
+		ipv4 = append(ipv4, acl.Addresses.IPv4...)
+		ipv6 = append(ipv6, acl.Addresses.IPv6...)
+	}
+
+	data.ID = helper.KeepOrUpdateString(data.ID, strconv.Itoa(clusterID), preserveKnown)
+	data.ClusterID = helper.KeepOrUpdateInt64(data.ClusterID, int64(clusterID), preserveKnown)
+	data.Enabled = helper.KeepOrUpdateBool(data.Enabled, acl.Enabled, preserveKnown)
+	data.IPv4 = helper.KeepOrUpdateStringSet(data.IPv4, ipv4, preserveKnown, diags)
+	data.IPv6 = helper.KeepOrUpdateStringSet(data.IPv6, ipv6, preserveKnown, diags)
+}
+
+func (data *ResourceModel) CopyFrom(other ResourceModel, preserveKnown bool) {
+	data.ID = helper.KeepOrUpdateValue(data.ID, other.ID, preserveKnown)
+	data.ClusterID = helper.KeepOrUpdateValue(data.ClusterID, other.ClusterID, preserveKnown)
+	data.Enabled = helper.KeepOrUpdateValue(data.Enabled, other.Enabled, preserveKnown)
+	data.IPv4 = helper.KeepOrUpdateValue(data.IPv4, other.IPv4, preserveKnown)
+	data.IPv6 = helper.KeepOrUpdateValue(data.IPv6, other.IPv6, preserveKnown)
+}
+
+// GetUpdateOptions returns the options to replace the whole ACL of the cluster
+// with the planned one. Addresses that aren't declared are always sent as empty
+// lists so that any addresses added outside of Terraform are removed.
+func (data *ResourceModel) GetUpdateOptions(
+	ctx context.Context, diags *diag.Diagnostics,
+) linodego.LKEClusterControlPlaneACLUpdateOptions {
+	ipv4 := make([]string, 0)
+	ipv6 := make([]string, 0)
+
+	diags.Append(data.IPv4.ElementsAs(ctx, &ipv4, false)...)
+	diags.Append(data.IPv6.ElementsAs(ctx, &ipv6, false)...)
+
+	return linodego.LKEClusterControlPlaneACLUpdateOptions{
+		ACL: linodego.LKEClusterControlPlaneACLOptions{
+			Enabled: data.Enabled.ValueBoolPointer(),
+			Addresses: &linodego.LKEClusterControlPlaneACLAddressesOptions{
+				IPv4: &ipv4,
+				IPv6: &ipv6,
+			},
+		},
+	}
+}
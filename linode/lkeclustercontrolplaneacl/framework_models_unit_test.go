@@ -0,0 +1,76 @@
+//go:build unit
+
+package lkeclustercontrolplaneacl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlattenControlPlaneACL(t *testing.T) {
+	acl := linodego.LKEClusterControlPlaneACL{
+		Enabled: true,
+		Addresses: &linodego.LKEClusterControlPlaneACLAddresses{
+			IPv4: []string{"203.0.113.1", "0.0.0.0/0"},
+			IPv6: []string{"2001:db8::/32"},
+		},
+	}
+
+	var model ResourceModel
+	var diags diag.Diagnostics
+
+	model.FlattenControlPlaneACL(123, acl, false, &diags)
+	assert.False(t, diags.HasError())
+
+	assert.Equal(t, types.StringValue("123"), model.ID)
+	assert.Equal(t, types.Int64Value(123), model.ClusterID)
+	assert.Equal(t, types.BoolValue(true), model.Enabled)
+
+	var ipv4, ipv6 []string
+	assert.False(t, model.IPv4.ElementsAs(context.Background(), &ipv4, false).HasError())
+	assert.False(t, model.IPv6.ElementsAs(context.Background(), &ipv6, false).HasError())
+
+	assert.ElementsMatch(t, []string{"203.0.113.1", "0.0.0.0/0"}, ipv4)
+	assert.ElementsMatch(t, []string{"2001:db8::/32"}, ipv6)
+}
+
+func TestFlattenControlPlaneACL_noAddresses(t *testing.T) {
+	var model ResourceModel
+	var diags diag.Diagnostics
+
+	model.FlattenControlPlaneACL(123, linodego.LKEClusterControlPlaneACL{}, false, &diags)
+	assert.False(t, diags.HasError())
+
+	assert.Equal(t, types.BoolValue(false), model.Enabled)
+	assert.False(t, model.IPv4.IsNull())
+	assert.Empty(t, model.IPv4.Elements())
+	assert.False(t, model.IPv6.IsNull())
+	assert.Empty(t, model.IPv6.Elements())
+}
+
+func TestGetUpdateOptions(t *testing.T) {
+	var diags diag.Diagnostics
+
+	model := ResourceModel{
+		ClusterID: types.Int64Value(123),
+		Enabled:   types.BoolValue(true),
+		IPv4:      helper.KeepOrUpdateStringSet(types.SetNull(types.StringType), []string{"0.0.0.0/0"}, false, &diags),
+		IPv6:      helper.KeepOrUpdateStringSet(types.SetNull(types.StringType), []string{}, false, &diags),
+	}
+
+	opts := model.GetUpdateOptions(context.Background(), &diags)
+	assert.False(t, diags.HasError())
+
+	assert.Equal(t, true, *opts.ACL.Enabled)
+	assert.Equal(t, []string{"0.0.0.0/0"}, *opts.ACL.Addresses.IPv4)
+
+	// Undeclared addresses must be cleared rather than omitted
+	assert.NotNil(t, opts.ACL.Addresses.IPv6)
+	assert.Empty(t, *opts.ACL.Addresses.IPv6)
+}
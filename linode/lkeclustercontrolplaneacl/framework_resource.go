@@ -0,0 +1,254 @@
+package lkeclustercontrolplaneacl
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+func NewResource() resource.Resource {
+	return &Resource{
+		BaseResource: helper.NewBaseResource(
+			helper.BaseResourceConfig{
+				Name:   "linode_lke_cluster_control_plane_acl",
+				IDType: types.StringType,
+				Schema: &frameworkResourceSchema,
+			},
+		),
+	}
+}
+
+type Resource struct {
+	helper.BaseResource
+}
+
+func updateControlPlaneACL(
+	ctx context.Context, client *linodego.Client, plan *ResourceModel, diags *diag.Diagnostics,
+) {
+	clusterID := helper.FrameworkSafeInt64ToInt(plan.ClusterID.ValueInt64(), diags)
+	if diags.HasError() {
+		return
+	}
+
+	updateOpts := plan.GetUpdateOptions(ctx, diags)
+	if diags.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "client.UpdateLKEClusterControlPlaneACL(...)", map[string]any{
+		"options": updateOpts,
+	})
+
+	aclResp, err := client.UpdateLKEClusterControlPlaneACL(ctx, clusterID, updateOpts)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Failed to update control plane ACL for LKE cluster %d", clusterID),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.FlattenControlPlaneACL(clusterID, aclResp.ACL, true, diags)
+
+	// IDs should always be overridden during creation (see #1085)
+	// TODO: Remove when Crossplane empty string ID issue is resolved
+	plan.ID = types.StringValue(strconv.Itoa(clusterID))
+}
+
+func (r *Resource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	tflog.Debug(ctx, "Create "+r.Config.Name)
+
+	var plan ResourceModel
+	client := r.Meta.Client
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, plan)
+
+	updateControlPlaneACL(ctx, client, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	tflog.Debug(ctx, "Read "+r.Config.Name)
+	client := r.Meta.Client
+
+	var state ResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if helper.FrameworkAttemptRemoveResourceForEmptyID(ctx, state.ID, resp) {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, state)
+
+	clusterID := helper.FrameworkSafeInt64ToInt(state.ClusterID.ValueInt64(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "client.GetLKEClusterControlPlaneACL(...)")
+
+	aclResp, err := client.GetLKEClusterControlPlaneACL(ctx, clusterID)
+	if err != nil {
+		if linodego.IsNotFound(err) {
+			resp.Diagnostics.AddWarning(
+				"LKE Cluster No Longer Exists",
+				fmt.Sprintf(
+					"Removing control plane ACL of LKE cluster %d from state because the "+
+						"cluster no longer exists",
+					clusterID,
+				),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to get control plane ACL for LKE cluster %d", clusterID),
+			err.Error(),
+		)
+		return
+	}
+
+	// Known values are not preserved so that changes made
+	// outside of Terraform are detected as drift
+	state.FlattenControlPlaneACL(clusterID, aclResp.ACL, false, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *Resource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	tflog.Debug(ctx, "Update "+r.Config.Name)
+	var plan, state ResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, plan)
+
+	client := r.Meta.Client
+
+	if !plan.Enabled.Equal(state.Enabled) ||
+		!plan.IPv4.Equal(state.IPv4) ||
+		!plan.IPv6.Equal(state.IPv6) {
+		updateControlPlaneACL(ctx, client, &plan, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	plan.CopyFrom(state, true)
+
+	// Workaround for Crossplane issue where ID is not
+	// properly populated in plan
+	// See TPT-2865 for more details
+	if plan.ID.ValueString() == "" {
+		plan.ID = state.ID
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	tflog.Debug(ctx, "Delete "+r.Config.Name)
+	var state ResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = populateLogAttributes(ctx, state)
+
+	clusterID := helper.FrameworkSafeInt64ToInt(state.ClusterID.ValueInt64(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := r.Meta.Client
+
+	tflog.Debug(ctx, "client.DeleteLKEClusterControlPlaneACL(...)")
+
+	// Deleting the ACL restores the default policy of allowing all addresses
+	if err := client.DeleteLKEClusterControlPlaneACL(ctx, clusterID); err != nil {
+		if linodego.IsNotFound(err) {
+			resp.Diagnostics.AddWarning(
+				fmt.Sprintf(
+					"Attempted to delete control plane ACL of LKE cluster %d but the cluster was not found",
+					clusterID,
+				),
+				err.Error(),
+			)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to delete control plane ACL for LKE cluster %d", clusterID),
+			err.Error(),
+		)
+	}
+}
+
+func (r *Resource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	tflog.Debug(ctx, "Import "+r.Config.Name)
+
+	helper.ImportStatePassthroughInt64ID(ctx, path.Root("cluster_id"), req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The cluster ID is also used as the ID of this resource
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+func populateLogAttributes(ctx context.Context, data ResourceModel) context.Context {
+	return helper.SetLogFieldBulk(ctx, map[string]any{
+		"cluster_id": data.ClusterID.ValueInt64(),
+	})
+}
@@ -0,0 +1,155 @@
+//go:build integration || lkeclustercontrolplaneacl
+
+package lkeclustercontrolplaneacl_test
+
+import (
+	"context"
+	"log"
+	"sort"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+	"github.com/linode/terraform-provider-linode/v2/linode/lkeclustercontrolplaneacl/tmpl"
+)
+
+const resourceName = "linode_lke_cluster_control_plane_acl.test"
+
+var (
+	k8sVersion string
+	testRegion string
+)
+
+func init() {
+	client, err := acceptance.GetTestClient()
+	if err != nil {
+		log.Fatalf("failed to get client: %s", err)
+	}
+
+	versions, err := client.ListLKEVersions(context.Background(), nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	k8sVersions := make([]string, len(versions))
+	for i, v := range versions {
+		k8sVersions[i] = v.ID
+	}
+
+	sort.Strings(k8sVersions)
+
+	if len(k8sVersions) < 1 {
+		log.Fatal("no k8s versions found")
+	}
+
+	k8sVersion = k8sVersions[len(k8sVersions)-1]
+
+	region, err := acceptance.GetRandomRegionWithCaps([]string{"kubernetes"}, "core")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	testRegion = region
+}
+
+func TestAccResourceLKEClusterControlPlaneACL_basic(t *testing.T) {
+	t.Parallel()
+
+	var clusterID int
+
+	testIPv4 := []string{"0.0.0.0/0"}
+	testIPv6 := []string{"2001:db8::/32"}
+	testIPv4Updated := []string{"203.0.113.1", "203.0.113.2"}
+
+	label := acctest.RandomWithPrefix("tf_test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.PreCheck(t) },
+		ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+		CheckDestroy:             acceptance.CheckLKEClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: tmpl.Basic(t, label, testRegion, k8sVersion, true, testIPv4, testIPv6),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_id", "linode_lke_cluster.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "id", "linode_lke_cluster.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "ipv4.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "ipv4.*", testIPv4[0]),
+					resource.TestCheckResourceAttr(resourceName, "ipv6.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "ipv6.*", testIPv6[0]),
+					resource.TestCheckResourceAttrWith(resourceName, "cluster_id", func(value string) (err error) {
+						clusterID, err = strconv.Atoi(value)
+						return err
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Addresses that are no longer declared are removed
+			{
+				Config: tmpl.Basic(t, label, testRegion, k8sVersion, true, testIPv4Updated, nil),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "ipv4.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "ipv4.*", testIPv4Updated[0]),
+					resource.TestCheckTypeSetElemAttr(resourceName, "ipv4.*", testIPv4Updated[1]),
+					resource.TestCheckResourceAttr(resourceName, "ipv6.#", "0"),
+				),
+			},
+			// Changes made outside of Terraform are detected and reverted
+			{
+				PreConfig: func() {
+					client, err := acceptance.GetTestClient()
+					if err != nil {
+						t.Fatalf("failed to get client: %s", err)
+					}
+
+					ipv4 := []string{"198.51.100.0/24"}
+					ipv6 := []string{}
+
+					if _, err := client.UpdateLKEClusterControlPlaneACL(
+						context.Background(),
+						clusterID,
+						linodego.LKEClusterControlPlaneACLUpdateOptions{
+							ACL: linodego.LKEClusterControlPlaneACLOptions{
+								Enabled: linodego.Pointer(true),
+								Addresses: &linodego.LKEClusterControlPlaneACLAddressesOptions{
+									IPv4: &ipv4,
+									IPv6: &ipv6,
+								},
+							},
+						},
+					); err != nil {
+						t.Fatalf("failed to update control plane ACL: %s", err)
+					}
+				},
+				Config: tmpl.Basic(t, label, testRegion, k8sVersion, true, testIPv4Updated, nil),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ipv4.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "ipv4.*", testIPv4Updated[0]),
+					resource.TestCheckTypeSetElemAttr(resourceName, "ipv4.*", testIPv4Updated[1]),
+				),
+			},
+			{
+				Config: tmpl.Basic(t, label, testRegion, k8sVersion, false, testIPv4Updated, nil),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "ipv4.#", "2"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,28 @@
+package tmpl
+
+import (
+	"testing"
+
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+)
+
+type TemplateData struct {
+	Label      string
+	Region     string
+	K8sVersion string
+	Enabled    bool
+	IPv4       []string
+	IPv6       []string
+}
+
+func Basic(t *testing.T, label, region, k8sVersion string, enabled bool, ipv4, ipv6 []string) string {
+	return acceptance.ExecuteTemplate(t,
+		"lke_cluster_control_plane_acl_basic", TemplateData{
+			Label:      label,
+			Region:     region,
+			K8sVersion: k8sVersion,
+			Enabled:    enabled,
+			IPv4:       ipv4,
+			IPv6:       ipv6,
+		})
+}
@@ -14,16 +14,17 @@ import (
 )
 
 type NodePoolModel struct {
-	ID             types.String              `tfsdk:"id"`
-	ClusterID      types.Int64               `tfsdk:"cluster_id"`
-	Count          types.Int64               `tfsdk:"node_count"`
-	Type           types.String              `tfsdk:"type"`
-	DiskEncryption types.String              `tfsdk:"disk_encryption"`
-	Tags           types.Set                 `tfsdk:"tags"`
-	Nodes          types.List                `tfsdk:"nodes"`
-	Autoscaler     []NodePoolAutoscalerModel `tfsdk:"autoscaler"`
-	Taints         []NodePoolTaintModel      `tfsdk:"taint"`
-	Labels         types.Map                 `tfsdk:"labels"`
+	ID              types.String              `tfsdk:"id"`
+	ClusterID       types.Int64               `tfsdk:"cluster_id"`
+	Count           types.Int64               `tfsdk:"node_count"`
+	Type            types.String              `tfsdk:"type"`
+	DiskEncryption  types.String              `tfsdk:"disk_encryption"`
+	Tags            types.Set                 `tfsdk:"tags"`
+	Nodes           types.List                `tfsdk:"nodes"`
+	Autoscaler      []NodePoolAutoscalerModel `tfsdk:"autoscaler"`
+	Taints          []NodePoolTaintModel      `tfsdk:"taint"`
+	Labels          types.Map                 `tfsdk:"labels"`
+	ReplaceStrategy types.String              `tfsdk:"replace_strategy"`
 }
 
 type NodePoolAutoscalerModel struct {
@@ -4,15 +4,22 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/linode/linodego"
 	"github.com/linode/terraform-provider-linode/v2/linode/helper"
 )
 
+// replacementCleanupTimeout is the time allowed to delete an unready
+// replacement node pool after the update has timed out.
+const replacementCleanupTimeout = 5 * time.Minute
+
 func NewResource() resource.Resource {
 	return &Resource{
 		BaseResource: helper.NewBaseResource(
@@ -80,6 +87,12 @@ func (r *Resource) Read(
 		return
 	}
 
+	// The replace strategy isn't returned by the API,
+	// e.g. when the node pool is imported
+	if data.ReplaceStrategy.IsNull() {
+		data.ReplaceStrategy = types.StringValue(ReplaceStrategyRecreate)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	tflog.Trace(ctx, "Read linode_lke_node_pool done")
 }
@@ -172,14 +185,20 @@ func (r *Resource) Update(
 		return
 	}
 
-	var updateOpts linodego.LKENodePoolUpdateOptions
-
-	plan.SetNodePoolUpdateOptions(ctx, &updateOpts, &resp.Diagnostics)
+	clusterID, poolID := state.ExtractClusterAndNodePoolIDs(&resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	clusterID, poolID := state.ExtractClusterAndNodePoolIDs(&resp.Diagnostics)
+	// The type can only be changed in-place with the create_before_delete strategy
+	if !plan.Type.Equal(state.Type) {
+		r.replaceNodePool(ctx, plan, clusterID, poolID, resp)
+		return
+	}
+
+	var updateOpts linodego.LKENodePoolUpdateOptions
+
+	plan.SetNodePoolUpdateOptions(ctx, &updateOpts, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -222,6 +241,141 @@ func (r *Resource) Update(
 	tflog.Trace(ctx, "Update linode_lke_node_pool done")
 }
 
+// replaceNodePool creates a node pool replacing the node pool with the given ID
+// and deletes the old node pool once all nodes of the new node pool are ready.
+// If the new node pool doesn't become ready, it is deleted and the old node pool is kept.
+func (r *Resource) replaceNodePool(
+	ctx context.Context,
+	plan NodePoolModel,
+	clusterID, oldPoolID int,
+	resp *resource.UpdateResponse,
+) {
+	client := r.Meta.Client
+
+	var createOpts linodego.LKENodePoolCreateOptions
+
+	plan.SetNodePoolCreateOptions(ctx, &createOpts, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "client.CreateLKENodePool(...)", map[string]any{
+		"cluster_id": clusterID,
+		"options":    createOpts,
+	})
+	pool, err := client.CreateLKENodePool(ctx, clusterID, createOpts)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating replacement Linode Node Pool", err.Error())
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "new_pool_id", pool.ID)
+
+	tflog.Debug(ctx, "waiting for replacement node pool to enter ready status")
+	readyPool, err := WaitForNodePoolReady(ctx,
+		*client,
+		int(r.Meta.Config.EventPollMilliseconds.ValueInt64()),
+		clusterID,
+		pool.ID,
+	)
+	if err != nil {
+		// The old pool is kept in the state, so the unready replacement
+		// is deleted to avoid leaking it.
+		if cleanupErr := deleteReplacementNodePool(ctx, *client, clusterID, pool.ID); cleanupErr != nil {
+			resp.Diagnostics.AddError(
+				"Failed to delete unready replacement Node Pool",
+				fmt.Sprintf(
+					"Node Pool %d is not ready and could not be deleted, please delete it manually: %s",
+					pool.ID, cleanupErr,
+				),
+			)
+		}
+
+		resp.Diagnostics.AddError(
+			"Replacement Linode Node Pool is not ready",
+			fmt.Sprintf(
+				"Node Pool %d was not replaced as its replacement %d isn't ready: %s",
+				oldPoolID, pool.ID, err,
+			),
+		)
+		return
+	}
+
+	plan.FlattenLKENodePool(ctx, readyPool, true, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(strconv.Itoa(readyPool.ID))
+
+	// Track the ready replacement before deleting the old pool
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "client.DeleteLKENodePool(...)", map[string]any{
+		"cluster_id": clusterID,
+		"pool_id":    oldPoolID,
+	})
+	if err := client.DeleteLKENodePool(ctx, clusterID, oldPoolID); err != nil {
+		if lerr, ok := err.(*linodego.Error); !ok || lerr.Code != 404 {
+			resp.Diagnostics.AddError(
+				"Failed to delete replaced Node Pool",
+				fmt.Sprintf(
+					"Node Pool %d was replaced by %d but could not be deleted, please delete it manually: %s",
+					oldPoolID, pool.ID, err,
+				),
+			)
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "Update linode_lke_node_pool done")
+}
+
+// deleteReplacementNodePool deletes a replacement node pool that failed to
+// become ready. This is done even if the context has been canceled, since
+// the pool is not tracked in the state.
+func deleteReplacementNodePool(ctx context.Context, client linodego.Client, clusterID, poolID int) error {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), replacementCleanupTimeout)
+	defer cancel()
+
+	tflog.Debug(ctx, "client.DeleteLKENodePool(...)", map[string]any{
+		"cluster_id": clusterID,
+		"pool_id":    poolID,
+	})
+	if err := client.DeleteLKENodePool(ctx, clusterID, poolID); err != nil && !linodego.IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+func (r *Resource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan NodePoolModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A node pool replaced in-place gets a new ID
+	if !plan.Type.IsUnknown() && !plan.Type.Equal(state.Type) &&
+		plan.ReplaceStrategy.ValueString() == ReplaceStrategyCreateBeforeDelete {
+		resp.Plan.SetAttribute(ctx, path.Root("id"), types.StringUnknown())
+	}
+}
+
 func (r *Resource) Delete(
 	ctx context.Context,
 	req resource.DeleteRequest,
@@ -284,6 +438,21 @@ func (r *Resource) ImportState(
 		})
 }
 
+func requiresReplaceUnlessCreateBeforeDelete(
+	ctx context.Context,
+	req planmodifier.StringRequest,
+	resp *stringplanmodifier.RequiresReplaceIfFuncResponse,
+) {
+	var replaceStrategy types.String
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("replace_strategy"), &replaceStrategy)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.RequiresReplace = replaceStrategy.ValueString() != ReplaceStrategyCreateBeforeDelete
+}
+
 func AddPoolResource(
 	ctx context.Context, p *linodego.LKENodePool, resp *resource.CreateResponse, plan NodePoolModel,
 ) {
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -17,6 +18,11 @@ import (
 	linodeplanmodifiers "github.com/linode/terraform-provider-linode/v2/linode/helper/planmodifiers"
 )
 
+const (
+	ReplaceStrategyRecreate           = "recreate"
+	ReplaceStrategyCreateBeforeDelete = "create_before_delete"
+)
+
 var resourceSchema = schema.Schema{
 	Version: 0,
 	Attributes: map[string]schema.Attribute{
@@ -50,7 +56,25 @@ var resourceSchema = schema.Schema{
 			Description: "The type of node pool.",
 			Required:    true,
 			PlanModifiers: []planmodifier.String{
-				stringplanmodifier.RequiresReplace(),
+				stringplanmodifier.RequiresReplaceIf(
+					requiresReplaceUnlessCreateBeforeDelete,
+					"Changing the type requires replacing the node pool unless "+
+						"replace_strategy is create_before_delete.",
+					"Changing the type requires replacing the node pool unless "+
+						"`replace_strategy` is `create_before_delete`.",
+				),
+			},
+		},
+		"replace_strategy": schema.StringAttribute{
+			Description: "How the node pool is replaced when its type is changed. " +
+				"recreate replaces the resource, deleting the node pool before its replacement is created. " +
+				"create_before_delete creates the replacement node pool and waits for all of its nodes " +
+				"to be ready before the old node pool is deleted.",
+			Optional: true,
+			Computed: true,
+			Default:  stringdefault.StaticString(ReplaceStrategyRecreate),
+			Validators: []validator.String{
+				stringvalidator.OneOf(ReplaceStrategyRecreate, ReplaceStrategyCreateBeforeDelete),
 			},
 		},
 		"disk_encryption": schema.StringAttribute{
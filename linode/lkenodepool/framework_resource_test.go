@@ -17,6 +17,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
 	"github.com/linode/terraform-provider-linode/v2/linode/helper"
 	"github.com/linode/terraform-provider-linode/v2/linode/lkenodepool/tmpl"
@@ -311,6 +312,90 @@ func TestAccResourceNodePool_update_type(t *testing.T) {
 	})
 }
 
+func TestAccResourceNodePool_replaceStrategy(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_lke_node_pool.foobar"
+	clusterLabel := acctest.RandomWithPrefix("tf_test_")
+	poolTag := acctest.RandomWithPrefix("tf_test_")
+
+	templateData := createTemplateData()
+	templateData.ClusterLabel = clusterLabel
+	templateData.PoolTag = poolTag
+	templateData.AutoscalerEnabled = false
+	templateData.NodeCount = 1
+	templateData.ReplaceStrategy = "create_before_delete"
+	createConfig := createResourceConfig(t, &templateData)
+
+	templateData.PoolNodeType = "g6-standard-2"
+	updateConfig := createResourceConfig(t, &templateData)
+
+	var oldClusterID, oldPoolID int
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acceptance.PreCheck(t) },
+		ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+		CheckDestroy:             checkNodePoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: createConfig,
+				Check: resource.ComposeTestCheckFunc(
+					checkNodePoolExists,
+					resource.TestCheckResourceAttr(resName, "type", "g6-standard-1"),
+					resource.TestCheckResourceAttr(resName, "replace_strategy", "create_before_delete"),
+					func(s *terraform.State) (err error) {
+						oldClusterID, oldPoolID, err = extractIDs(s)
+						return err
+					},
+				),
+			},
+			{
+				ResourceName:            resName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       resourceImportStateID,
+				ImportStateVerifyIgnore: []string{"replace_strategy"},
+			},
+			{
+				Config: updateConfig,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					checkNodePoolExists,
+					resource.TestCheckResourceAttr(resName, "type", "g6-standard-2"),
+					resource.TestCheckResourceAttr(resName, "nodes.#", "1"),
+					func(s *terraform.State) error {
+						_, poolID, err := extractIDs(s)
+						if err != nil {
+							return err
+						}
+
+						if poolID == oldPoolID {
+							return fmt.Errorf("expected node pool %d to be replaced", oldPoolID)
+						}
+
+						client := acceptance.TestAccProvider.Meta().(*helper.ProviderMeta).Client
+
+						_, err = client.GetLKENodePool(context.Background(), oldClusterID, oldPoolID)
+						if err == nil {
+							return fmt.Errorf("expected replaced node pool %d to be deleted", oldPoolID)
+						}
+
+						if !linodego.IsNotFound(err) {
+							return err
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func TestAccResourceNodePool_taints_labels(t *testing.T) {
 	t.Parallel()
 
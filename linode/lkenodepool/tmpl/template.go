@@ -25,6 +25,7 @@ type TemplateData struct {
 	AutoscalerMax     int
 	Taints            []TaintData
 	Labels            map[string]string
+	ReplaceStrategy   string
 }
 
 func Generate(t *testing.T, data *TemplateData) string {
@@ -45,14 +45,14 @@ func isCluster(regionOrCluster string) bool {
 	return re.MatchString(regionOrCluster)
 }
 
-// createTempKeys creates temporary Object Storage Keys to use.
+// CreateTempKeys creates temporary Object Storage Keys to use.
 // The temporary keys are scoped only to the target cluster and bucket with limited permissions.
 // Keys only exist for the duration of the apply time.
-func createTempKeys(
+func CreateTempKeys(
 	ctx context.Context,
 	client linodego.Client,
 	bucket, regionOrCluster, permissions string,
-) (*linodego.ObjectStorageKey, diag.Diagnostics) {
+) (*linodego.ObjectStorageKey, error) {
 	tflog.Debug(ctx, "Create temporary object storage access keys implicitly.")
 
 	tempBucketAccess := linodego.ObjectStorageKeyBucketAccess{
@@ -76,12 +76,7 @@ func createTempKeys(
 		"options": createOpts,
 	})
 
-	keys, err := client.CreateObjectStorageKey(ctx, createOpts)
-	if err != nil {
-		return nil, diag.FromErr(err)
-	}
-
-	return keys, nil
+	return client.CreateObjectStorageKey(ctx, createOpts)
 }
 
 // checkObjKeysConfigured checks whether AccessKey and SecretKey both exist.
@@ -130,9 +125,9 @@ func GetObjKeys(
 			objKeys = providerKeys
 		} else if config.ObjUseTempKeys {
 			// Implicitly create temporary object storage keys
-			keys, diag := createTempKeys(ctx, client, bucket, regionOrCluster, permission)
-			if diag != nil {
-				return objKeys, diag, nil
+			keys, err := CreateTempKeys(ctx, client, bucket, regionOrCluster, permission)
+			if err != nil {
+				return objKeys, diag.FromErr(err), nil
 			}
 			objKeys.AccessKey = keys.AccessKey
 			objKeys.SecretKey = keys.SecretKey
@@ -469,14 +469,25 @@ func expandBucketCert(v any) linodego.ObjectStorageBucketCertUploadOptions {
 	}
 }
 
+// ParseBucketID returns the region or cluster and the label
+// from a bucket ID of the form <ClusterOrRegion>:<Label>.
+func ParseBucketID(id string) (regionOrCluster, label string, err error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf(
+			"Linode Object Storage Bucket ID must be of the form <ClusterOrRegion>:<Label>, got %q", id,
+		)
+	}
+
+	return parts[0], parts[1], nil
+}
+
 func DecodeBucketID(ctx context.Context, id string, d *schema.ResourceData) (regionOrCluster, label string, err error) {
 	tflog.Debug(ctx, "decoding bucket ID")
-	parts := strings.Split(id, ":")
-	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
-		regionOrCluster = parts[0]
-		label = parts[1]
+	if regionOrCluster, label, err = ParseBucketID(id); err == nil {
 		return
 	}
+	err = nil
 	tflog.Warn(ctx, "Corrupted bucket ID detected, trying to recover it from cluster and label attributes.")
 
 	recoveredCluster, clusterOk := d.GetOk("cluster")
@@ -0,0 +1,80 @@
+//go:build integration || objtempkey
+
+package objtempkey_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+	"github.com/linode/terraform-provider-linode/v2/linode/objtempkey/tmpl"
+)
+
+var testRegion string
+
+func init() {
+	region, err := acceptance.GetRandomRegionWithCaps([]string{"Object Storage"}, "core")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	testRegion = region
+}
+
+func TestAccEphemeralResourceObjectStorageTempKey_basic(t *testing.T) {
+	t.Parallel()
+
+	resName := "echo.test"
+	label := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acceptance.PreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acceptance.ProtoV6EchoProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: tmpl.Basic(t, label, testRegion),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "data.bucket_name", label+"-bucket"),
+					resource.TestCheckResourceAttr(resName, "data.region", testRegion),
+					resource.TestCheckResourceAttr(resName, "data.permissions", "read_write"),
+					resource.TestCheckResourceAttrSet(resName, "data.access_key"),
+					resource.TestCheckResourceAttrSet(resName, "data.secret_key"),
+					resource.TestCheckResourceAttrWith(resName, "data.id", checkKeyDeleted),
+				),
+			},
+		},
+	})
+}
+
+// checkKeyDeleted checks that the key created by the
+// ephemeral resource was deleted when it was closed.
+func checkKeyDeleted(value string) error {
+	client := acceptance.TestAccFrameworkProvider.Meta.Client
+
+	id, err := strconv.Atoi(value)
+	if err != nil {
+		return fmt.Errorf("Error parsing %v to int", value)
+	}
+
+	_, err = client.GetObjectStorageKey(context.Background(), id)
+	if err == nil {
+		return fmt.Errorf("Object Storage Key with id %d still exists", id)
+	}
+
+	if !linodego.IsNotFound(err) {
+		return fmt.Errorf("Error requesting Object Storage Key with id %d: %s", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package objtempkey
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	"github.com/linode/terraform-provider-linode/v2/linode/obj"
+)
+
+func NewEphemeralResource() ephemeral.EphemeralResource {
+	return &EphemeralResource{
+		BaseEphemeralResource: helper.NewBaseEphemeralResource(
+			helper.BaseEphemeralResourceConfig{
+				Name:   "linode_object_storage_temp_key",
+				Schema: &frameworkEphemeralResourceSchema,
+			},
+		),
+	}
+}
+
+type EphemeralResource struct {
+	helper.BaseEphemeralResource
+}
+
+var _ ephemeral.EphemeralResourceWithClose = &EphemeralResource{}
+
+func (r *EphemeralResource) Open(
+	ctx context.Context,
+	req ephemeral.OpenRequest,
+	resp *ephemeral.OpenResponse,
+) {
+	tflog.Debug(ctx, "Open ephemeral.linode_object_storage_temp_key")
+
+	client := r.Meta.Client
+
+	var data EphemeralModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = helper.SetLogFieldBulk(ctx, map[string]any{
+		"bucket": data.BucketName.ValueString(),
+		"region": data.Region.ValueString(),
+	})
+
+	key, err := obj.CreateTempKeys(
+		ctx,
+		*client,
+		data.BucketName.ValueString(),
+		data.Region.ValueString(),
+		data.GetPermissions(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Create Temporary Object Storage Key",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(helper.SetEphemeralPrivateID(ctx, resp.Private, key.ID)...)
+
+	data.FlattenObjectStorageKey(key)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *EphemeralResource) Close(
+	ctx context.Context,
+	req ephemeral.CloseRequest,
+	resp *ephemeral.CloseResponse,
+) {
+	tflog.Debug(ctx, "Close ephemeral.linode_object_storage_temp_key")
+
+	client := r.Meta.Client
+
+	id, ok, diags := helper.GetEphemeralPrivateID(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !ok {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "key_id", id)
+
+	tflog.Trace(ctx, "client.DeleteObjectStorageKey(...)")
+
+	if err := client.DeleteObjectStorageKey(ctx, id); err != nil && !linodego.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Delete Temporary Object Storage Key %d", id),
+			err.Error(),
+		)
+	}
+}
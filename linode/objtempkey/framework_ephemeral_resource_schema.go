@@ -0,0 +1,44 @@
+package objtempkey
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+const defaultPermissions = "read_only"
+
+var frameworkEphemeralResourceSchema = schema.Schema{
+	Description: "Creates a temporary Object Storage Key limited to a single bucket. " +
+		"The key is deleted when Terraform no longer needs it.",
+	Attributes: map[string]schema.Attribute{
+		"bucket_name": schema.StringAttribute{
+			Description: "The label of the bucket to which the key will grant limited access.",
+			Required:    true,
+		},
+		"region": schema.StringAttribute{
+			Description: "The region where the bucket resides.",
+			Required:    true,
+		},
+		"permissions": schema.StringAttribute{
+			Description: "The permissions of the key for the bucket. Defaults to `" + defaultPermissions + "`.",
+			Optional:    true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("read_only", "read_write"),
+			},
+		},
+		"id": schema.StringAttribute{
+			Description: "The ID of the temporary key.",
+			Computed:    true,
+		},
+		"access_key": schema.StringAttribute{
+			Description: "The access key of the temporary key.",
+			Computed:    true,
+		},
+		"secret_key": schema.StringAttribute{
+			Description: "The secret key of the temporary key.",
+			Computed:    true,
+			Sensitive:   true,
+		},
+	},
+}
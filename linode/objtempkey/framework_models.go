@@ -0,0 +1,34 @@
+package objtempkey
+
+import (
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+)
+
+// EphemeralModel describes the Terraform ephemeral resource data model to match the
+// ephemeral resource schema.
+type EphemeralModel struct {
+	BucketName  types.String `tfsdk:"bucket_name"`
+	Region      types.String `tfsdk:"region"`
+	Permissions types.String `tfsdk:"permissions"`
+	ID          types.String `tfsdk:"id"`
+	AccessKey   types.String `tfsdk:"access_key"`
+	SecretKey   types.String `tfsdk:"secret_key"`
+}
+
+func (m *EphemeralModel) GetPermissions() string {
+	if m.Permissions.IsNull() || m.Permissions.IsUnknown() {
+		return defaultPermissions
+	}
+
+	return m.Permissions.ValueString()
+}
+
+func (m *EphemeralModel) FlattenObjectStorageKey(key *linodego.ObjectStorageKey) {
+	m.Permissions = types.StringValue(m.GetPermissions())
+	m.ID = types.StringValue(strconv.Itoa(key.ID))
+	m.AccessKey = types.StringValue(key.AccessKey)
+	m.SecretKey = types.StringValue(key.SecretKey)
+}
@@ -0,0 +1,42 @@
+//go:build unit
+
+package objtempkey
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/linode/linodego"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlattenObjectStorageKey(t *testing.T) {
+	key := linodego.ObjectStorageKey{
+		ID:        123,
+		Label:     "temp_example-bucket_1700000000",
+		AccessKey: "KVAKUTGBA4WTR2NSJQ81",
+		SecretKey: "OiA6F5r0niLs3QA2stbyq7mY5VCV7KqOzcmitmHw",
+		Limited:   true,
+	}
+
+	data := EphemeralModel{
+		BucketName:  types.StringValue("example-bucket"),
+		Region:      types.StringValue("us-mia"),
+		Permissions: types.StringNull(),
+	}
+
+	data.FlattenObjectStorageKey(&key)
+
+	assert.Equal(t, "123", data.ID.ValueString())
+	assert.Equal(t, "read_only", data.Permissions.ValueString())
+	assert.Equal(t, key.AccessKey, data.AccessKey.ValueString())
+	assert.Equal(t, key.SecretKey, data.SecretKey.ValueString())
+}
+
+func TestGetPermissions(t *testing.T) {
+	data := EphemeralModel{Permissions: types.StringValue("read_write")}
+	assert.Equal(t, "read_write", data.GetPermissions())
+
+	data.Permissions = types.StringNull()
+	assert.Equal(t, "read_only", data.GetPermissions())
+}
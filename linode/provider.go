@@ -2,14 +2,12 @@ package linode
 
 import (
 	"context"
-	"fmt"
-	"os"
-	"strconv"
+	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
-	"github.com/linode/linodego"
 	"github.com/linode/terraform-provider-linode/v2/linode/databaseaccesscontrols"
 	"github.com/linode/terraform-provider-linode/v2/linode/databasemysql"
 	"github.com/linode/terraform-provider-linode/v2/linode/databasemysqlbackups"
@@ -134,11 +132,135 @@ func Provider() *schema.Provider {
 				Description: "If true, when deleting a linode_object_storage_bucket any objects " +
 					"and versions will be force deleted.",
 			},
+			"cassette_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "If set, API interactions will be recorded to or replayed from " +
+					"the cassette at cassette_path. (record, replay)",
+				ValidateFunc: validation.StringInSlice([]string{
+					string(helper.CassetteModeRecord),
+					string(helper.CassetteModeReplay),
+				}, false),
+			},
+			"cassette_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The path of the cassette file to record API interactions to or replay them from.",
+			},
+			"retry": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "An additional set of conditions under which failed API requests will be retried.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"status_codes": {
+							Type:        schema.TypeList,
+							Required:    true,
+							MinItems:    1,
+							Description: "The response status codes to retry on.",
+							Elem: &schema.Schema{
+								Type:         schema.TypeInt,
+								ValidateFunc: validation.IntBetween(400, 599),
+							},
+						},
+						"path_patterns": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Description: "Regular expressions matched against the request path. " +
+								"If unset, requests to all paths will be retried.",
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsValidRegExp,
+							},
+						},
+						"methods": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Description: "The HTTP methods to retry. " +
+								"If unset, requests with any method will be retried.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete,
+								}, false),
+							},
+						},
+						"max_attempts": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Description: "The maximum number of attempts for a matching request. " +
+								"If unset, matching requests will be retried up to the API client's default retry limit.",
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"rate_limit": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Limits the rate and concurrency of API requests in a given endpoint and operation class.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"endpoint": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Description: "The class of API endpoints this limit applies to. (compute, object_storage) " +
+								"If unset, the limit applies to all endpoints.",
+							ValidateFunc: validation.StringInSlice([]string{
+								string(helper.RateLimitEndpointCompute),
+								string(helper.RateLimitEndpointObjectStorage),
+							}, false),
+						},
+						"operation": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Description: "The class of API operations this limit applies to. (read, write) " +
+								"If unset, the limit applies to all operations.",
+							ValidateFunc: validation.StringInSlice([]string{
+								string(helper.RateLimitOperationRead),
+								string(helper.RateLimitOperationWrite),
+							}, false),
+						},
+						"requests_per_second": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							Description:  "The number of requests per second allowed by the token bucket.",
+							ValidateFunc: validation.FloatAtLeast(0),
+						},
+						"burst": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Description:  "The maximum number of requests allowed to burst above the rate. (default: requests_per_second)",
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"max_in_flight": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Description:  "The maximum number of concurrent requests.",
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"child_account": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Manage resources in the given child account using a proxy user token minted by the parent account.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"euuid": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The EUUID of the child account.",
+						},
+					},
+				},
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
 			"linode_database_mysql_backups": databasemysqlbackups.DataSource(),
-			"linode_instances":              instance.DataSource(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
@@ -169,85 +291,91 @@ func Provider() *schema.Provider {
 	return provider
 }
 
+// GRPCProvider returns a factory of the provider server of the given SDKv2 provider
+// that adds plan-time warnings to the resources that support them.
+func GRPCProvider(provider *schema.Provider) func() tfprotov5.ProviderServer {
+	return helper.NewSDKv2PlanWarningProviderServer(
+		provider,
+		map[string]helper.SDKv2PlanWarningFunc{
+			"linode_instance":        instance.PlanWarnings,
+			"linode_instance_config": instanceconfig.PlanWarnings,
+		},
+	)
+}
+
 func handleDefault(config *helper.Config, d *schema.ResourceData) diag.Diagnostics {
-	if v, ok := d.GetOk("token"); ok {
-		config.AccessToken = v.(string)
-	} else {
-		config.AccessToken = os.Getenv("LINODE_TOKEN")
-	}
+	config.AccessToken = d.Get("token").(string)
+	config.APIURL = d.Get("url").(string)
+	config.APIVersion = d.Get("api_version").(string)
+	config.UAPrefix = d.Get("ua_prefix").(string)
+	config.ConfigPath = d.Get("config_path").(string)
+	config.ConfigProfile = d.Get("config_profile").(string)
+	config.EventPollMilliseconds = d.Get("event_poll_ms").(int)
+	config.LKEEventPollMilliseconds = d.Get("lke_event_poll_ms").(int)
+	config.LKENodeReadyPollMilliseconds = d.Get("lke_node_ready_poll_ms").(int)
+	config.ObjAccessKey = d.Get("obj_access_key").(string)
+	config.ObjSecretKey = d.Get("obj_secret_key").(string)
+	config.CassetteMode = helper.CassetteMode(d.Get("cassette_mode").(string))
+	config.CassettePath = d.Get("cassette_path").(string)
+	config.RetryPolicies = expandRetryPolicies(d.Get("retry").([]any))
+	config.RateLimits = expandRateLimits(d.Get("rate_limit").([]any))
 
-	if v, ok := d.GetOk("api_version"); ok {
-		config.APIVersion = v.(string)
-	} else {
-		config.APIVersion = os.Getenv("LINODE_API_VERSION")
+	if v, ok := d.GetOk("child_account.0.euuid"); ok {
+		config.ChildAccountEUUID = v.(string)
 	}
 
-	if v, ok := d.GetOk("config_path"); ok {
-		config.ConfigPath = v.(string)
-	} else {
-		homeDir, err := os.UserHomeDir()
-		if err != nil {
-			return diag.Errorf(
-				"Failed to get user home directory: %s",
-				err.Error(),
-			)
-		}
-		config.ConfigPath = fmt.Sprintf("%s/.config/linode", homeDir)
-	}
+	var diags diag.Diagnostics
 
-	if v, ok := d.GetOk("config_profile"); ok {
-		config.ConfigProfile = v.(string)
-	} else {
-		config.ConfigProfile = "default"
+	warnings, err := config.ApplyDefaults()
+	for _, warning := range warnings {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  warning,
+		})
 	}
 
-	if v, ok := d.GetOk("url"); ok {
-		config.APIURL = v.(string)
-	} else {
-		config.APIURL = os.Getenv("LINODE_URL")
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
 	}
 
-	if v, ok := d.GetOk("ua_prefix"); ok {
-		config.UAPrefix = v.(string)
-	} else {
-		config.UAPrefix = os.Getenv("LINODE_UA_PREFIX")
-	}
+	return diags
+}
 
-	if v, ok := d.GetOk("event_poll_ms"); ok {
-		config.EventPollMilliseconds = v.(int)
-	} else {
-		eventPollMs, err := strconv.Atoi(os.Getenv("LINODE_EVENT_POLL_MS"))
-		if err != nil {
-			eventPollMs = 4000
-		}
-		config.EventPollMilliseconds = eventPollMs
-	}
+func expandRateLimits(rateLimits []any) []helper.RateLimit {
+	result := make([]helper.RateLimit, 0, len(rateLimits))
 
-	if v, ok := d.GetOk("lke_event_poll_ms"); ok {
-		config.LKEEventPollMilliseconds = v.(int)
-	} else {
-		config.LKEEventPollMilliseconds = 3000
-	}
+	for _, rateLimit := range rateLimits {
+		rateLimit := rateLimit.(map[string]any)
 
-	if v, ok := d.GetOk("lke_node_ready_poll_ms"); ok {
-		config.LKENodeReadyPollMilliseconds = v.(int)
-	} else {
-		config.LKENodeReadyPollMilliseconds = 3000
+		result = append(result, helper.RateLimit{
+			Endpoint:          helper.RateLimitEndpoint(rateLimit["endpoint"].(string)),
+			Operation:         helper.RateLimitOperation(rateLimit["operation"].(string)),
+			RequestsPerSecond: rateLimit["requests_per_second"].(float64),
+			Burst:             rateLimit["burst"].(int),
+			MaxInFlight:       rateLimit["max_in_flight"].(int),
+		})
 	}
 
-	if v, ok := d.GetOk("obj_access_key"); ok {
-		config.ObjAccessKey = v.(string)
-	} else {
-		config.ObjAccessKey = os.Getenv("LINODE_OBJ_ACCESS_KEY")
-	}
+	return result
+}
+
+func expandRetryPolicies(retries []any) []helper.RetryPolicy {
+	result := make([]helper.RetryPolicy, 0, len(retries))
 
-	if v, ok := d.GetOk("obj_secret_key"); ok {
-		config.ObjSecretKey = v.(string)
-	} else {
-		config.ObjSecretKey = os.Getenv("LINODE_OBJ_SECRET_KEY")
+	for _, retry := range retries {
+		retry := retry.(map[string]any)
+
+		policy := helper.RetryPolicy{
+			StatusCodes:  helper.ExpandIntList(retry["status_codes"].([]any)),
+			PathPatterns: helper.ExpandStringList(retry["path_patterns"].([]any)),
+			Methods:      helper.ExpandStringList(retry["methods"].([]any)),
+			MaxAttempts:  retry["max_attempts"].(int),
+		}
+
+		result = append(result, policy)
 	}
 
-	return nil
+	return result
 }
 
 func providerConfigure(
@@ -267,20 +395,20 @@ func providerConfigure(
 		ObjBucketForceDelete: d.Get("obj_bucket_force_delete").(bool),
 	}
 
-	handleDefault(config, d)
+	diags := handleDefault(config, d)
+	if diags.HasError() {
+		return nil, diags
+	}
 
 	config.TerraformVersion = terraformVersion
-	client, err := config.Client(ctx)
+	client, err := config.SharedClient(ctx)
 	if err != nil {
-		return nil, diag.Errorf("failed to initialize client: %s", err)
+		return nil, append(diags, diag.FromErr(err)...)
 	}
 
-	// Ping the API for an empty response to verify the configuration works
-	if _, err := client.ListTypes(ctx, linodego.NewListOptions(100, "")); err != nil {
-		return nil, diag.Errorf("Error connecting to the Linode API: %s", err)
-	}
 	return &helper.ProviderMeta{
-		Client: *client,
-		Config: config,
-	}, nil
+		Client:       *client,
+		Config:       config,
+		EventWatcher: helper.GetEventWatcher(client),
+	}, diags
 }
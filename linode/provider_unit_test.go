@@ -0,0 +1,61 @@
+//go:build unit
+
+package linode
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+func TestProviders_shareClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data": [], "page": 1, "pages": 1, "results": 0}`))
+	}))
+	t.Cleanup(server.Close)
+
+	configPath := t.TempDir() + "/missing"
+
+	sdkv2Provider := Provider()
+	sdkv2Provider.TerraformVersion = "1.10.0"
+
+	sdkv2Diags := sdkv2Provider.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]any{
+		"token":                 "shared-client-token",
+		"url":                   server.URL,
+		"config_path":           configPath,
+		"skip_implicit_reboots": true,
+	}))
+	if sdkv2Diags.HasError() {
+		t.Fatalf("failed to configure SDKv2 provider: %v", sdkv2Diags)
+	}
+
+	var frameworkDiags diag.Diagnostics
+	var frameworkMeta helper.FrameworkProviderMeta
+
+	frameworkProvider := &FrameworkProvider{}
+	frameworkProvider.InitProvider(context.Background(), &helper.FrameworkProviderModel{
+		AccessToken:         types.StringValue("shared-client-token"),
+		APIURL:              types.StringValue(server.URL),
+		ConfigPath:          types.StringValue(configPath),
+		SkipImplicitReboots: types.BoolValue(true),
+	}, "1.10.0", &frameworkDiags, &frameworkMeta)
+	if frameworkDiags.HasError() {
+		t.Fatalf("failed to configure framework provider: %v", frameworkDiags)
+	}
+
+	sdkv2Meta := sdkv2Provider.Meta().(*helper.ProviderMeta)
+
+	// The SDKv2 provider keeps a copy of the client, so the client is
+	// compared through the event watcher of the shared client instead.
+	if sdkv2Meta.EventWatcher != frameworkMeta.EventWatcher ||
+		frameworkMeta.EventWatcher != helper.GetEventWatcher(frameworkMeta.Client) {
+		t.Error("expected the SDKv2 and framework providers to share a client")
+	}
+}
@@ -0,0 +1,68 @@
+//go:build integration || token
+
+package token_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/acceptance"
+	"github.com/linode/terraform-provider-linode/v2/linode/token/tmpl"
+)
+
+func TestAccEphemeralResourceToken_basic(t *testing.T) {
+	t.Parallel()
+
+	resName := "echo.test"
+	tokenName := acctest.RandomWithPrefix("tf_test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acceptance.PreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acceptance.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acceptance.ProtoV6EchoProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: tmpl.Ephemeral(t, tokenName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "data.label", tokenName),
+					resource.TestCheckResourceAttr(resName, "data.scopes", "linodes:read_only"),
+					resource.TestCheckResourceAttr(resName, "data.expiry", "2100-01-02T03:04:05Z"),
+					resource.TestCheckResourceAttrSet(resName, "data.token"),
+					resource.TestCheckResourceAttrSet(resName, "data.created"),
+					resource.TestCheckResourceAttrWith(resName, "data.id", checkTokenRevoked),
+				),
+			},
+		},
+	})
+}
+
+// checkTokenRevoked checks that the token created by the
+// ephemeral resource was deleted when it was closed.
+func checkTokenRevoked(value string) error {
+	client := acceptance.TestAccFrameworkProvider.Meta.Client
+
+	id, err := strconv.Atoi(value)
+	if err != nil {
+		return fmt.Errorf("Error parsing %v to int", value)
+	}
+
+	_, err = client.GetToken(context.Background(), id)
+	if err == nil {
+		return fmt.Errorf("Linode Token with id %d still exists", id)
+	}
+
+	if !linodego.IsNotFound(err) {
+		return fmt.Errorf("Error requesting Linode Token with id %d: %s", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package token
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/linode/linodego"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+)
+
+func NewEphemeralResource() ephemeral.EphemeralResource {
+	return &EphemeralResource{
+		BaseEphemeralResource: helper.NewBaseEphemeralResource(
+			helper.BaseEphemeralResourceConfig{
+				Name:   "linode_token",
+				Schema: &frameworkEphemeralResourceSchema,
+			},
+		),
+	}
+}
+
+type EphemeralResource struct {
+	helper.BaseEphemeralResource
+}
+
+var _ ephemeral.EphemeralResourceWithClose = &EphemeralResource{}
+
+func (r *EphemeralResource) Open(
+	ctx context.Context,
+	req ephemeral.OpenRequest,
+	resp *ephemeral.OpenResponse,
+) {
+	tflog.Debug(ctx, "Open ephemeral.linode_token")
+
+	client := r.Meta.Client
+
+	var data ResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createOpts, d := data.GetCreateOptions()
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "client.CreateToken(...)", map[string]any{
+		"options": createOpts,
+	})
+	token, err := client.CreateToken(ctx, createOpts)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Token creation error",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(helper.SetEphemeralPrivateID(ctx, resp.Private, token.ID)...)
+
+	// Keep the configured label and scopes as-is since
+	// the API may normalize them
+	label, scopes := data.Label, data.Scopes
+	data.FlattenToken(token, false, false)
+	data.Label, data.Scopes = label, scopes
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *EphemeralResource) Close(
+	ctx context.Context,
+	req ephemeral.CloseRequest,
+	resp *ephemeral.CloseResponse,
+) {
+	tflog.Debug(ctx, "Close ephemeral.linode_token")
+
+	client := r.Meta.Client
+
+	id, ok, diags := helper.GetEphemeralPrivateID(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !ok {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "token_id", id)
+
+	tflog.Debug(ctx, "client.DeleteToken(...)")
+
+	if err := client.DeleteToken(ctx, id); err != nil && !linodego.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Failed to Delete Token %d", id),
+			err.Error(),
+		)
+	}
+}
@@ -0,0 +1,51 @@
+package token
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper/customtypes"
+)
+
+var frameworkEphemeralResourceSchema = schema.Schema{
+	Description: "Creates a short-lived Linode Personal Access Token. " +
+		"The token is revoked when Terraform no longer needs it.",
+	Attributes: map[string]schema.Attribute{
+		"label": schema.StringAttribute{
+			Description: "The label of the Linode Token.",
+			Optional:    true,
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(1, 100),
+			},
+		},
+		"scopes": schema.StringAttribute{
+			Description: "The scopes this token will be created with. Multiple scopes are " +
+				"separated by a space character (e.g., \"databases:read_only events:read_only\").",
+			Required:   true,
+			CustomType: customtypes.LinodeScopesStringType{},
+		},
+		"expiry": schema.StringAttribute{
+			Description: "When this token will expire. The token is revoked when Terraform no longer needs it, " +
+				"but setting an expiry limits its lifetime if revocation fails. Format: " + helper.TIME_FORMAT,
+			Optional:   true,
+			Computed:   true,
+			CustomType: timetypes.RFC3339Type{},
+		},
+		"created": schema.StringAttribute{
+			Description: "The date and time this token was created.",
+			Computed:    true,
+			CustomType:  timetypes.RFC3339Type{},
+		},
+		"token": schema.StringAttribute{
+			Sensitive:   true,
+			Description: "The token used to access the API.",
+			Computed:    true,
+		},
+		"id": schema.StringAttribute{
+			Description: "The ID of the token.",
+			Computed:    true,
+		},
+	},
+}
@@ -4,6 +4,7 @@ import (
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/linode/linodego"
 	"github.com/linode/terraform-provider-linode/v2/linode/helper"
@@ -46,6 +47,23 @@ func (rm *ResourceModel) FlattenToken(token *linodego.Token, refresh, preserveKn
 	}
 }
 
+// GetCreateOptions converts the model into the options used to create a token.
+func (rm *ResourceModel) GetCreateOptions() (opts linodego.TokenCreateOptions, diags diag.Diagnostics) {
+	opts.Label = rm.Label.ValueString()
+	opts.Scopes = rm.Scopes.ValueString()
+
+	if !rm.Expiry.IsNull() && !rm.Expiry.IsUnknown() {
+		expiry, d := rm.Expiry.ValueRFC3339Time()
+		diags.Append(d...)
+		if diags.HasError() {
+			return opts, diags
+		}
+		opts.Expiry = &expiry
+	}
+
+	return opts, diags
+}
+
 func (rm *ResourceModel) CopyFrom(other ResourceModel, preserveKnown bool) {
 	rm.Label = helper.KeepOrUpdateValue(rm.Label, other.Label, preserveKnown)
 	rm.Created = helper.KeepOrUpdateValue(rm.Created, other.Created, preserveKnown)
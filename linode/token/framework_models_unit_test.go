@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/linode/linodego"
 	"github.com/linode/terraform-provider-linode/v2/linode/helper/customtypes"
@@ -55,3 +56,25 @@ func TestFlattenTokenRefreshTrue(t *testing.T) {
 
 	assert.Empty(t, rm.Token)
 }
+
+func TestGetCreateOptions(t *testing.T) {
+	expiryDate := time.Date(2050, time.August, 17, 12, 0, 0, 0, time.UTC)
+
+	model := &ResourceModel{
+		Label:  types.StringValue("Test Token"),
+		Scopes: customtypes.LinodeScopesStringValue{StringValue: types.StringValue("linodes:read_only")},
+		Expiry: timetypes.NewRFC3339TimeValue(expiryDate),
+	}
+
+	opts, diags := model.GetCreateOptions()
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "Test Token", opts.Label)
+	assert.Equal(t, "linodes:read_only", opts.Scopes)
+	assert.True(t, expiryDate.Equal(*opts.Expiry))
+
+	model.Expiry = timetypes.NewRFC3339Null()
+
+	opts, diags = model.GetCreateOptions()
+	assert.False(t, diags.HasError())
+	assert.Nil(t, opts.Expiry)
+}
@@ -45,20 +45,10 @@ func (r *Resource) Create(
 		return
 	}
 
-	var expiry *time.Time
-	if !data.Expiry.IsNull() && !data.Expiry.IsUnknown() {
-		parsedExpiry, d := data.Expiry.ValueRFC3339Time()
-		resp.Diagnostics.Append(d...)
-		if d.HasError() {
-			return
-		}
-		expiry = &parsedExpiry
-	}
-
-	createOpts := linodego.TokenCreateOptions{
-		Label:  data.Label.ValueString(),
-		Scopes: data.Scopes.ValueString(),
-		Expiry: expiry,
+	createOpts, d := data.GetCreateOptions()
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	tflog.Debug(ctx, "client.CreateToken(...)", map[string]any{
@@ -45,3 +45,8 @@ func RecreateNewExpiryDate(t *testing.T, label, expiry string) string {
 			Expiry:       expiry,
 		})
 }
+
+func Ephemeral(t *testing.T, label string) string {
+	return acceptance.ExecuteTemplate(t,
+		"token_ephemeral", TemplateData{Label: label})
+}
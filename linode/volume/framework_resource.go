@@ -508,12 +508,17 @@ func (r *Resource) Update(
 				ConfigID: 0,
 			}
 
+			p, err := r.Meta.EventWatcher.NewEventWaiter(ctx, id, linodego.EntityVolume, linodego.ActionVolumeAttach)
+			if err != nil {
+				resp.Diagnostics.AddError("Failed to Initialize Event Waiter", err.Error())
+				return
+			}
+
 			tflog.Debug(ctx, "client.AttachVolume(...)", map[string]interface{}{
 				"options": attachOptions,
 			})
 
-			_, err := client.AttachVolume(ctx, id, &attachOptions)
-			if err != nil {
+			if _, err := client.AttachVolume(ctx, id, &attachOptions); err != nil {
 				resp.Diagnostics.AddError(
 					fmt.Sprintf("Failed to Attach Volume %d to Linode %d", id, linodeID),
 					err.Error(),
@@ -521,10 +526,9 @@ func (r *Resource) Update(
 				return
 			}
 
-			tflog.Trace(ctx, "client.WaitForVolumeLinodeID(...)")
+			tflog.Debug(ctx, "Waiting for volume attach to finish")
 
-			volume, err := client.WaitForVolumeLinodeID(ctx, id, &linodeID, timeoutSeconds)
-			if err != nil {
+			if _, err := p.WaitForFinished(ctx, timeoutSeconds); err != nil {
 				resp.Diagnostics.AddError(
 					fmt.Sprintf("Failed to Wait for Volume %d Attached to Linode %d", id, linodeID),
 					err.Error(),
@@ -532,6 +536,17 @@ func (r *Resource) Update(
 				return
 			}
 
+			tflog.Trace(ctx, "client.GetVolume(...)")
+
+			volume, err := client.GetVolume(ctx, id)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					fmt.Sprintf("Failed to Get Volume %d", id),
+					err.Error(),
+				)
+				return
+			}
+
 			resp.Diagnostics.Append(plan.FlattenVolume(volume, true)...)
 			if resp.Diagnostics.HasError() {
 				return
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
 	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
 	"github.com/linode/terraform-provider-linode/v2/linode"
+	"github.com/linode/terraform-provider-linode/v2/linode/helper"
 	"github.com/linode/terraform-provider-linode/v2/version"
 )
 
@@ -24,11 +25,16 @@ func main() {
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
+	shutdownTelemetry, err := helper.InitTelemetry(ctx, version.ProviderVersion)
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	providers := []func() tfprotov5.ProviderServer{
 		providerserver.NewProtocol5(
 			linode.CreateFrameworkProvider(version.ProviderVersion),
 		),
-		linode.Provider().GRPCProvider,
+		linode.GRPCProvider(linode.Provider()),
 	}
 
 	muxServer, err := tf5muxserver.NewMuxServer(ctx, providers...)
@@ -44,9 +50,15 @@ func main() {
 
 	err = tf5server.Serve(
 		"registry.terraform.io/linode/linode",
-		muxServer.ProviderServer,
+		helper.NewTelemetryProviderServer(muxServer.ProviderServer),
 		serveOpts...,
 	)
+
+	// Flush any remaining telemetry before exiting
+	if shutdownErr := shutdownTelemetry(ctx); shutdownErr != nil {
+		log.Printf("[WARN] failed to shut down telemetry: %s", shutdownErr)
+	}
+
 	if err != nil {
 		log.Fatal(err)
 	}